@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package common
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -20,12 +21,17 @@ import (
 	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/ld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/ldcontext/remote"
+	vdrapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdr"
 	ldstore "github.com/hyperledger/aries-framework-go/pkg/store/ld"
+	vdrpkg "github.com/hyperledger/aries-framework-go/pkg/vdr"
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/httpbinding"
+	vdrkey "github.com/hyperledger/aries-framework-go/pkg/vdr/key"
 	"github.com/hyperledger/aries-framework-go/spi/storage"
 	jsonld "github.com/piprate/json-gold/ld"
 	"github.com/spf13/cobra"
 	"github.com/trustbloc/edge-core/pkg/log"
 	cmdutils "github.com/trustbloc/edge-core/pkg/utils/cmd"
+	tlsutils "github.com/trustbloc/edge-core/pkg/utils/tls"
 )
 
 const (
@@ -79,6 +85,45 @@ const (
 	databaseTypeMongoDBOption = "mongodb"
 )
 
+const (
+	// WebhookSecretsFlagName is the shared secrets of the webhook signatures.
+	WebhookSecretsFlagName = "webhook-secrets" // nolint:gosec
+	// WebhookSecretsFlagUsage describes the usage.
+	WebhookSecretsFlagUsage = "Comma-separated shared secrets the webhook requests are signed with by HMAC-SHA256." +
+		" Any of them is accepted, so that the secrets are rotated by adding the new secret first." +
+		" Webhook requests aren't authenticated if neither the secrets nor the client CA certs are set." +
+		" Alternatively, this can be set with the following environment variable: " + WebhookSecretsEnvKey
+	// WebhookSecretsEnvKey is the shared secrets of the webhook signatures.
+	WebhookSecretsEnvKey = "WEBHOOK_SECRETS" // nolint:gosec
+
+	// WebhookSecretsFileFlagName is the file of the shared secrets of the webhook signatures.
+	WebhookSecretsFileFlagName = "webhook-secrets-file" // nolint:gosec
+	// WebhookSecretsFileFlagUsage describes the usage.
+	WebhookSecretsFileFlagUsage = "File with the shared secrets of the webhook signatures, one per line." +
+		" It's reloaded when modified, so that the secrets are rotated without restart." +
+		" Alternatively, this can be set with the following environment variable: " + WebhookSecretsFileEnvKey
+	// WebhookSecretsFileEnvKey is the file of the shared secrets of the webhook signatures.
+	WebhookSecretsFileEnvKey = "WEBHOOK_SECRETS_FILE" // nolint:gosec
+
+	// WebhookClientCACertsFlagName is the CA certs of the webhook client certificates.
+	WebhookClientCACertsFlagName = "webhook-client-cacerts"
+	// WebhookClientCACertsFlagUsage describes the usage.
+	WebhookClientCACertsFlagUsage = "Comma-separated list of the CA cert paths the TLS client certificates of the" +
+		" webhook requests are verified with. Client certificates aren't required if not set." +
+		" Alternatively, this can be set with the following environment variable: " + WebhookClientCACertsEnvKey
+	// WebhookClientCACertsEnvKey is the CA certs of the webhook client certificates.
+	WebhookClientCACertsEnvKey = "WEBHOOK_CLIENT_CACERTS"
+
+	// WebhookClientNamesFlagName is the names allowed in the webhook client certificates.
+	WebhookClientNamesFlagName = "webhook-client-names"
+	// WebhookClientNamesFlagUsage describes the usage.
+	WebhookClientNamesFlagUsage = "Comma-separated common names or DNS names of the allowed webhook client" +
+		" certificates. Any client certificate issued by the client CA certs is allowed if not set." +
+		" Alternatively, this can be set with the following environment variable: " + WebhookClientNamesEnvKey
+	// WebhookClientNamesEnvKey is the names allowed in the webhook client certificates.
+	WebhookClientNamesEnvKey = "WEBHOOK_CLIENT_NAMES"
+)
+
 // DBParameters holds database configuration.
 type DBParameters struct {
 	URL     string
@@ -86,6 +131,14 @@ type DBParameters struct {
 	Timeout uint64
 }
 
+// WebhookParameters holds the authentication configuration of the webhook requests.
+type WebhookParameters struct {
+	Secrets       []string
+	SecretsFile   string
+	ClientCACerts []string
+	ClientNames   []string
+}
+
 // nolint:gochecknoglobals
 var supportedStorageProviders = map[string]func(string, string) (storage.Provider, error){
 	databaseTypeMYSQLDBOption: func(dbURL, prefix string) (storage.Provider, error) {
@@ -128,6 +181,44 @@ func Flags(cmd *cobra.Command) {
 	cmd.Flags().StringP(DatabaseTimeoutFlagName, "", "", DatabaseTimeoutFlagUsage)
 }
 
+// WebhookFlags registers the webhook authentication flags.
+func WebhookFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayP(WebhookSecretsFlagName, "", []string{}, WebhookSecretsFlagUsage)
+	cmd.Flags().StringP(WebhookSecretsFileFlagName, "", "", WebhookSecretsFileFlagUsage)
+	cmd.Flags().StringArrayP(WebhookClientCACertsFlagName, "", []string{}, WebhookClientCACertsFlagUsage)
+	cmd.Flags().StringArrayP(WebhookClientNamesFlagName, "", []string{}, WebhookClientNamesFlagUsage)
+}
+
+// WebhookParams fetches the webhook authentication parameters configured for this command.
+func WebhookParams(cmd *cobra.Command) (*WebhookParameters, error) {
+	var err error
+
+	params := &WebhookParameters{
+		SecretsFile: cmdutils.GetUserSetOptionalVarFromString(cmd, WebhookSecretsFileFlagName,
+			WebhookSecretsFileEnvKey),
+	}
+
+	params.Secrets, err = cmdutils.GetUserSetVarFromArrayString(cmd, WebhookSecretsFlagName,
+		WebhookSecretsEnvKey, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure webhook secrets: %w", err)
+	}
+
+	params.ClientCACerts, err = cmdutils.GetUserSetVarFromArrayString(cmd, WebhookClientCACertsFlagName,
+		WebhookClientCACertsEnvKey, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure webhook client CA certs: %w", err)
+	}
+
+	params.ClientNames, err = cmdutils.GetUserSetVarFromArrayString(cmd, WebhookClientNamesFlagName,
+		WebhookClientNamesEnvKey, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure webhook client names: %w", err)
+	}
+
+	return params, nil
+}
+
 // DBParams fetches the DB parameters configured for this command.
 func DBParams(cmd *cobra.Command) (*DBParameters, error) {
 	var err error
@@ -280,3 +371,37 @@ func CreateJSONLDDocumentLoader(ldStore ldStoreProvider, client httpClient,
 
 	return loader, nil
 }
+
+// CreateVDR returns the VDR registry resolving did:key locally and the other DIDs through the DID resolver, if set.
+func CreateVDR(didResolverURL string, client *http.Client) (vdrapi.Registry, error) {
+	opts := []vdrpkg.Option{vdrpkg.WithVDR(vdrkey.New())}
+
+	if didResolverURL != "" {
+		didResolverVDR, err := httpbinding.New(didResolverURL,
+			httpbinding.WithHTTPClient(client),
+			httpbinding.WithAccept(func(method string) bool {
+				return method != "key"
+			}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new universal resolver vdr: %w", err)
+		}
+
+		opts = append(opts, vdrpkg.WithVDR(didResolverVDR))
+	}
+
+	return vdrpkg.New(opts...), nil
+}
+
+// ClientCertPool returns the cert pool of the webhook client CA certs, nil if the client CA certs aren't set.
+func (p *WebhookParameters) ClientCertPool() (*x509.CertPool, error) {
+	if len(p.ClientCACerts) == 0 {
+		return nil, nil
+	}
+
+	pool, err := tlsutils.GetCertPool(false, p.ClientCACerts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load webhook client CA certs: %w", err)
+	}
+
+	return pool, nil
+}
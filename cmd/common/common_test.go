@@ -120,6 +120,71 @@ func TestDBParams(t *testing.T) {
 	})
 }
 
+func TestWebhookParams(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		WebhookFlags(cmd)
+
+		params, err := WebhookParams(cmd)
+		require.NoError(t, err)
+		require.Empty(t, params.Secrets)
+		require.Empty(t, params.SecretsFile)
+		require.Empty(t, params.ClientCACerts)
+		require.Empty(t, params.ClientNames)
+	})
+
+	t.Run("flags", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		WebhookFlags(cmd)
+
+		require.NoError(t, cmd.ParseFlags([]string{
+			"--" + WebhookSecretsFlagName, "old",
+			"--" + WebhookSecretsFlagName, "new",
+			"--" + WebhookSecretsFileFlagName, "secrets",
+			"--" + WebhookClientCACertsFlagName, "ca.crt",
+			"--" + WebhookClientNamesFlagName, "vcs",
+		}))
+
+		params, err := WebhookParams(cmd)
+		require.NoError(t, err)
+		require.Equal(t, &WebhookParameters{
+			Secrets:       []string{"old", "new"},
+			SecretsFile:   "secrets",
+			ClientCACerts: []string{"ca.crt"},
+			ClientNames:   []string{"vcs"},
+		}, params)
+	})
+
+	t.Run("env", func(t *testing.T) {
+		require.NoError(t, os.Setenv(WebhookSecretsEnvKey, "old,new"))
+
+		defer func() {
+			require.NoError(t, os.Unsetenv(WebhookSecretsEnvKey))
+		}()
+
+		cmd := &cobra.Command{}
+		WebhookFlags(cmd)
+
+		params, err := WebhookParams(cmd)
+		require.NoError(t, err)
+		require.Equal(t, []string{"old", "new"}, params.Secrets)
+	})
+}
+
+func TestWebhookParameters_ClientCertPool(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		pool, err := (&WebhookParameters{}).ClientCertPool()
+		require.NoError(t, err)
+		require.Nil(t, pool)
+	})
+
+	t.Run("missing cert", func(t *testing.T) {
+		_, err := (&WebhookParameters{ClientCACerts: []string{"missing.crt"}}).ClientCertPool()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to load webhook client CA certs")
+	})
+}
+
 func TestInitEdgeStore(t *testing.T) {
 	t.Run("inits ok", func(t *testing.T) {
 		s, err := InitStore(&DBParameters{
@@ -313,3 +378,23 @@ func unsetEnv(t *testing.T) {
 	err = os.Unsetenv(DatabaseTimeoutEnvKey)
 	require.NoError(t, err)
 }
+
+func TestCreateVDR(t *testing.T) {
+	t.Run("did:key only", func(t *testing.T) {
+		vdr, err := CreateVDR("", &http.Client{})
+		require.NoError(t, err)
+		require.NotNil(t, vdr)
+	})
+
+	t.Run("with did resolver", func(t *testing.T) {
+		vdr, err := CreateVDR("https://resolver.example.com/1.0/identifiers", &http.Client{})
+		require.NoError(t, err)
+		require.NotNil(t, vdr)
+	})
+
+	t.Run("invalid did resolver url", func(t *testing.T) {
+		_, err := CreateVDR(":invalid", &http.Client{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to create new universal resolver vdr")
+	})
+}
@@ -8,10 +8,14 @@ package startcmd
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	_ "github.com/go-sql-driver/mysql" // mysql driver of the SQL subject data sources
 	"github.com/gorilla/mux"
 	ldrest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/ld"
 	ldsvc "github.com/hyperledger/aries-framework-go/pkg/ld"
@@ -215,6 +219,99 @@ const (
 	vcsDemoIssuerFlagUsage = "VCS demo issuer name"
 	vcsDemoIssuerEnvKey    = "VCS_DEMO_ISSUER"
 
+	oidcIssuerKeyTypeFlagName  = "oidc-issuer-key-type"
+	oidcIssuerKeyTypeFlagUsage = "Default type of the signing keys created for OIDC issuers." +
+		" Supported values: Ed25519, P-256, P-384. Defaults to Ed25519." +
+		" Alternatively, this can be set with the following environment variable: " + oidcIssuerKeyTypeEnvKey
+	oidcIssuerKeyTypeEnvKey = "ISSUER_OIDC_KEY_TYPE"
+
+	oidcIssuerClientsFlagName  = "oidc-issuer-clients"
+	oidcIssuerClientsFlagUsage = "Clients allowed to use the OIDC issuance endpoints, in clientID=clientSecret format." +
+		" Use clientID only for public clients. If not set, unknown clients are treated as public clients." +
+		" Alternatively, this can be set with the following environment variable: " + oidcIssuerClientsEnvKey
+	oidcIssuerClientsEnvKey = "ISSUER_OIDC_CLIENTS"
+
+	oidcRedirectURIAllowlistFlagName  = "oidc-issuer-redirect-uri-allowlist"
+	oidcRedirectURIAllowlistFlagUsage = "Redirect URIs allowed for the OIDC issuance clients, an entry ending with *" +
+		" allows any redirect URI with that prefix. If not set, any redirect URI is allowed for the configured" +
+		" clients. Unknown clients may use only the exact entries, without them they're limited to the" +
+		" pre-authorized code grant." +
+		" Alternatively, this can be set with the following environment variable: " + oidcRedirectURIAllowlistEnvKey
+	oidcRedirectURIAllowlistEnvKey = "ISSUER_OIDC_REDIRECT_URI_ALLOWLIST"
+
+	oidcRequireRegisteredClientsFlagName  = "oidc-issuer-require-registered-clients"
+	oidcRequireRegisteredClientsFlagUsage = "Reject OIDC issuance clients which are not registered. Defaults to false," +
+		" implied if oidc-issuer-clients is set." +
+		" Alternatively, this can be set with the following environment variable: " + oidcRequireRegisteredClientsEnvKey
+	oidcRequireRegisteredClientsEnvKey = "ISSUER_OIDC_REQUIRE_REGISTERED_CLIENTS"
+
+	txnStoreSweepIntervalFlagName  = "txn-store-sweep-interval"
+	txnStoreSweepIntervalFlagUsage = "Interval of purging expired records from the transaction store and" +
+		" the issuer key store, e.g. 10m." +
+		" Defaults to 5m, 0 disables the sweeper." +
+		" Alternatively, this can be set with the following environment variable: " + txnStoreSweepIntervalEnvKey
+	txnStoreSweepIntervalEnvKey = "ISSUER_TXN_STORE_SWEEP_INTERVAL"
+
+	defaultTxnStoreSweepInterval = 5 * time.Minute
+
+	webhookEventTTLFlagName  = "webhook-event-ttl"
+	webhookEventTTLFlagUsage = "Time the webhook events of a transaction are kept after its last update, e.g. 30m." +
+		" Defaults to 1h." +
+		" Alternatively, this can be set with the following environment variable: " + webhookEventTTLEnvKey
+	webhookEventTTLEnvKey = "ISSUER_WEBHOOK_EVENT_TTL"
+
+	vcsProfilesFileFlagName  = "vcs-profiles-file"
+	vcsProfilesFileFlagUsage = "Path to the JSON file of the issuer profiles by name overriding the VCS ones." +
+		" Alternatively, this can be set with the following environment variable: " + vcsProfilesFileEnvKey
+	vcsProfilesFileEnvKey = "ISSUER_VCS_PROFILES_FILE"
+
+	vcsProfilesFlagName  = "vcs-profiles"
+	vcsProfilesFlagUsage = "Comma-separated names of the VCS issuer profiles listed to the UI." +
+		" Alternatively, this can be set with the following environment variable: " + vcsProfilesEnvKey
+	vcsProfilesEnvKey = "ISSUER_VCS_PROFILES"
+
+	vcsProfileCacheTTLFlagName  = "vcs-profile-cache-ttl"
+	vcsProfileCacheTTLFlagUsage = "Time the VCS issuer profiles are cached, e.g. 10m. Defaults to 5m," +
+		" a negative value disables the cache." +
+		" Alternatively, this can be set with the following environment variable: " + vcsProfileCacheTTLEnvKey
+	vcsProfileCacheTTLEnvKey = "ISSUER_VCS_PROFILE_CACHE_TTL"
+
+	statusListTypeFlagName  = "status-list-type"
+	statusListTypeFlagUsage = "Type of the credential status of the issued credentials." +
+		" Supported values: StatusList2021, BitstringStatusList. Defaults to StatusList2021." +
+		" Alternatively, this can be set with the following environment variable: " + statusListTypeEnvKey
+	statusListTypeEnvKey = "ISSUER_STATUS_LIST_TYPE"
+
+	statusListPurposeFlagName  = "status-list-purpose"
+	statusListPurposeFlagUsage = "Purpose of the credential status of the issued credentials." +
+		" Supported values: revocation, suspension. Defaults to revocation." +
+		" Alternatively, this can be set with the following environment variable: " + statusListPurposeEnvKey
+	statusListPurposeEnvKey = "ISSUER_STATUS_LIST_PURPOSE"
+
+	credentialTemplatesFlagName  = "credential-templates"
+	credentialTemplatesFlagUsage = "Path of the credential template JSON file, or directory of them, to be" +
+		" registered on start. Alternatively, this can be set with the following environment variable: " +
+		credentialTemplatesEnvKey
+	credentialTemplatesEnvKey = "ISSUER_CREDENTIAL_TEMPLATES"
+
+	subjectDataSourcesFlagName  = "subject-data-sources"
+	subjectDataSourcesFlagUsage = "Path of the subject data source config JSON file, which routes the credential" +
+		" scopes to the CMS, SQL, static file or REST sources. All the subject data is read from the CMS if not set." +
+		" Alternatively, this can be set with the following environment variable: " + subjectDataSourcesEnvKey
+	subjectDataSourcesEnvKey = "ISSUER_SUBJECT_DATA_SOURCES"
+
+	didResolverURLFlagName  = "did-resolver-url"
+	didResolverURLFlagUsage = "DID resolver URL resolving the holder DIDs of the DID Auth responses, only did:key" +
+		" is resolved if not set. Alternatively, this can be set with the following environment variable: " +
+		didResolverURLEnvKey
+	didResolverURLEnvKey = "ISSUER_DID_RESOLVER_URL"
+
+	adminTokenFlagName  = "admin-token"
+	adminTokenFlagUsage = "Bearer token authorizing the admin API requests, e.g. the signing key rotation." +
+		" The admin API is disabled if not set." +
+		" Alternatively, this can be set with the following environment variable: " + adminTokenEnvKey
+	adminTokenEnvKey = "ISSUER_ADMIN_TOKEN" //nolint:gosec
+
 	tokenLength2 = 2
 )
 
@@ -227,12 +324,26 @@ type server interface {
 }
 
 // HTTPServer represents an actual HTTP server implementation.
-type HTTPServer struct{}
+type HTTPServer struct {
+	// ClientAuth is the TLS client authentication policy of the server, client certificates aren't requested
+	// by default.
+	ClientAuth tls.ClientAuthType
+}
 
 // ListenAndServe starts the server using the standard Go HTTP server implementation.
 func (s *HTTPServer) ListenAndServe(host, certFile, keyFile string, router http.Handler) error {
 	if certFile != "" && keyFile != "" {
-		return http.ListenAndServeTLS(host, certFile, keyFile, router)
+		if s.ClientAuth == tls.NoClientCert {
+			return http.ListenAndServeTLS(host, certFile, keyFile, router)
+		}
+
+		srv := &http.Server{ // nolint:gosec
+			Addr:      host,
+			Handler:   router,
+			TLSConfig: &tls.Config{ClientAuth: s.ClientAuth, MinVersion: tls.VersionTLS12},
+		}
+
+		return srv.ListenAndServeTLS(certFile, keyFile)
 	}
 
 	return http.ListenAndServe(host, router)
@@ -266,6 +377,20 @@ type issuerParameters struct {
 	vcsAPIURL                     string
 	vcsClaimDataURL               string
 	vcsDemoIssuer                 string
+	oidcIssuerKeyType             string
+	oidcIssuerClients             map[string]string
+	oidcRedirectURIAllowlist      []string
+	oidcRequireRegisteredClients  bool
+	txnStoreSweepInterval         time.Duration
+	webhookEventTTL               time.Duration
+	webhookParameters             *common.WebhookParameters
+	vcsProfileParameters          *vcsProfileParameters
+	statusListType                string
+	statusListPurpose             string
+	credentialTemplatesPath       string
+	subjectDataSourcesPath        string
+	didResolverURL                string
+	adminToken                    string
 }
 
 type tlsConfig struct {
@@ -282,6 +407,12 @@ type oidcParameters struct {
 	oidcCallbackURL  string
 }
 
+type vcsProfileParameters struct {
+	file     string
+	names    []string
+	cacheTTL time.Duration
+}
+
 type externalAuthParameters struct {
 	ExternalAuthProviderURL  string
 	ExternalAuthClientID     string
@@ -444,6 +575,57 @@ func createStartCmd(srv server) *cobra.Command { // nolint: funlen,gocyclo,gocog
 				vcsClaimDataURLFlagName, vcsClaimDataURLEnvKey)
 			vcsDemoIssuer := cmdutils.GetUserSetOptionalVarFromString(cmd,
 				vcsDemoIssuerFlagName, vcsDemoIssuerEnvKey)
+			oidcIssuerKeyType := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				oidcIssuerKeyTypeFlagName, oidcIssuerKeyTypeEnvKey)
+
+			oidcIssuerClients, err := getOIDCIssuerClients(cmd)
+			if err != nil {
+				return err
+			}
+
+			oidcRedirectURIAllowlist, err := cmdutils.GetUserSetVarFromArrayString(cmd,
+				oidcRedirectURIAllowlistFlagName, oidcRedirectURIAllowlistEnvKey, true)
+			if err != nil {
+				return err
+			}
+
+			oidcRequireRegisteredClients, err := getOIDCRequireRegisteredClients(cmd)
+			if err != nil {
+				return err
+			}
+
+			txnStoreSweepInterval, err := getTxnStoreSweepInterval(cmd)
+			if err != nil {
+				return err
+			}
+
+			webhookEventTTL, err := getWebhookEventTTL(cmd)
+			if err != nil {
+				return err
+			}
+
+			webhookParams, err := common.WebhookParams(cmd)
+			if err != nil {
+				return err
+			}
+
+			vcsProfileParams, err := getVCSProfileParameters(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusListType := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				statusListTypeFlagName, statusListTypeEnvKey)
+			statusListPurpose := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				statusListPurposeFlagName, statusListPurposeEnvKey)
+			credentialTemplatesPath := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				credentialTemplatesFlagName, credentialTemplatesEnvKey)
+			subjectDataSourcesPath := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				subjectDataSourcesFlagName, subjectDataSourcesEnvKey)
+			didResolverURL := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				didResolverURLFlagName, didResolverURLEnvKey)
+			adminToken := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				adminTokenFlagName, adminTokenEnvKey)
 
 			parameters := &issuerParameters{
 				srv:                           srv,
@@ -473,6 +655,20 @@ func createStartCmd(srv server) *cobra.Command { // nolint: funlen,gocyclo,gocog
 				vcsAPIURL:                     vcsAPIURL,
 				vcsClaimDataURL:               vcsClaimDataURL,
 				vcsDemoIssuer:                 vcsDemoIssuer,
+				oidcIssuerKeyType:             oidcIssuerKeyType,
+				oidcIssuerClients:             oidcIssuerClients,
+				oidcRedirectURIAllowlist:      oidcRedirectURIAllowlist,
+				oidcRequireRegisteredClients:  oidcRequireRegisteredClients,
+				txnStoreSweepInterval:         txnStoreSweepInterval,
+				webhookEventTTL:               webhookEventTTL,
+				webhookParameters:             webhookParams,
+				vcsProfileParameters:          vcsProfileParams,
+				statusListType:                statusListType,
+				statusListPurpose:             statusListPurpose,
+				credentialTemplatesPath:       credentialTemplatesPath,
+				subjectDataSourcesPath:        subjectDataSourcesPath,
+				didResolverURL:                strings.TrimSpace(didResolverURL),
+				adminToken:                    adminToken,
 			}
 
 			return startIssuer(parameters)
@@ -558,6 +754,95 @@ func getRequestTokens(cmd *cobra.Command) (map[string]string, error) {
 	return tokens, nil
 }
 
+func getOIDCIssuerClients(cmd *cobra.Command) (map[string]string, error) {
+	oidcIssuerClients, err := cmdutils.GetUserSetVarFromArrayString(cmd, oidcIssuerClientsFlagName,
+		oidcIssuerClientsEnvKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[string]string)
+
+	for _, client := range oidcIssuerClients {
+		split := strings.SplitN(client, "=", tokenLength2)
+		if split[0] == "" {
+			logger.Warnf("invalid oidc issuer client '%s'", client)
+
+			continue
+		}
+
+		if len(split) == tokenLength2 {
+			clients[split[0]] = split[1]
+		} else {
+			clients[split[0]] = ""
+		}
+	}
+
+	return clients, nil
+}
+
+func getOIDCRequireRegisteredClients(cmd *cobra.Command) (bool, error) {
+	requireRegisteredClients := cmdutils.GetUserSetOptionalVarFromString(cmd, oidcRequireRegisteredClientsFlagName,
+		oidcRequireRegisteredClientsEnvKey)
+	if requireRegisteredClients == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(requireRegisteredClients)
+}
+
+func getTxnStoreSweepInterval(cmd *cobra.Command) (time.Duration, error) {
+	return getDuration(cmd, txnStoreSweepIntervalFlagName, txnStoreSweepIntervalEnvKey, defaultTxnStoreSweepInterval)
+}
+
+func getWebhookEventTTL(cmd *cobra.Command) (time.Duration, error) {
+	return getDuration(cmd, webhookEventTTLFlagName, webhookEventTTLEnvKey, 0)
+}
+
+func getVCSProfileParameters(cmd *cobra.Command) (*vcsProfileParameters, error) {
+	names, err := cmdutils.GetUserSetVarFromArrayString(cmd, vcsProfilesFlagName, vcsProfilesEnvKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheTTL, err := getDuration(cmd, vcsProfileCacheTTLFlagName, vcsProfileCacheTTLEnvKey, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vcsProfileParameters{
+		file:     cmdutils.GetUserSetOptionalVarFromString(cmd, vcsProfilesFileFlagName, vcsProfilesFileEnvKey),
+		names:    names,
+		cacheTTL: cacheTTL,
+	}, nil
+}
+
+func getDuration(cmd *cobra.Command, flagName, envKey string, defaultValue time.Duration) (time.Duration, error) {
+	value := cmdutils.GetUserSetOptionalVarFromString(cmd, flagName, envKey)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s [%s] : %w", flagName, value, err)
+	}
+
+	return d, nil
+}
+
+// requestClientCerts makes the HTTP server request the TLS client certificates if the webhook client CAs are set.
+// The certificates aren't required by the handshake, since only the webhook requests are authenticated with them.
+func requestClientCerts(srv server, clientCAs *x509.CertPool) {
+	if clientCAs == nil {
+		return
+	}
+
+	if s, ok := srv.(*HTTPServer); ok {
+		s.ClientAuth = tls.RequestClientCert
+	}
+}
+
 func getTLS(cmd *cobra.Command) (*tlsConfig, error) {
 	tlsCertFile, err := cmdutils.GetUserSetVarFromString(cmd, tlsCertFileFlagName,
 		tlsCertFileEnvKey, true)
@@ -650,6 +935,40 @@ func createFlags(startCmd *cobra.Command) {
 	startCmd.Flags().StringP(vcsAPIURLFlagName, "", "", vcsAPIURLFlagUsage)
 	startCmd.Flags().StringP(vcsClaimDataURLFlagName, "", "", vcsClaimDataURLFlagUsage)
 	startCmd.Flags().StringP(vcsDemoIssuerFlagName, "", "", vcsDemoIssuerFlagUsage)
+
+	// OIDC issuance
+	startCmd.Flags().StringP(oidcIssuerKeyTypeFlagName, "", "", oidcIssuerKeyTypeFlagUsage)
+	startCmd.Flags().StringArrayP(oidcIssuerClientsFlagName, "", []string{}, oidcIssuerClientsFlagUsage)
+	startCmd.Flags().StringArrayP(oidcRedirectURIAllowlistFlagName, "", []string{}, oidcRedirectURIAllowlistFlagUsage)
+	startCmd.Flags().StringP(oidcRequireRegisteredClientsFlagName, "", "", oidcRequireRegisteredClientsFlagUsage)
+
+	// transaction store
+	startCmd.Flags().StringP(txnStoreSweepIntervalFlagName, "", "", txnStoreSweepIntervalFlagUsage)
+
+	// webhook events
+	startCmd.Flags().StringP(webhookEventTTLFlagName, "", "", webhookEventTTLFlagUsage)
+	common.WebhookFlags(startCmd)
+
+	// vcs profiles
+	startCmd.Flags().StringP(vcsProfilesFileFlagName, "", "", vcsProfilesFileFlagUsage)
+	startCmd.Flags().StringArrayP(vcsProfilesFlagName, "", []string{}, vcsProfilesFlagUsage)
+	startCmd.Flags().StringP(vcsProfileCacheTTLFlagName, "", "", vcsProfileCacheTTLFlagUsage)
+
+	// credential status
+	startCmd.Flags().StringP(statusListTypeFlagName, "", "", statusListTypeFlagUsage)
+	startCmd.Flags().StringP(statusListPurposeFlagName, "", "", statusListPurposeFlagUsage)
+
+	// credential templates
+	startCmd.Flags().StringP(credentialTemplatesFlagName, "", "", credentialTemplatesFlagUsage)
+
+	// subject data
+	startCmd.Flags().StringP(subjectDataSourcesFlagName, "", "", subjectDataSourcesFlagUsage)
+
+	// did auth
+	startCmd.Flags().StringP(didResolverURLFlagName, "", "", didResolverURLFlagUsage)
+
+	// admin api
+	startCmd.Flags().StringP(adminTokenFlagName, "", "", adminTokenFlagUsage)
 }
 
 func startIssuer(parameters *issuerParameters) error { //nolint:funlen,gocyclo
@@ -685,6 +1004,18 @@ func startIssuer(parameters *issuerParameters) error { //nolint:funlen,gocyclo
 		return err
 	}
 
+	vdr, err := common.CreateVDR(parameters.didResolverURL, httpClient)
+	if err != nil {
+		return err
+	}
+
+	webhookClientCAs, err := parameters.webhookParameters.ClientCertPool()
+	if err != nil {
+		return err
+	}
+
+	requestClientCerts(parameters.srv, webhookClientCAs)
+
 	cfg := &operation.Config{
 		TokenIssuer: tokenIssuer.New(parameters.oauth2Config,
 			tokenIssuer.WithTLSConfig(tlsConfig)),
@@ -722,6 +1053,25 @@ func startIssuer(parameters *issuerParameters) error { //nolint:funlen,gocyclo
 		VcsAPIURL:                     parameters.vcsAPIURL,
 		VcsClaimDataURL:               parameters.vcsClaimDataURL,
 		VcsDemoIssuer:                 parameters.vcsDemoIssuer,
+		DefaultKeyType:                parameters.oidcIssuerKeyType,
+		OIDCIssuanceClients:           parameters.oidcIssuerClients,
+		OIDCRedirectURIAllowlist:      parameters.oidcRedirectURIAllowlist,
+		OIDCRequireRegisteredClients:  parameters.oidcRequireRegisteredClients,
+		TxnStoreSweepInterval:         parameters.txnStoreSweepInterval,
+		WebhookEventTTL:               parameters.webhookEventTTL,
+		WebhookSecrets:                parameters.webhookParameters.Secrets,
+		WebhookSecretsFile:            parameters.webhookParameters.SecretsFile,
+		WebhookClientCAs:              webhookClientCAs,
+		WebhookClientNames:            parameters.webhookParameters.ClientNames,
+		VCSProfilesFile:               parameters.vcsProfileParameters.file,
+		VCSProfiles:                   parameters.vcsProfileParameters.names,
+		VCSProfileCacheTTL:            parameters.vcsProfileParameters.cacheTTL,
+		StatusListType:                parameters.statusListType,
+		StatusListPurpose:             parameters.statusListPurpose,
+		CredentialTemplatesPath:       parameters.credentialTemplatesPath,
+		SubjectDataSourcesPath:        parameters.subjectDataSourcesPath,
+		VDRegistry:                    vdr,
+		AdminToken:                    parameters.adminToken,
 	}
 
 	issuerService, err := issuer.New(cfg)
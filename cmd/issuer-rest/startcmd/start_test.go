@@ -6,10 +6,13 @@ SPDX-License-Identifier: Apache-2.0
 package startcmd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
@@ -33,6 +36,35 @@ func TestListenAndServe(t *testing.T) {
 	require.Contains(t, err.Error(), "open test.key: no such file or directory")
 }
 
+func TestRequestClientCerts(t *testing.T) {
+	srv := &HTTPServer{}
+
+	requestClientCerts(srv, nil)
+	require.Equal(t, tls.NoClientCert, srv.ClientAuth)
+
+	requestClientCerts(srv, x509.NewCertPool())
+	require.Equal(t, tls.RequestClientCert, srv.ClientAuth)
+
+	err := srv.ListenAndServe("localhost:8080", "test.key", "test.cert", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "open test.key: no such file or directory")
+
+	// other servers are left as is
+	requestClientCerts(&mockServer{}, x509.NewCertPool())
+}
+
+func TestStartCmdWithInvalidWebhookClientCACerts(t *testing.T) {
+	startCmd := GetStartCmd(&mockServer{})
+
+	args := getValidArgs("")
+	args = append(args, flag+common.WebhookClientCACertsFlagName, "missing.crt")
+	startCmd.SetArgs(args)
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to load webhook client CA certs")
+}
+
 func TestStartCmdContents(t *testing.T) {
 	startCmd := GetStartCmd(&mockServer{})
 
@@ -320,6 +352,170 @@ func TestStartCmdValidArgs(t *testing.T) {
 	require.Equal(t, log.ERROR, log.GetLevel(""))
 }
 
+func TestGetOIDCIssuerClients(t *testing.T) {
+	startCmd := GetStartCmd(&mockServer{})
+
+	require.NoError(t, startCmd.ParseFlags(oidcIssuerClientsArg()))
+
+	clients, err := getOIDCIssuerClients(startCmd)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"wallet": "secret", "mobile-wallet": ""}, clients)
+}
+
+func TestGetOIDCRequireRegisteredClients(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		required, err := getOIDCRequireRegisteredClients(startCmd)
+		require.NoError(t, err)
+		require.False(t, required)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{
+			flag + oidcRequireRegisteredClientsFlagName, "true",
+			flag + oidcRedirectURIAllowlistFlagName, "https://wallet.example.com/*",
+		}))
+
+		required, err := getOIDCRequireRegisteredClients(startCmd)
+		require.NoError(t, err)
+		require.True(t, required)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs("")
+		args = append(args, flag+oidcRequireRegisteredClientsFlagName, "invalid")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid syntax")
+	})
+}
+
+func TestGetTxnStoreSweepInterval(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		interval, err := getTxnStoreSweepInterval(startCmd)
+		require.NoError(t, err)
+		require.Equal(t, defaultTxnStoreSweepInterval, interval)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{flag + txnStoreSweepIntervalFlagName, "90s"}))
+
+		interval, err := getTxnStoreSweepInterval(startCmd)
+		require.NoError(t, err)
+		require.Equal(t, 90*time.Second, interval)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs("")
+		args = append(args, flag+txnStoreSweepIntervalFlagName, "invalid")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+txnStoreSweepIntervalFlagName)
+	})
+}
+
+func TestGetWebhookEventTTL(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		ttl, err := getWebhookEventTTL(startCmd)
+		require.NoError(t, err)
+		require.Zero(t, ttl)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{flag + webhookEventTTLFlagName, "30m"}))
+
+		ttl, err := getWebhookEventTTL(startCmd)
+		require.NoError(t, err)
+		require.Equal(t, 30*time.Minute, ttl)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs("")
+		args = append(args, flag+webhookEventTTLFlagName, "invalid")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+webhookEventTTLFlagName)
+	})
+}
+
+func TestGetVCSProfileParameters(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		params, err := getVCSProfileParameters(startCmd)
+		require.NoError(t, err)
+		require.Empty(t, params.file)
+		require.Empty(t, params.names)
+		require.Zero(t, params.cacheTTL)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{
+			flag + vcsProfilesFileFlagName, "profiles.json",
+			flag + vcsProfilesFlagName, "vc-issuer-1",
+			flag + vcsProfilesFlagName, "vc-issuer-2",
+			flag + vcsProfileCacheTTLFlagName, "10m",
+		}))
+
+		params, err := getVCSProfileParameters(startCmd)
+		require.NoError(t, err)
+		require.Equal(t, &vcsProfileParameters{
+			file:     "profiles.json",
+			names:    []string{"vc-issuer-1", "vc-issuer-2"},
+			cacheTTL: 10 * time.Minute,
+		}, params)
+	})
+
+	t.Run("invalid cache ttl", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs("")
+		args = append(args, flag+vcsProfileCacheTTLFlagName, "invalid")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+vcsProfileCacheTTLFlagName)
+	})
+
+	t.Run("invalid profiles file", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs("")
+		args = append(args, flag+vcsProfilesFileFlagName, "missing.json")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "issuer profile registry")
+	})
+}
+
 func TestStartCmdValidArgsEnvVar(t *testing.T) {
 	startCmd := GetStartCmd(&mockServer{})
 
@@ -416,6 +612,7 @@ func getValidArgs(logLevel string) []string {
 	args = append(args, cmsURLArg()...)
 	args = append(args, vcsURLArg()...)
 	args = append(args, requestTokensArg()...)
+	args = append(args, oidcIssuerClientsArg()...)
 	args = append(args, issuerAdapterURLArg()...)
 	args = append(args, databaseURLArg()...)
 	args = append(args, databasePrefixArg()...)
@@ -475,6 +672,14 @@ func requestTokensArg() []string {
 	return []string{flag + requestTokensFlagName, "token1=tk1", flag + requestTokensFlagName, "token2=tk2=tk2"}
 }
 
+func oidcIssuerClientsArg() []string {
+	return []string{
+		flag + oidcIssuerClientsFlagName, "wallet=secret",
+		flag + oidcIssuerClientsFlagName, "mobile-wallet",
+		flag + oidcIssuerClientsFlagName, "=secret",
+	}
+}
+
 func issuerAdapterURLArg() []string {
 	return []string{flag + issuerAdapterURLFlagName, "issuer-adapter"}
 }
@@ -8,9 +8,12 @@ package startcmd
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
@@ -125,6 +128,77 @@ const (
 		" Alternatively, this can be set with the following environment variable: " + apiGatewayURLEnvKey
 	apiGatewayURLEnvKey = "RP_API_GATEWAY_URL"
 
+	webhookEventTTLFlagName  = "webhook-event-ttl"
+	webhookEventTTLFlagUsage = "Time the webhook events of a transaction are kept after its last update, e.g. 30m." +
+		" Defaults to 1h." +
+		" Alternatively, this can be set with the following environment variable: " + webhookEventTTLEnvKey
+	webhookEventTTLEnvKey = "RP_WEBHOOK_EVENT_TTL"
+
+	webhookEventSweepIntervalFlagName  = "webhook-event-sweep-interval"
+	webhookEventSweepIntervalFlagUsage = "Interval of purging expired webhook events and OIDC share states, e.g. 10m." +
+		" Defaults to 5m, 0 disables the sweeper." +
+		" Alternatively, this can be set with the following environment variable: " + webhookEventSweepIntervalEnvKey
+	webhookEventSweepIntervalEnvKey = "RP_WEBHOOK_EVENT_SWEEP_INTERVAL"
+
+	didResolverURLFlagName  = "did-resolver-url"
+	didResolverURLFlagUsage = "DID resolver URL resolving the wallet DIDs of the OIDC share responses and the issuer" +
+		" DIDs of the local verification, only did:key is resolved if not set." +
+		" Alternatively, this can be set with the following environment variable: " + didResolverURLEnvKey
+	didResolverURLEnvKey = "RP_DID_RESOLVER_URL"
+
+	verifierModeFlagName  = "verifier-mode"
+	verifierModeFlagUsage = "Verifier of the presentations and the credentials, the VCS (vcs) or the embedded" +
+		" verifier (local). Defaults to vcs." +
+		" Alternatively, this can be set with the following environment variable: " + verifierModeEnvKey
+	verifierModeEnvKey = "RP_VERIFIER_MODE"
+
+	trustRegistryEnabledFlagName  = "trust-registry-enabled"
+	trustRegistryEnabledFlagUsage = "Enables the trust check of the credential issuers, the trusted issuers are" +
+		" managed through the /trust/issuers admin API authorized with the admin token. Implied by the trust list" +
+		" file and URL. Defaults to false." +
+		" Alternatively, this can be set with the following environment variable: " + trustRegistryEnabledEnvKey
+	trustRegistryEnabledEnvKey = "RP_TRUST_REGISTRY_ENABLED"
+
+	trustRegistryFileFlagName  = "trust-registry-file"
+	trustRegistryFileFlagUsage = "Trust list file of the trusted issuers, it's reloaded when modified." +
+		" Alternatively, this can be set with the following environment variable: " + trustRegistryFileEnvKey
+	trustRegistryFileEnvKey = "RP_TRUST_REGISTRY_FILE"
+
+	trustRegistryURLFlagName  = "trust-registry-url"
+	trustRegistryURLFlagUsage = "URL of the remote trust list of the trusted issuers." +
+		" Alternatively, this can be set with the following environment variable: " + trustRegistryURLEnvKey
+	trustRegistryURLEnvKey = "RP_TRUST_REGISTRY_URL"
+
+	trustRegistryRefreshIntervalFlagName  = "trust-registry-refresh-interval"
+	trustRegistryRefreshIntervalFlagUsage = "Interval the remote trust list is fetched again, e.g. 30m." +
+		" Defaults to 10m." +
+		" Alternatively, this can be set with the following environment variable: " + trustRegistryRefreshIntervalEnvKey
+	trustRegistryRefreshIntervalEnvKey = "RP_TRUST_REGISTRY_REFRESH_INTERVAL"
+
+	trustMinAssuranceLevelFlagName  = "trust-min-assurance-level"
+	trustMinAssuranceLevelFlagUsage = "Assurance level the issuers must have at least to be trusted, low," +
+		" substantial or high. Defaults to low." +
+		" Alternatively, this can be set with the following environment variable: " + trustMinAssuranceLevelEnvKey
+	trustMinAssuranceLevelEnvKey = "RP_TRUST_MIN_ASSURANCE_LEVEL"
+
+	verifierProfilesFlagName  = "verifier-profiles"
+	verifierProfilesFlagUsage = "Path of the verifier profile JSON file, or directory of them, to be" +
+		" registered on start. Alternatively, this can be set with the following environment variable: " +
+		verifierProfilesEnvKey
+	verifierProfilesEnvKey = "RP_VERIFIER_PROFILES"
+
+	adminTokenFlagName  = "admin-token"
+	adminTokenFlagUsage = "Bearer token authorizing the admin API requests, e.g. the trusted issuer and" +
+		" the verifier profile updates." +
+		" The admin API is disabled if not set." +
+		" Alternatively, this can be set with the following environment variable: " + adminTokenEnvKey
+	adminTokenEnvKey = "RP_ADMIN_TOKEN" //nolint:gosec
+
+	vcsVerifierMode   = "vcs"
+	localVerifierMode = "local"
+
+	defaultWebhookEventSweepInterval = 5 * time.Minute
+
 	tokenLength2 = 2
 )
 
@@ -139,12 +213,26 @@ type server interface {
 }
 
 // HTTPServer represents an actual HTTP server implementation.
-type HTTPServer struct{}
+type HTTPServer struct {
+	// ClientAuth is the TLS client authentication policy of the server, client certificates aren't requested
+	// by default.
+	ClientAuth tls.ClientAuthType
+}
 
 // ListenAndServe starts the server using the standard Go HTTP server implementation.
 func (s *HTTPServer) ListenAndServe(host, certFile, keyFile string, router http.Handler) error {
 	if certFile != "" && keyFile != "" {
-		return http.ListenAndServeTLS(host, certFile, keyFile, router)
+		if s.ClientAuth == tls.NoClientCert {
+			return http.ListenAndServeTLS(host, certFile, keyFile, router)
+		}
+
+		srv := &http.Server{ // nolint:gosec
+			Addr:      host,
+			Handler:   router,
+			TLSConfig: &tls.Config{ClientAuth: s.ClientAuth, MinVersion: tls.VersionTLS12},
+		}
+
+		return srv.ListenAndServeTLS(certFile, keyFile)
 	}
 
 	return http.ListenAndServe(host, router)
@@ -167,6 +255,22 @@ type rpParameters struct {
 	dbParams           *common.DBParameters
 	accessTokenURL     string
 	apiGatewayURL      string
+	webhookEventTTL    time.Duration
+	webhookEventSweep  time.Duration
+	webhookParams      *common.WebhookParameters
+	didResolverURL     string
+	localVerification  bool
+	trustRegistry      *trustRegistryParameters
+	verifierProfiles   string
+	adminToken         string
+}
+
+type trustRegistryParameters struct {
+	enabled           bool
+	file              string
+	url               string
+	refreshInterval   time.Duration
+	minAssuranceLevel string
 }
 
 type oidcParameters struct {
@@ -252,6 +356,39 @@ func createStartCmd(srv server) *cobra.Command { // nolint: funlen,gocyclo
 
 			apiGatewayURL := cmdutils.GetUserSetOptionalVarFromString(cmd, apiGatewayURLFlagName, apiGatewayURLEnvKey)
 
+			webhookEventTTL, err := getDuration(cmd, webhookEventTTLFlagName, webhookEventTTLEnvKey, 0)
+			if err != nil {
+				return err
+			}
+
+			webhookEventSweep, err := getDuration(cmd, webhookEventSweepIntervalFlagName,
+				webhookEventSweepIntervalEnvKey, defaultWebhookEventSweepInterval)
+			if err != nil {
+				return err
+			}
+
+			webhookParams, err := common.WebhookParams(cmd)
+			if err != nil {
+				return err
+			}
+
+			didResolverURL := cmdutils.GetUserSetOptionalVarFromString(cmd, didResolverURLFlagName, didResolverURLEnvKey)
+
+			localVerification, err := getLocalVerification(cmd)
+			if err != nil {
+				return err
+			}
+
+			trustRegistry, err := getTrustRegistryParameters(cmd)
+			if err != nil {
+				return err
+			}
+
+			verifierProfiles := cmdutils.GetUserSetOptionalVarFromString(cmd,
+				verifierProfilesFlagName, verifierProfilesEnvKey)
+
+			adminToken := cmdutils.GetUserSetOptionalVarFromString(cmd, adminTokenFlagName, adminTokenEnvKey)
+
 			parameters := &rpParameters{
 				srv:                srv,
 				hostURL:            strings.TrimSpace(hostURL),
@@ -269,6 +406,14 @@ func createStartCmd(srv server) *cobra.Command { // nolint: funlen,gocyclo
 				dbParams:           dbParams,
 				accessTokenURL:     accessTokenURL,
 				apiGatewayURL:      apiGatewayURL,
+				webhookEventTTL:    webhookEventTTL,
+				webhookEventSweep:  webhookEventSweep,
+				webhookParams:      webhookParams,
+				didResolverURL:     strings.TrimSpace(didResolverURL),
+				localVerification:  localVerification,
+				trustRegistry:      trustRegistry,
+				verifierProfiles:   verifierProfiles,
+				adminToken:         adminToken,
 			}
 
 			return startRP(parameters)
@@ -362,6 +507,81 @@ func getRequestTokens(cmd *cobra.Command) (map[string]string, error) {
 	return tokens, nil
 }
 
+func getDuration(cmd *cobra.Command, flagName, envKey string, defaultValue time.Duration) (time.Duration, error) {
+	value := cmdutils.GetUserSetOptionalVarFromString(cmd, flagName, envKey)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value for %s [%s] : %w", flagName, value, err)
+	}
+
+	return d, nil
+}
+
+// getLocalVerification returns whether the presentations and the credentials are verified with the embedded
+// verifier.
+func getLocalVerification(cmd *cobra.Command) (bool, error) {
+	mode := cmdutils.GetUserSetOptionalVarFromString(cmd, verifierModeFlagName, verifierModeEnvKey)
+
+	switch strings.TrimSpace(mode) {
+	case "", vcsVerifierMode:
+		return false, nil
+	case localVerifierMode:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid value for %s [%s] : supported modes are %s and %s",
+			verifierModeFlagName, mode, vcsVerifierMode, localVerifierMode)
+	}
+}
+
+// getTrustRegistryParameters returns the trust registry parameters, the registry is enabled if the trust list
+// file or URL is set.
+func getTrustRegistryParameters(cmd *cobra.Command) (*trustRegistryParameters, error) {
+	params := &trustRegistryParameters{
+		file: cmdutils.GetUserSetOptionalVarFromString(cmd, trustRegistryFileFlagName, trustRegistryFileEnvKey),
+		url:  cmdutils.GetUserSetOptionalVarFromString(cmd, trustRegistryURLFlagName, trustRegistryURLEnvKey),
+		minAssuranceLevel: cmdutils.GetUserSetOptionalVarFromString(cmd, trustMinAssuranceLevelFlagName,
+			trustMinAssuranceLevelEnvKey),
+	}
+
+	enabled := cmdutils.GetUserSetOptionalVarFromString(cmd, trustRegistryEnabledFlagName, trustRegistryEnabledEnvKey)
+	if enabled != "" {
+		var err error
+
+		params.enabled, err = strconv.ParseBool(enabled)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s [%s] : %w", trustRegistryEnabledFlagName, enabled, err)
+		}
+	}
+
+	params.enabled = params.enabled || params.file != "" || params.url != ""
+
+	refreshInterval, err := getDuration(cmd, trustRegistryRefreshIntervalFlagName, trustRegistryRefreshIntervalEnvKey,
+		0)
+	if err != nil {
+		return nil, err
+	}
+
+	params.refreshInterval = refreshInterval
+
+	return params, nil
+}
+
+// requestClientCerts makes the HTTP server request the TLS client certificates if the webhook client CAs are set.
+// The certificates aren't required by the handshake, since only the webhook requests are authenticated with them.
+func requestClientCerts(srv server, clientCAs *x509.CertPool) {
+	if clientCAs == nil {
+		return
+	}
+
+	if s, ok := srv.(*HTTPServer); ok {
+		s.ClientAuth = tls.RequestClientCert
+	}
+}
+
 func getTLS(cmd *cobra.Command) (*tlsConfig, error) {
 	tlsCertFile, err := cmdutils.GetUserSetVarFromString(cmd, tlsCertFileFlagName,
 		tlsCertFileEnvKey, true)
@@ -422,6 +642,18 @@ func createFlags(startCmd *cobra.Command) {
 	startCmd.Flags().StringP(walletAuthURLFlagName, "", "", walletAuthURLFlagUsage)
 	startCmd.Flags().StringP(accessTokenURLFlagName, "", "", accessTokenURLFlagUsage)
 	startCmd.Flags().StringP(apiGatewayURLFlagName, "", "", apiGatewayURLFlagUsage)
+	startCmd.Flags().StringP(webhookEventTTLFlagName, "", "", webhookEventTTLFlagUsage)
+	startCmd.Flags().StringP(webhookEventSweepIntervalFlagName, "", "", webhookEventSweepIntervalFlagUsage)
+	startCmd.Flags().StringP(didResolverURLFlagName, "", "", didResolverURLFlagUsage)
+	startCmd.Flags().StringP(verifierModeFlagName, "", "", verifierModeFlagUsage)
+	startCmd.Flags().StringP(trustRegistryEnabledFlagName, "", "", trustRegistryEnabledFlagUsage)
+	startCmd.Flags().StringP(trustRegistryFileFlagName, "", "", trustRegistryFileFlagUsage)
+	startCmd.Flags().StringP(trustRegistryURLFlagName, "", "", trustRegistryURLFlagUsage)
+	startCmd.Flags().StringP(trustRegistryRefreshIntervalFlagName, "", "", trustRegistryRefreshIntervalFlagUsage)
+	startCmd.Flags().StringP(trustMinAssuranceLevelFlagName, "", "", trustMinAssuranceLevelFlagUsage)
+	startCmd.Flags().StringP(verifierProfilesFlagName, "", "", verifierProfilesFlagUsage)
+	startCmd.Flags().StringP(adminTokenFlagName, "", "", adminTokenFlagUsage)
+	common.WebhookFlags(startCmd)
 }
 
 func startRP(parameters *rpParameters) error { //nolint:funlen
@@ -439,26 +671,56 @@ func startRP(parameters *rpParameters) error { //nolint:funlen
 		return err
 	}
 
+	webhookClientCAs, err := parameters.webhookParams.ClientCertPool()
+	if err != nil {
+		return err
+	}
+
+	requestClientCerts(parameters.srv, webhookClientCAs)
+
+	clientTLSConfig := &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+
+	vdr, err := common.CreateVDR(parameters.didResolverURL,
+		&http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}})
+	if err != nil {
+		return err
+	}
+
 	cfg := &operation.Config{
-		VPHTML:                 "static/vp.html",
-		DIDCOMMVPHTML:          "static/didcommvp.html",
-		OIDCShareVPHTML:        "static/oidcvp.html",
-		VCSURL:                 parameters.vcServiceURL,
-		VCSV1URL:               parameters.vcV1ServiceURL,
-		TLSConfig:              &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
-		RequestTokens:          parameters.requestTokens,
-		TransientStoreProvider: transientStore,
-		OIDCProviderURL:        parameters.oidcParameters.oidcProviderURL,
-		OIDCClientID:           parameters.oidcParameters.oidcClientID,
-		OIDCClientSecret:       parameters.oidcParameters.oidcClientSecret,
-		OIDCCallbackURL:        parameters.oidcParameters.oidcCallbackURL,
-		WACIOIDCProviderURL:    parameters.waciOIDCParameters.oidcProviderURL,
-		WACIOIDCClientID:       parameters.waciOIDCParameters.oidcClientID,
-		WACIOIDCClientSecret:   parameters.waciOIDCParameters.oidcClientSecret,
-		WACIOIDCCallbackURL:    parameters.waciOIDCParameters.oidcCallbackURL,
-		WalletAuthURL:          parameters.walletAuthURL,
-		AccessTokenURL:         parameters.accessTokenURL,
-		APIGatewayURL:          parameters.apiGatewayURL,
+		VPHTML:                       "static/vp.html",
+		DIDCOMMVPHTML:                "static/didcommvp.html",
+		OIDCShareVPHTML:              "static/oidcvp.html",
+		VCSURL:                       parameters.vcServiceURL,
+		VCSV1URL:                     parameters.vcV1ServiceURL,
+		TLSConfig:                    clientTLSConfig,
+		RequestTokens:                parameters.requestTokens,
+		TransientStoreProvider:       transientStore,
+		OIDCProviderURL:              parameters.oidcParameters.oidcProviderURL,
+		OIDCClientID:                 parameters.oidcParameters.oidcClientID,
+		OIDCClientSecret:             parameters.oidcParameters.oidcClientSecret,
+		OIDCCallbackURL:              parameters.oidcParameters.oidcCallbackURL,
+		WACIOIDCProviderURL:          parameters.waciOIDCParameters.oidcProviderURL,
+		WACIOIDCClientID:             parameters.waciOIDCParameters.oidcClientID,
+		WACIOIDCClientSecret:         parameters.waciOIDCParameters.oidcClientSecret,
+		WACIOIDCCallbackURL:          parameters.waciOIDCParameters.oidcCallbackURL,
+		WalletAuthURL:                parameters.walletAuthURL,
+		AccessTokenURL:               parameters.accessTokenURL,
+		APIGatewayURL:                parameters.apiGatewayURL,
+		WebhookEventTTL:              parameters.webhookEventTTL,
+		WebhookEventSweepInterval:    parameters.webhookEventSweep,
+		WebhookSecrets:               parameters.webhookParams.Secrets,
+		WebhookSecretsFile:           parameters.webhookParams.SecretsFile,
+		WebhookClientCAs:             webhookClientCAs,
+		WebhookClientNames:           parameters.webhookParams.ClientNames,
+		VDRegistry:                   vdr,
+		LocalVerification:            parameters.localVerification,
+		TrustRegistryEnabled:         parameters.trustRegistry.enabled,
+		TrustRegistryFile:            parameters.trustRegistry.file,
+		TrustRegistryURL:             parameters.trustRegistry.url,
+		TrustRegistryRefreshInterval: parameters.trustRegistry.refreshInterval,
+		TrustMinAssuranceLevel:       parameters.trustRegistry.minAssuranceLevel,
+		VerifierProfilesPath:         parameters.verifierProfiles,
+		AdminToken:                   parameters.adminToken,
 	}
 
 	rpService, err := rp.New(cfg)
@@ -6,12 +6,15 @@ SPDX-License-Identifier: Apache-2.0
 package startcmd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -36,6 +39,145 @@ func TestListenAndServe(t *testing.T) {
 	require.Contains(t, err.Error(), "open test.key: no such file or directory")
 }
 
+func TestRequestClientCerts(t *testing.T) {
+	srv := &HTTPServer{}
+
+	requestClientCerts(srv, nil)
+	require.Equal(t, tls.NoClientCert, srv.ClientAuth)
+
+	requestClientCerts(srv, x509.NewCertPool())
+	require.Equal(t, tls.RequestClientCert, srv.ClientAuth)
+
+	err := srv.ListenAndServe("localhost:8080", "test.key", "test.cert", nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "open test.key: no such file or directory")
+
+	// other servers are left as is
+	requestClientCerts(&mockServer{}, x509.NewCertPool())
+}
+
+func TestStartCmdWithInvalidWebhookClientCACerts(t *testing.T) {
+	startCmd := GetStartCmd(&mockServer{})
+
+	args := getValidArgs(log.ParseString(log.ERROR), "")
+	args = append(args, flag+common.WebhookClientCACertsFlagName, "missing.crt")
+	startCmd.SetArgs(args)
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to load webhook client CA certs")
+}
+
+func TestStartCmdWithInvalidDIDResolverURL(t *testing.T) {
+	startCmd := GetStartCmd(&mockServer{})
+
+	args := getValidArgs(log.ParseString(log.ERROR), "")
+	args = append(args, flag+didResolverURLFlagName, ":invalid")
+	startCmd.SetArgs(args)
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to create new universal resolver vdr")
+}
+
+func TestStartCmdWithInvalidVerifierProfiles(t *testing.T) {
+	startCmd := GetStartCmd(&mockServer{})
+
+	path, cleanup := newTestOIDCProvider()
+	defer cleanup()
+
+	args := getValidArgs(log.ParseString(log.ERROR), path)
+	args = append(args, flag+verifierProfilesFlagName, "missing.json")
+	startCmd.SetArgs(args)
+
+	err := startCmd.Execute()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to create verifier profiles : read verifier profiles")
+}
+
+func TestStartCmdWithVerifierMode(t *testing.T) {
+	t.Run("local", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{flag + verifierModeFlagName, localVerifierMode}))
+
+		local, err := getLocalVerification(startCmd)
+		require.NoError(t, err)
+		require.True(t, local)
+	})
+
+	t.Run("vcs by default", func(t *testing.T) {
+		local, err := getLocalVerification(GetStartCmd(&mockServer{}))
+		require.NoError(t, err)
+		require.False(t, local)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs(log.ParseString(log.ERROR), "")
+		args = append(args, flag+verifierModeFlagName, "remote")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+verifierModeFlagName)
+	})
+}
+
+func TestStartCmdWithTrustRegistry(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{
+			flag + trustRegistryURLFlagName, "https://example.com/trust-list",
+			flag + trustRegistryRefreshIntervalFlagName, "30m",
+			flag + trustMinAssuranceLevelFlagName, "high",
+		}))
+
+		params, err := getTrustRegistryParameters(startCmd)
+		require.NoError(t, err)
+		require.Equal(t, &trustRegistryParameters{
+			enabled:           true,
+			url:               "https://example.com/trust-list",
+			refreshInterval:   30 * time.Minute,
+			minAssuranceLevel: "high",
+		}, params)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		params, err := getTrustRegistryParameters(GetStartCmd(&mockServer{}))
+		require.NoError(t, err)
+		require.False(t, params.enabled)
+
+		startCmd := GetStartCmd(&mockServer{})
+		require.NoError(t, startCmd.ParseFlags([]string{flag + trustRegistryEnabledFlagName, "true"}))
+
+		params, err = getTrustRegistryParameters(startCmd)
+		require.NoError(t, err)
+		require.True(t, params.enabled)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+		require.NoError(t, startCmd.ParseFlags([]string{flag + trustRegistryEnabledFlagName, "yes"}))
+
+		_, err := getTrustRegistryParameters(startCmd)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+trustRegistryEnabledFlagName)
+
+		startCmd = GetStartCmd(&mockServer{})
+
+		args := getValidArgs(log.ParseString(log.ERROR), "")
+		args = append(args, flag+trustRegistryRefreshIntervalFlagName, "often")
+		startCmd.SetArgs(args)
+
+		err = startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+trustRegistryRefreshIntervalFlagName)
+	})
+}
+
 func TestStartCmdContents(t *testing.T) {
 	startCmd := GetStartCmd(&mockServer{})
 
@@ -119,6 +261,39 @@ func TestWACIOIDCParam(t *testing.T) {
 	err := startCmd.Execute()
 	require.Error(t, err)
 }
+func TestGetDuration(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		d, err := getDuration(startCmd, webhookEventSweepIntervalFlagName, webhookEventSweepIntervalEnvKey,
+			defaultWebhookEventSweepInterval)
+		require.NoError(t, err)
+		require.Equal(t, defaultWebhookEventSweepInterval, d)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		require.NoError(t, startCmd.ParseFlags([]string{flag + webhookEventTTLFlagName, "30m"}))
+
+		d, err := getDuration(startCmd, webhookEventTTLFlagName, webhookEventTTLEnvKey, 0)
+		require.NoError(t, err)
+		require.Equal(t, 30*time.Minute, d)
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		startCmd := GetStartCmd(&mockServer{})
+
+		args := getValidArgs(log.ParseString(log.ERROR), "")
+		args = append(args, flag+webhookEventTTLFlagName, "invalid")
+		startCmd.SetArgs(args)
+
+		err := startCmd.Execute()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid value for "+webhookEventTTLFlagName)
+	})
+}
+
 func TestStartCmdValidArgsEnvVar(t *testing.T) {
 	startCmd := GetStartCmd(&mockServer{})
 
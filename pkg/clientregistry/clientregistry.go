@@ -0,0 +1,371 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clientregistry
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/trustbloc/edge-core/pkg/log"
+)
+
+const (
+	// store
+	clientStoreName = "issuer_oidc_clients"
+	clientTagName   = "oidcClient"
+
+	// AuthorizationCodeGrantType is the OAuth 2.0 authorization code grant.
+	AuthorizationCodeGrantType = "authorization_code"
+	// PreAuthorizedCodeGrantType is the OpenID4VCI pre-authorized code grant.
+	PreAuthorizedCodeGrantType = "urn:ietf:params:oauth:grant-type:pre-authorized_code"
+
+	// CodeResponseType is the authorization code response type.
+	CodeResponseType = "code"
+
+	// NoneAuthMethod is used by the public clients, which have no client secret.
+	NoneAuthMethod = "none"
+	// ClientSecretBasicAuthMethod authenticates the client using HTTP basic authentication.
+	ClientSecretBasicAuthMethod = "client_secret_basic"
+	// ClientSecretPostAuthMethod authenticates the client using the credentials in the request body.
+	ClientSecretPostAuthMethod = "client_secret_post"
+
+	// InvalidRedirectURIError is the RFC 7591 error code of an invalid redirect URI.
+	InvalidRedirectURIError = "invalid_redirect_uri"
+	// InvalidClientMetadataError is the RFC 7591 error code of invalid client metadata.
+	InvalidClientMetadataError = "invalid_client_metadata"
+
+	clientSecretSize = 32
+)
+
+var logger = log.New("sandbox-clientregistry")
+
+// ErrClientNotFound is returned when no client is registered with the given ID.
+var ErrClientNotFound = errors.New("client not found")
+
+// Client is the registered OAuth 2.0 client, metadata fields follow RFC 7591.
+type Client struct {
+	ClientID                string   `json:"client_id"`
+	ClientSecret            string   `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64    `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   int64    `json:"client_secret_expires_at"`
+	ClientName              string   `json:"client_name,omitempty"`
+	RedirectURIs            []string `json:"redirect_uris,omitempty"`
+	GrantTypes              []string `json:"grant_types,omitempty"`
+	ResponseTypes           []string `json:"response_types,omitempty"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
+	Scope                   string   `json:"scope,omitempty"`
+}
+
+// RegistrationError is returned when the client metadata is rejected, Code is the RFC 7591 error code.
+type RegistrationError struct {
+	Code        string
+	Description string
+}
+
+func (e *RegistrationError) Error() string {
+	return fmt.Sprintf("%s : %s", e.Code, e.Description)
+}
+
+// Registry of the OAuth 2.0 clients of the issuer.
+type Registry struct {
+	store     storage.Store
+	allowlist []string
+}
+
+// Opt configures the registry.
+type Opt func(r *Registry)
+
+// WithRedirectURIAllowlist restricts the redirect URIs to the given ones. An allowlist entry ending with "*"
+// allows any redirect URI with that prefix.
+func WithRedirectURIAllowlist(allowlist ...string) Opt {
+	return func(r *Registry) {
+		r.allowlist = allowlist
+	}
+}
+
+// New returns new client registry backed by the given storage provider.
+func New(provider storage.Provider, opts ...Opt) (*Registry, error) {
+	store, err := provider.OpenStore(clientStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open client store : %w", err)
+	}
+
+	err = provider.SetStoreConfig(clientStoreName, storage.StoreConfiguration{TagNames: []string{clientTagName}})
+	if err != nil {
+		return nil, fmt.Errorf("set client store configuration : %w", err)
+	}
+
+	r := &Registry{store: store}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r, nil
+}
+
+// Register validates the client metadata, applies the defaults and saves the client. Client ID and, unless
+// the client is a public one, client secret are generated if not provided. An existing client with the same ID
+// is replaced.
+func (r *Registry) Register(client *Client) (*Client, error) {
+	c := *client
+
+	err := r.validate(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ClientID == "" {
+		c.ClientID = uuid.NewString()
+	}
+
+	if c.TokenEndpointAuthMethod == NoneAuthMethod {
+		c.ClientSecret = ""
+	} else if c.ClientSecret == "" {
+		c.ClientSecret, err = generateSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.ClientIDIssuedAt = time.Now().Unix()
+	c.ClientSecretExpiresAt = 0
+
+	clientBytes, err := json.Marshal(&c)
+	if err != nil {
+		return nil, fmt.Errorf("marshal client : %w", err)
+	}
+
+	err = r.store.Put(c.ClientID, clientBytes, storage.Tag{Name: clientTagName})
+	if err != nil {
+		return nil, fmt.Errorf("save client : %w", err)
+	}
+
+	return &c, nil
+}
+
+// Get returns the registered client.
+func (r *Registry) Get(clientID string) (*Client, error) {
+	clientBytes, err := r.store.Get(clientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrDataNotFound) {
+			return nil, ErrClientNotFound
+		}
+
+		return nil, fmt.Errorf("get client : %w", err)
+	}
+
+	client := &Client{}
+
+	err = json.Unmarshal(clientBytes, client)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal client : %w", err)
+	}
+
+	return client, nil
+}
+
+// List returns all the registered clients sorted by client ID.
+func (r *Registry) List() ([]*Client, error) {
+	iter, err := r.store.Query(clientTagName)
+	if err != nil {
+		return nil, fmt.Errorf("query clients : %w", err)
+	}
+
+	defer func() {
+		if e := iter.Close(); e != nil {
+			logger.Warnf("failed to close iterator : %s", e)
+		}
+	}()
+
+	clients := []*Client{}
+
+	more, err := iter.Next()
+
+	for ; err == nil && more; more, err = iter.Next() {
+		clientBytes, e := iter.Value()
+		if e != nil {
+			return nil, fmt.Errorf("get client : %w", e)
+		}
+
+		client := &Client{}
+
+		e = json.Unmarshal(clientBytes, client)
+		if e != nil {
+			return nil, fmt.Errorf("unmarshal client : %w", e)
+		}
+
+		clients = append(clients, client)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("iterate clients : %w", err)
+	}
+
+	sort.Slice(clients, func(i, j int) bool { return clients[i].ClientID < clients[j].ClientID })
+
+	return clients, nil
+}
+
+// Delete removes the registered client.
+func (r *Registry) Delete(clientID string) error {
+	_, err := r.Get(clientID)
+	if err != nil {
+		return err
+	}
+
+	err = r.store.Delete(clientID)
+	if err != nil {
+		return fmt.Errorf("delete client : %w", err)
+	}
+
+	return nil
+}
+
+// AllowedRedirectURI checks the redirect URI against the allowlist, any URI is allowed if there is no allowlist.
+func (r *Registry) AllowedRedirectURI(redirectURI string) bool {
+	if len(r.allowlist) == 0 {
+		return true
+	}
+
+	for _, allowed := range r.allowlist {
+		if prefix := strings.TrimSuffix(allowed, "*"); prefix != allowed {
+			if strings.HasPrefix(redirectURI, prefix) {
+				return true
+			}
+
+			continue
+		}
+
+		if allowed == redirectURI {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExactRedirectURIs returns the allowlist entries which allow a single redirect URI, i.e. the ones which aren't
+// prefixes.
+func (r *Registry) ExactRedirectURIs() []string {
+	var redirectURIs []string
+
+	for _, allowed := range r.allowlist {
+		if !strings.HasSuffix(allowed, "*") {
+			redirectURIs = append(redirectURIs, allowed)
+		}
+	}
+
+	return redirectURIs
+}
+
+// AllowsRedirectURI checks whether the redirect URI is one of the registered redirect URIs of the client.
+func (c *Client) AllowsRedirectURI(redirectURI string) bool {
+	return contains(c.RedirectURIs, redirectURI)
+}
+
+// AllowsGrantType checks whether the client is registered for the grant type.
+func (c *Client) AllowsGrantType(grantType string) bool {
+	return contains(c.GrantTypes, grantType)
+}
+
+// AllowsResponseType checks whether the client is registered for the response type.
+func (c *Client) AllowsResponseType(responseType string) bool {
+	return contains(c.ResponseTypes, responseType)
+}
+
+func (r *Registry) validate(c *Client) error {
+	if len(c.GrantTypes) == 0 {
+		c.GrantTypes = []string{AuthorizationCodeGrantType}
+	}
+
+	for _, grantType := range c.GrantTypes {
+		if grantType != AuthorizationCodeGrantType && grantType != PreAuthorizedCodeGrantType {
+			return &RegistrationError{Code: InvalidClientMetadataError,
+				Description: fmt.Sprintf("unsupported grant type %s", grantType)}
+		}
+	}
+
+	if len(c.ResponseTypes) == 0 && c.AllowsGrantType(AuthorizationCodeGrantType) {
+		c.ResponseTypes = []string{CodeResponseType}
+	}
+
+	for _, responseType := range c.ResponseTypes {
+		if responseType != CodeResponseType {
+			return &RegistrationError{Code: InvalidClientMetadataError,
+				Description: fmt.Sprintf("unsupported response type %s", responseType)}
+		}
+	}
+
+	switch c.TokenEndpointAuthMethod {
+	case "":
+		c.TokenEndpointAuthMethod = ClientSecretBasicAuthMethod
+	case NoneAuthMethod, ClientSecretBasicAuthMethod, ClientSecretPostAuthMethod:
+	default:
+		return &RegistrationError{Code: InvalidClientMetadataError,
+			Description: fmt.Sprintf("unsupported token endpoint auth method %s", c.TokenEndpointAuthMethod)}
+	}
+
+	if c.AllowsGrantType(AuthorizationCodeGrantType) && len(c.RedirectURIs) == 0 {
+		return &RegistrationError{Code: InvalidRedirectURIError,
+			Description: "redirect URIs are required for the authorization code grant"}
+	}
+
+	for _, redirectURI := range c.RedirectURIs {
+		err := r.validateRedirectURI(redirectURI)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) validateRedirectURI(redirectURI string) error {
+	u, err := url.Parse(redirectURI)
+	if err != nil || !u.IsAbs() || u.Fragment != "" {
+		return &RegistrationError{Code: InvalidRedirectURIError,
+			Description: fmt.Sprintf("redirect URI %s must be an absolute URI without fragment", redirectURI)}
+	}
+
+	if !r.AllowedRedirectURI(redirectURI) {
+		return &RegistrationError{Code: InvalidRedirectURIError,
+			Description: fmt.Sprintf("redirect URI %s is not allowed", redirectURI)}
+	}
+
+	return nil
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, clientSecretSize)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", fmt.Errorf("generate client secret : %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
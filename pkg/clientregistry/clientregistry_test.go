@@ -0,0 +1,271 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clientregistry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r, err := New(mem.NewProvider(), WithRedirectURIAllowlist("https://wallet.example.com/cb"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"https://wallet.example.com/cb"}, r.allowlist)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open client store : open error")
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{
+			OpenStoreReturn:   &mockstorage.Store{},
+			ErrSetStoreConfig: errors.New("config error"),
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "set client store configuration : config error")
+	})
+}
+
+func TestRegistry_Register(t *testing.T) {
+	t.Run("success - defaults", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		client, err := r.Register(&Client{
+			ClientName:   "wallet",
+			RedirectURIs: []string{"https://wallet.example.com/cb"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, client.ClientID)
+		require.NotEmpty(t, client.ClientSecret)
+		require.NotZero(t, client.ClientIDIssuedAt)
+		require.Equal(t, []string{AuthorizationCodeGrantType}, client.GrantTypes)
+		require.Equal(t, []string{CodeResponseType}, client.ResponseTypes)
+		require.Equal(t, ClientSecretBasicAuthMethod, client.TokenEndpointAuthMethod)
+
+		saved, err := r.Get(client.ClientID)
+		require.NoError(t, err)
+		require.Equal(t, client, saved)
+	})
+
+	t.Run("success - public pre-authorized code client", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		client, err := r.Register(&Client{
+			ClientID:                "mobile-wallet",
+			ClientSecret:            "ignored",
+			GrantTypes:              []string{PreAuthorizedCodeGrantType},
+			TokenEndpointAuthMethod: NoneAuthMethod,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "mobile-wallet", client.ClientID)
+		require.Empty(t, client.ClientSecret)
+		require.Empty(t, client.ResponseTypes)
+		require.True(t, client.AllowsGrantType(PreAuthorizedCodeGrantType))
+		require.False(t, client.AllowsGrantType(AuthorizationCodeGrantType))
+	})
+
+	t.Run("invalid client metadata", func(t *testing.T) {
+		r, err := New(mem.NewProvider(), WithRedirectURIAllowlist("https://wallet.example.com/*"))
+		require.NoError(t, err)
+
+		for _, tc := range []struct {
+			name   string
+			client *Client
+			code   string
+		}{
+			{
+				name:   "unsupported grant type",
+				client: &Client{GrantTypes: []string{"implicit"}},
+				code:   InvalidClientMetadataError,
+			},
+			{
+				name:   "unsupported response type",
+				client: &Client{ResponseTypes: []string{"token"}, RedirectURIs: []string{"https://wallet.example.com"}},
+				code:   InvalidClientMetadataError,
+			},
+			{
+				name:   "unsupported token endpoint auth method",
+				client: &Client{TokenEndpointAuthMethod: "private_key_jwt"},
+				code:   InvalidClientMetadataError,
+			},
+			{
+				name:   "missing redirect uri",
+				client: &Client{},
+				code:   InvalidRedirectURIError,
+			},
+			{
+				name:   "relative redirect uri",
+				client: &Client{RedirectURIs: []string{"/cb"}},
+				code:   InvalidRedirectURIError,
+			},
+			{
+				name:   "redirect uri with fragment",
+				client: &Client{RedirectURIs: []string{"https://wallet.example.com/cb#fragment"}},
+				code:   InvalidRedirectURIError,
+			},
+			{
+				name:   "redirect uri not in allowlist",
+				client: &Client{RedirectURIs: []string{"https://attacker.example.com/cb"}},
+				code:   InvalidRedirectURIError,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := r.Register(tc.client)
+				require.Error(t, err)
+
+				var regErr *RegistrationError
+
+				require.True(t, errors.As(err, &regErr))
+				require.Equal(t, tc.code, regErr.Code)
+				require.Contains(t, err.Error(), tc.code)
+			})
+		}
+	})
+
+	t.Run("save error", func(t *testing.T) {
+		r, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("put error")}})
+		require.NoError(t, err)
+
+		_, err = r.Register(&Client{RedirectURIs: []string{"https://wallet.example.com/cb"}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save client : put error")
+	})
+}
+
+func TestRegistry_Get(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		_, err = r.Get("unknown")
+		require.ErrorIs(t, err, ErrClientNotFound)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		r, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrGet: errors.New("get error")}})
+		require.NoError(t, err)
+
+		_, err = r.Get("client")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get client : get error")
+	})
+
+	t.Run("invalid client", func(t *testing.T) {
+		r, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{GetReturn: []byte("{")}})
+		require.NoError(t, err)
+
+		_, err = r.Get("client")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal client")
+	})
+}
+
+func TestRegistry_ListAndDelete(t *testing.T) {
+	r, err := New(mem.NewProvider())
+	require.NoError(t, err)
+
+	clients, err := r.List()
+	require.NoError(t, err)
+	require.Empty(t, clients)
+
+	for _, id := range []string{"b", "a", "c"} {
+		_, err = r.Register(&Client{ClientID: id, RedirectURIs: []string{"https://wallet.example.com/" + id}})
+		require.NoError(t, err)
+	}
+
+	clients, err = r.List()
+	require.NoError(t, err)
+	require.Len(t, clients, 3)
+	require.Equal(t, "a", clients[0].ClientID)
+	require.Equal(t, "c", clients[2].ClientID)
+
+	require.NoError(t, r.Delete("b"))
+	require.ErrorIs(t, r.Delete("b"), ErrClientNotFound)
+
+	clients, err = r.List()
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+
+	_, err = r.Get("b")
+	require.ErrorIs(t, err, ErrClientNotFound)
+}
+
+func TestRegistry_ListErrors(t *testing.T) {
+	for iter, errMsg := range map[*mockstorage.Iterator]string{
+		{ErrNext: errors.New("next error")}:                     "iterate clients : next error",
+		{NextReturn: true, ErrValue: errors.New("value error")}: "get client : value error",
+		{NextReturn: true, ValueReturn: []byte("{")}:            "unmarshal client",
+	} {
+		r := &Registry{store: &mockstorage.Store{QueryReturn: iter}}
+
+		_, err := r.List()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errMsg)
+	}
+
+	r := &Registry{store: &mockstorage.Store{ErrQuery: errors.New("query error")}}
+
+	_, err := r.List()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query clients : query error")
+}
+
+func TestRegistry_DeleteError(t *testing.T) {
+	r := &Registry{store: &mockstorage.Store{GetReturn: []byte("{}"), ErrDelete: errors.New("delete error")}}
+
+	err := r.Delete("client")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "delete client : delete error")
+
+	r = &Registry{store: &mockstorage.Store{ErrGet: storage.ErrDataNotFound}}
+	require.ErrorIs(t, r.Delete("client"), ErrClientNotFound)
+}
+
+func TestRegistry_AllowedRedirectURI(t *testing.T) {
+	r := &Registry{}
+	require.True(t, r.AllowedRedirectURI("https://any.example.com"))
+
+	r = &Registry{allowlist: []string{"https://wallet.example.com/cb", "https://mobile.example.com/*"}}
+	require.True(t, r.AllowedRedirectURI("https://wallet.example.com/cb"))
+	require.False(t, r.AllowedRedirectURI("https://wallet.example.com/cb/other"))
+	require.True(t, r.AllowedRedirectURI("https://mobile.example.com/app/cb"))
+	require.False(t, r.AllowedRedirectURI("https://attacker.example.com/cb"))
+}
+
+func TestRegistry_ExactRedirectURIs(t *testing.T) {
+	r := &Registry{}
+	require.Empty(t, r.ExactRedirectURIs())
+
+	r = &Registry{allowlist: []string{"https://wallet.example.com/cb", "https://mobile.example.com/*"}}
+	require.Equal(t, []string{"https://wallet.example.com/cb"}, r.ExactRedirectURIs())
+}
+
+func TestClient_Allows(t *testing.T) {
+	c := &Client{
+		RedirectURIs:  []string{"https://wallet.example.com/cb"},
+		GrantTypes:    []string{AuthorizationCodeGrantType},
+		ResponseTypes: []string{CodeResponseType},
+	}
+
+	require.True(t, c.AllowsRedirectURI("https://wallet.example.com/cb"))
+	require.False(t, c.AllowsRedirectURI("https://wallet.example.com/cb2"))
+	require.True(t, c.AllowsGrantType(AuthorizationCodeGrantType))
+	require.False(t, c.AllowsGrantType(PreAuthorizedCodeGrantType))
+	require.True(t, c.AllowsResponseType(CodeResponseType))
+	require.False(t, c.AllowsResponseType("token"))
+}
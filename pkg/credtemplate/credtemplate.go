@@ -0,0 +1,544 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credtemplate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/trustbloc/edge-core/pkg/log"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// store
+	templateStoreName = "issuer_credential_templates"
+	templateTagName   = "credentialTemplate"
+
+	// DefaultTemplateID is the ID of the built-in template used for the scopes without a template.
+	DefaultTemplateID = "default"
+	// PermanentResidentCardTemplateID is the ID of the built-in template of the external subject data.
+	PermanentResidentCardTemplateID = "PermanentResidentCard"
+
+	// VerifiableCredentialType is the type of every credential.
+	VerifiableCredentialType = "VerifiableCredential"
+
+	// CredentialsContext is the base context of every credential.
+	CredentialsContext = "https://www.w3.org/2018/credentials/v1"
+	// TrustBlocExampleContext is the context of the sample credentials.
+	TrustBlocExampleContext = "https://trustbloc.github.io/context/vc/examples-ext-v1.jsonld"
+	// CitizenshipContext is the context of the permanent resident card.
+	CitizenshipContext = "https://w3id.org/citizenship/v1"
+
+	// source field path separator
+	pathSeparator = "."
+)
+
+var logger = log.New("sandbox-credtemplate")
+
+var (
+	// ErrTemplateNotFound is returned when no template is registered with the given ID.
+	ErrTemplateNotFound = errors.New("credential template not found")
+	// ErrInvalidTemplate is returned when the template is rejected.
+	ErrInvalidTemplate = errors.New("invalid credential template")
+	// ErrBuiltInTemplate is returned when deleting the built-in template.
+	ErrBuiltInTemplate = errors.New("built-in credential template can't be deleted")
+	// ErrInvalidSubject is returned when the credential subject doesn't match the schema of the template.
+	ErrInvalidSubject = errors.New("invalid credential subject")
+)
+
+// Template declares how the credential of a type is built from the subject data.
+type Template struct {
+	ID string `json:"id"`
+	// Scopes resolved to the template besides its ID.
+	Scopes []string `json:"scopes,omitempty"`
+	// Type of the credential besides VerifiableCredential, the resolved scope if not set.
+	Type string `json:"type,omitempty"`
+	// Contexts of the credential besides the base credentials context.
+	Contexts []string `json:"contexts,omitempty"`
+	// SubjectField is the field of the subject data holding the subject, the whole subject data if not set.
+	SubjectField string `json:"subjectField,omitempty"`
+	// Mapping maps credentialSubject fields to the source fields, nested source fields are separated by dots.
+	// The source is copied as it is if not set.
+	Mapping map[string]string `json:"mapping,omitempty"`
+	// ExcludeFields are the source fields left out of the copied credentialSubject.
+	ExcludeFields []string `json:"excludeFields,omitempty"`
+	// Name and Description of the credential.
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Display metadata of the credential advertised by the OIDC issuer.
+	Display []Display `json:"display,omitempty"`
+	Expiry  *Expiry   `json:"expiry,omitempty"`
+	// Schema is the JSON schema the credentialSubject is validated against.
+	Schema json.RawMessage `json:"schema,omitempty"`
+}
+
+// Display is the OpenID4VCI display metadata of the credential.
+type Display struct {
+	Name            string `json:"name"`
+	Locale          string `json:"locale,omitempty"`
+	Description     string `json:"description,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	TextColor       string `json:"text_color,omitempty"`
+	Logo            *Logo  `json:"logo,omitempty"`
+}
+
+// Logo of the credential.
+type Logo struct {
+	URL     string `json:"url"`
+	AltText string `json:"alt_text,omitempty"`
+}
+
+// Expiry rule of the credential, fixed expiration date takes precedence over the validity period.
+type Expiry struct {
+	// ValidFor is the validity period of the credential from the issuance, e.g. "8760h".
+	ValidFor       string     `json:"validFor,omitempty"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+}
+
+// Credential is the unsigned content of the credential built by the template.
+type Credential struct {
+	Context     []string
+	Types       []string
+	Subject     interface{}
+	Name        string
+	Description string
+	Expired     *time.Time
+}
+
+// Registry of the credential templates. Registered templates take precedence over the built-in ones.
+type Registry struct {
+	store    storage.Store
+	builtIns map[string]*Template
+}
+
+// New returns new credential template registry backed by the given storage provider.
+func New(provider storage.Provider) (*Registry, error) {
+	store, err := provider.OpenStore(templateStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open credential template store : %w", err)
+	}
+
+	err = provider.SetStoreConfig(templateStoreName, storage.StoreConfiguration{TagNames: []string{templateTagName}})
+	if err != nil {
+		return nil, fmt.Errorf("set credential template store configuration : %w", err)
+	}
+
+	return &Registry{store: store, builtIns: builtInTemplates()}, nil
+}
+
+// builtInTemplates returns the templates of the credentials the issuer was issuing before templates were
+// introduced: the permanent resident card of the external subject data and the credential of the CMS user data
+// typed by the scope.
+func builtInTemplates() map[string]*Template {
+	return map[string]*Template{
+		DefaultTemplateID: {
+			ID:            DefaultTemplateID,
+			Contexts:      []string{TrustBlocExampleContext},
+			ExcludeFields: []string{"created_at", "updated_at", "userid", "vcmetadata"},
+		},
+		PermanentResidentCardTemplateID: {
+			ID:           PermanentResidentCardTemplateID,
+			Scopes:       []string{"subject_data"},
+			Type:         "PermanentResidentCard",
+			Contexts:     []string{CitizenshipContext},
+			SubjectField: "subjectData",
+			Name:         "Permanent Resident Card",
+		},
+	}
+}
+
+// Put validates and saves the template. An existing template with the same ID is replaced.
+func (r *Registry) Put(template *Template) error {
+	err := template.validate()
+	if err != nil {
+		return err
+	}
+
+	templateBytes, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("marshal credential template : %w", err)
+	}
+
+	err = r.store.Put(template.ID, templateBytes, storage.Tag{Name: templateTagName})
+	if err != nil {
+		return fmt.Errorf("save credential template : %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the template.
+func (r *Registry) Get(id string) (*Template, error) {
+	templateBytes, err := r.store.Get(id)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		if template, ok := r.builtIns[id]; ok {
+			return template, nil
+		}
+
+		return nil, ErrTemplateNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get credential template : %w", err)
+	}
+
+	template := &Template{}
+
+	err = json.Unmarshal(templateBytes, template)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal credential template : %w", err)
+	}
+
+	return template, nil
+}
+
+// List returns all the templates sorted by ID.
+func (r *Registry) List() ([]*Template, error) {
+	iter, err := r.store.Query(templateTagName)
+	if err != nil {
+		return nil, fmt.Errorf("query credential templates : %w", err)
+	}
+
+	defer func() {
+		if e := iter.Close(); e != nil {
+			logger.Warnf("failed to close iterator : %s", e)
+		}
+	}()
+
+	templates := map[string]*Template{}
+
+	for id, template := range r.builtIns {
+		templates[id] = template
+	}
+
+	more, err := iter.Next()
+
+	for ; err == nil && more; more, err = iter.Next() {
+		templateBytes, e := iter.Value()
+		if e != nil {
+			return nil, fmt.Errorf("get credential template : %w", e)
+		}
+
+		template := &Template{}
+
+		e = json.Unmarshal(templateBytes, template)
+		if e != nil {
+			return nil, fmt.Errorf("unmarshal credential template : %w", e)
+		}
+
+		templates[template.ID] = template
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("iterate credential templates : %w", err)
+	}
+
+	result := make([]*Template, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, template)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return result, nil
+}
+
+// Delete removes the registered template, the built-in template with the same ID, if any, applies again.
+func (r *Registry) Delete(id string) error {
+	_, err := r.store.Get(id)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		if _, ok := r.builtIns[id]; ok {
+			return ErrBuiltInTemplate
+		}
+
+		return ErrTemplateNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("get credential template : %w", err)
+	}
+
+	err = r.store.Delete(id)
+	if err != nil {
+		return fmt.Errorf("delete credential template : %w", err)
+	}
+
+	return nil
+}
+
+// Resolve returns the template of the scope, which is either the template with the scope as ID or the first one
+// by ID declaring the scope. The default template is returned if there is no such template.
+func (r *Registry) Resolve(scope string) (*Template, error) {
+	if scope == "" {
+		return r.Get(DefaultTemplateID)
+	}
+
+	template, err := r.Get(scope)
+	if err == nil || !errors.Is(err, ErrTemplateNotFound) {
+		return template, err
+	}
+
+	templates, err := r.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range templates {
+		if contains(t.Scopes, scope) {
+			return t, nil
+		}
+	}
+
+	return r.Get(DefaultTemplateID)
+}
+
+// Load registers the templates of the file, or of every JSON file of the directory. File holds either a single
+// template or an array of them.
+func (r *Registry) Load(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("read credential templates : %w", err)
+	}
+
+	files := []string{path}
+
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return fmt.Errorf("read credential templates : %w", err)
+		}
+	}
+
+	for _, file := range files {
+		templates, err := readTemplates(file)
+		if err != nil {
+			return err
+		}
+
+		for _, template := range templates {
+			err = r.Put(template)
+			if err != nil {
+				return fmt.Errorf("load credential template from %s : %w", file, err)
+			}
+		}
+
+		logger.Infof("loaded %d credential templates from %s", len(templates), file)
+	}
+
+	return nil
+}
+
+func readTemplates(file string) ([]*Template, error) {
+	templatesBytes, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("read credential templates : %w", err)
+	}
+
+	var templates []*Template
+
+	if strings.HasPrefix(strings.TrimSpace(string(templatesBytes)), "[") {
+		err = json.Unmarshal(templatesBytes, &templates)
+	} else {
+		template := &Template{}
+		err = json.Unmarshal(templatesBytes, template)
+		templates = append(templates, template)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal credential templates of %s : %w", file, err)
+	}
+
+	return templates, nil
+}
+
+// Build builds the credential of the scope from the subject data, which isn't modified. The credential subject
+// is validated against the schema of the template.
+func (t *Template) Build(data map[string]interface{}, scope string, now time.Time) (*Credential, error) {
+	credType := t.Type
+	if credType == "" {
+		credType = scope
+	}
+
+	cred := &Credential{
+		Context:     append([]string{CredentialsContext}, t.Contexts...),
+		Types:       []string{VerifiableCredentialType, credType},
+		Name:        t.Name,
+		Description: t.Description,
+	}
+
+	var source interface{} = data
+	if t.SubjectField != "" {
+		source = data[t.SubjectField]
+	}
+
+	cred.Subject = t.subject(source)
+
+	err := t.validateSubject(cred.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	cred.Expired, err = t.expirationDate(now)
+	if err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+// subject maps or copies the source fields, source which isn't an object is the subject as it is.
+func (t *Template) subject(source interface{}) interface{} {
+	fields, ok := source.(map[string]interface{})
+	if !ok {
+		return source
+	}
+
+	subject := map[string]interface{}{}
+
+	if len(t.Mapping) == 0 {
+		for k, v := range fields {
+			if !contains(t.ExcludeFields, k) {
+				subject[k] = v
+			}
+		}
+
+		return subject
+	}
+
+	for field, path := range t.Mapping {
+		if v, found := lookup(fields, path); found {
+			subject[field] = v
+		}
+	}
+
+	return subject
+}
+
+func (t *Template) validateSubject(subject interface{}) error {
+	if len(t.Schema) == 0 {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(t.Schema), gojsonschema.NewGoLoader(subject))
+	if err != nil {
+		return fmt.Errorf("validate credential subject : %w", err)
+	}
+
+	if !result.Valid() {
+		var msgs []string
+		for _, e := range result.Errors() {
+			msgs = append(msgs, e.String())
+		}
+
+		return fmt.Errorf("%w : %s", ErrInvalidSubject, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+func (t *Template) expirationDate(now time.Time) (*time.Time, error) {
+	if t.Expiry == nil {
+		return nil, nil
+	}
+
+	if t.Expiry.ExpirationDate != nil {
+		return t.Expiry.ExpirationDate, nil
+	}
+
+	if t.Expiry.ValidFor == "" {
+		return nil, nil
+	}
+
+	validFor, err := time.ParseDuration(t.Expiry.ValidFor)
+	if err != nil {
+		return nil, fmt.Errorf("parse validity period : %w", err)
+	}
+
+	expired := now.Add(validFor)
+
+	return &expired, nil
+}
+
+func (t *Template) validate() error {
+	if t.ID == "" {
+		return fmt.Errorf("%w : missing id", ErrInvalidTemplate)
+	}
+
+	for _, ctx := range t.Contexts {
+		if ctx == "" {
+			return fmt.Errorf("%w : empty context", ErrInvalidTemplate)
+		}
+	}
+
+	for field, path := range t.Mapping {
+		if field == "" || path == "" {
+			return fmt.Errorf("%w : empty mapping of '%s'", ErrInvalidTemplate, field)
+		}
+	}
+
+	for _, d := range t.Display {
+		if d.Name == "" {
+			return fmt.Errorf("%w : missing display name", ErrInvalidTemplate)
+		}
+	}
+
+	if t.Expiry != nil && t.Expiry.ValidFor != "" {
+		validFor, err := time.ParseDuration(t.Expiry.ValidFor)
+		if err != nil || validFor <= 0 {
+			return fmt.Errorf("%w : invalid validity period %s", ErrInvalidTemplate, t.Expiry.ValidFor)
+		}
+	}
+
+	if len(t.Schema) > 0 {
+		_, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(t.Schema))
+		if err != nil {
+			return fmt.Errorf("%w : invalid schema : %s", ErrInvalidTemplate, err)
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the value of the dot separated path of the nested fields.
+func lookup(fields map[string]interface{}, path string) (interface{}, bool) {
+	names := strings.Split(path, pathSeparator)
+
+	for i, name := range names {
+		v, ok := fields[name]
+		if !ok {
+			return nil, false
+		}
+
+		if i == len(names)-1 {
+			return v, true
+		}
+
+		if fields, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+
+	return nil, false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
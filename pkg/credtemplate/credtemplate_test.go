@@ -0,0 +1,338 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package credtemplate
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const driversLicenseTemplate = `{
+	"id": "DriversLicense",
+	"scopes": ["mDL", "driver_license"],
+	"type": "mDL",
+	"contexts": ["https://w3id.org/vdl/v1"],
+	"mapping": {
+		"givenName": "first_name",
+		"familyName": "last_name",
+		"city": "address.city"
+	},
+	"name": "Driver's License",
+	"display": [{"name": "Driver's License", "locale": "en-US", "background_color": "#12107c"}],
+	"expiry": {"validFor": "24h"},
+	"schema": {
+		"type": "object",
+		"required": ["givenName", "familyName"],
+		"properties": {"givenName": {"type": "string"}}
+	}
+}`
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+		require.NotNil(t, r)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open credential template store : open error")
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{
+			OpenStoreReturn:   &mockstorage.Store{},
+			ErrSetStoreConfig: errors.New("config error"),
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "set credential template store configuration : config error")
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("put, get, list and delete", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		require.NoError(t, r.Put(parseTemplate(t, driversLicenseTemplate)))
+
+		template, err := r.Get("DriversLicense")
+		require.NoError(t, err)
+		require.Equal(t, "mDL", template.Type)
+		require.Equal(t, "#12107c", template.Display[0].BackgroundColor)
+
+		templates, err := r.List()
+		require.NoError(t, err)
+		require.Len(t, templates, 3)
+		require.Equal(t, "DriversLicense", templates[0].ID)
+		require.Equal(t, PermanentResidentCardTemplateID, templates[1].ID)
+		require.Equal(t, DefaultTemplateID, templates[2].ID)
+
+		require.NoError(t, r.Delete("DriversLicense"))
+
+		_, err = r.Get("DriversLicense")
+		require.ErrorIs(t, err, ErrTemplateNotFound)
+
+		require.ErrorIs(t, r.Delete("DriversLicense"), ErrTemplateNotFound)
+		require.ErrorIs(t, r.Delete(DefaultTemplateID), ErrBuiltInTemplate)
+	})
+
+	t.Run("registered template overrides built-in one", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		require.NoError(t, r.Put(&Template{ID: DefaultTemplateID, Contexts: []string{"https://example.com/v1"}}))
+
+		template, err := r.Resolve("unknown")
+		require.NoError(t, err)
+		require.Equal(t, []string{"https://example.com/v1"}, template.Contexts)
+
+		templates, err := r.List()
+		require.NoError(t, err)
+		require.Len(t, templates, 2)
+
+		require.NoError(t, r.Delete(DefaultTemplateID))
+
+		template, err = r.Resolve("unknown")
+		require.NoError(t, err)
+		require.Equal(t, []string{TrustBlocExampleContext}, template.Contexts)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		for errMsg, template := range map[string]*Template{
+			"missing id":                     {},
+			"empty context":                  {ID: "t", Contexts: []string{""}},
+			"empty mapping of 'name'":        {ID: "t", Mapping: map[string]string{"name": ""}},
+			"missing display name":           {ID: "t", Display: []Display{{Locale: "en-US"}}},
+			"invalid validity period -1h":    {ID: "t", Expiry: &Expiry{ValidFor: "-1h"}},
+			"invalid validity period a year": {ID: "t", Expiry: &Expiry{ValidFor: "a year"}},
+			"invalid schema":                 {ID: "t", Schema: json.RawMessage(`{"type": 1}`)},
+		} {
+			err = r.Put(template)
+			require.ErrorIs(t, err, ErrInvalidTemplate)
+			require.Contains(t, err.Error(), errMsg)
+		}
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		r := &Registry{store: &mockstorage.Store{ErrPut: errors.New("put error")}}
+
+		err := r.Put(&Template{ID: "t"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save credential template : put error")
+
+		r = &Registry{store: &mockstorage.Store{ErrGet: errors.New("get error")}}
+
+		_, err = r.Get("t")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get credential template : get error")
+
+		err = r.Delete("t")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get credential template : get error")
+
+		r = &Registry{store: &mockstorage.Store{GetReturn: []byte("{")}}
+
+		_, err = r.Get("t")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal credential template")
+
+		r = &Registry{store: &mockstorage.Store{GetReturn: []byte("{}"), ErrDelete: errors.New("delete error")}}
+
+		err = r.Delete("t")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "delete credential template : delete error")
+
+		r = &Registry{store: &mockstorage.Store{ErrQuery: errors.New("query error")}}
+
+		_, err = r.List()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "query credential templates : query error")
+
+		for iter, errMsg := range map[*mockstorage.Iterator]string{
+			{ErrNext: errors.New("next error")}:                     "iterate credential templates : next error",
+			{NextReturn: true, ErrValue: errors.New("value error")}: "get credential template : value error",
+			{NextReturn: true, ValueReturn: []byte("{")}:            "unmarshal credential template",
+		} {
+			r = &Registry{store: &mockstorage.Store{QueryReturn: iter}}
+
+			_, err = r.List()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), errMsg)
+		}
+	})
+}
+
+func TestRegistry_Resolve(t *testing.T) {
+	r, err := New(mem.NewProvider())
+	require.NoError(t, err)
+
+	require.NoError(t, r.Put(parseTemplate(t, driversLicenseTemplate)))
+
+	for scope, id := range map[string]string{
+		"DriversLicense": "DriversLicense",
+		"driver_license": "DriversLicense",
+		"subject_data":   PermanentResidentCardTemplateID,
+		"CreditCard":     DefaultTemplateID,
+		"":               DefaultTemplateID,
+	} {
+		template, e := r.Resolve(scope)
+		require.NoError(t, e)
+		require.Equal(t, id, template.ID, scope)
+	}
+}
+
+func TestRegistry_Load(t *testing.T) {
+	t.Run("directory", func(t *testing.T) {
+		dir := t.TempDir()
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "dl.json"), []byte(driversLicenseTemplate), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cards.json"),
+			[]byte(`[{"id":"CreditCard"},{"id":"BankCard","scopes":["bank"]}]`), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("templates"), 0o600))
+
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		require.NoError(t, r.Load(dir))
+
+		templates, err := r.List()
+		require.NoError(t, err)
+		require.Len(t, templates, 5)
+
+		template, err := r.Resolve("bank")
+		require.NoError(t, err)
+		require.Equal(t, "BankCard", template.ID)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		dir := t.TempDir()
+
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		err = r.Load(filepath.Join(dir, "missing.json"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read credential templates")
+
+		file := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(file, []byte("{"), 0o600))
+
+		err = r.Load(file)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal credential templates of "+file)
+
+		require.NoError(t, os.WriteFile(file, []byte(`[{"id":""}]`), 0o600))
+
+		err = r.Load(dir)
+		require.ErrorIs(t, err, ErrInvalidTemplate)
+		require.Contains(t, err.Error(), "load credential template from "+file)
+	})
+}
+
+func TestTemplate_Build(t *testing.T) {
+	now := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("mapping, display and expiry", func(t *testing.T) {
+		template := parseTemplate(t, driversLicenseTemplate)
+
+		data := map[string]interface{}{
+			"first_name": "Foo",
+			"last_name":  "Bar",
+			"address":    map[string]interface{}{"city": "Toronto"},
+			"userid":     "100",
+		}
+
+		cred, err := template.Build(data, "driver_license", now)
+		require.NoError(t, err)
+		require.Equal(t, []string{CredentialsContext, "https://w3id.org/vdl/v1"}, cred.Context)
+		require.Equal(t, []string{VerifiableCredentialType, "mDL"}, cred.Types)
+		require.Equal(t, map[string]interface{}{"givenName": "Foo", "familyName": "Bar", "city": "Toronto"},
+			cred.Subject)
+		require.Equal(t, "Driver's License", cred.Name)
+		require.Equal(t, now.Add(24*time.Hour), *cred.Expired)
+		require.Len(t, data, 4)
+	})
+
+	t.Run("schema validation", func(t *testing.T) {
+		template := parseTemplate(t, driversLicenseTemplate)
+
+		_, err := template.Build(map[string]interface{}{"first_name": 1, "last_name": "Bar"}, "mDL", now)
+		require.ErrorIs(t, err, ErrInvalidSubject)
+		require.Contains(t, err.Error(), "givenName")
+
+		_, err = template.Build(map[string]interface{}{"first_name": "Foo"}, "mDL", now)
+		require.ErrorIs(t, err, ErrInvalidSubject)
+		require.Contains(t, err.Error(), "familyName is required")
+	})
+
+	t.Run("built-in templates", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		template, err := r.Resolve("CreditCard")
+		require.NoError(t, err)
+
+		data := map[string]interface{}{
+			"id": "", "name": "Foo", "userid": "100", "created_at": "2020", "vcmetadata": map[string]interface{}{},
+		}
+
+		cred, err := template.Build(data, "CreditCard", now)
+		require.NoError(t, err)
+		require.Equal(t, []string{CredentialsContext, TrustBlocExampleContext}, cred.Context)
+		require.Equal(t, []string{VerifiableCredentialType, "CreditCard"}, cred.Types)
+		require.Equal(t, map[string]interface{}{"id": "", "name": "Foo"}, cred.Subject)
+		require.Nil(t, cred.Expired)
+
+		template, err = r.Resolve("subject_data")
+		require.NoError(t, err)
+
+		cred, err = template.Build(map[string]interface{}{"subjectData": map[string]interface{}{"name": "Foo"}},
+			"subject_data", now)
+		require.NoError(t, err)
+		require.Equal(t, []string{CredentialsContext, CitizenshipContext}, cred.Context)
+		require.Equal(t, []string{VerifiableCredentialType, "PermanentResidentCard"}, cred.Types)
+		require.Equal(t, map[string]interface{}{"name": "Foo"}, cred.Subject)
+		require.Equal(t, "Permanent Resident Card", cred.Name)
+	})
+
+	t.Run("fixed expiration date", func(t *testing.T) {
+		expirationDate := now.AddDate(1, 0, 0)
+
+		cred, err := (&Template{ID: "t", Expiry: &Expiry{ValidFor: "1h", ExpirationDate: &expirationDate}}).
+			Build(map[string]interface{}{}, "t", now)
+		require.NoError(t, err)
+		require.Equal(t, expirationDate, *cred.Expired)
+	})
+
+	t.Run("invalid validity period", func(t *testing.T) {
+		_, err := (&Template{ID: "t", Expiry: &Expiry{ValidFor: "x"}}).Build(map[string]interface{}{}, "t", now)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse validity period")
+	})
+}
+
+func parseTemplate(t *testing.T, templateJSON string) *Template {
+	t.Helper()
+
+	template := &Template{}
+	require.NoError(t, json.Unmarshal([]byte(templateJSON), template))
+
+	return template
+}
@@ -0,0 +1,170 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package didauth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	vdrapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdr"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/piprate/json-gold/ld"
+
+	"github.com/trustbloc/sandbox/pkg/txnstore"
+)
+
+const (
+	challengeStoreName  = "didauth_challenges"
+	defaultChallengeTTL = 5 * time.Minute
+
+	authenticationPurpose = "authentication"
+)
+
+// ErrInvalidChallenge is returned when the challenge wasn't issued by the verifier, has expired or was already used.
+var ErrInvalidChallenge = errors.New("invalid or expired challenge")
+
+// Verifier issues the DID Auth challenges and verifies the DID Auth presentations signed by the holders.
+type Verifier struct {
+	vdr        vdrapi.Registry
+	loader     ld.DocumentLoader
+	challenges *txnstore.Store
+	ttl        time.Duration
+}
+
+// Option configures the verifier.
+type Option func(v *Verifier)
+
+// WithChallengeTTL sets the time the challenges can be used for, 5 minutes by default.
+func WithChallengeTTL(ttl time.Duration) Option {
+	return func(v *Verifier) {
+		v.ttl = ttl
+	}
+}
+
+// New returns new verifier resolving the holder DIDs through the VDR registry.
+func New(provider storage.Provider, vdr vdrapi.Registry, loader ld.DocumentLoader, opts ...Option) (*Verifier, error) {
+	challenges, err := txnstore.New(provider, challengeStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open challenge store : %w", err)
+	}
+
+	v := &Verifier{
+		vdr:        vdr,
+		loader:     loader,
+		challenges: challenges,
+		ttl:        defaultChallengeTTL,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v, nil
+}
+
+// IssueChallenge returns new single use challenge bound to the domain.
+func (v *Verifier) IssueChallenge(domain string) (string, error) {
+	challenge := uuid.NewString()
+
+	err := v.challenges.PutWithTTL(challenge, []byte(domain), v.ttl)
+	if err != nil {
+		return "", fmt.Errorf("store challenge : %w", err)
+	}
+
+	return challenge, nil
+}
+
+// Verify verifies the DID Auth presentation of the holder: the proofs must be signed by the authentication keys of
+// the holder DID for the challenge and the domain, and the challenge must be issued by the verifier for the domain.
+// The challenge is consumed, so that the presentation can't be replayed.
+func (v *Verifier) Verify(authResp []byte, holder, domain, challenge string) error { // nolint:gocyclo
+	vp, err := verifiable.ParsePresentation(authResp,
+		verifiable.WithPresPublicKeyFetcher(v.publicKeyFetcher(holder)),
+		verifiable.WithPresJSONLDDocumentLoader(v.loader))
+	if err != nil {
+		return fmt.Errorf("invalid auth response : %w", err)
+	}
+
+	if vp.Holder != holder {
+		return fmt.Errorf("invalid auth response, invalid holder proof")
+	}
+
+	if len(vp.Proofs) == 0 {
+		return fmt.Errorf("invalid auth response, missing proof")
+	}
+
+	for _, proof := range vp.Proofs {
+		if purpose, _ := proof["proofPurpose"].(string); purpose != authenticationPurpose {
+			return fmt.Errorf("invalid auth response proof, proof purpose must be %s", authenticationPurpose)
+		}
+
+		proofChallenge, ok := proof["challenge"].(string)
+		if !ok {
+			return fmt.Errorf("invalid auth response proof, missing challenge")
+		}
+
+		proofDomain, ok := proof["domain"].(string)
+		if !ok {
+			return fmt.Errorf("invalid auth response proof, missing domain")
+		}
+
+		if proofChallenge != challenge || proofDomain != domain {
+			return fmt.Errorf("invalid proof and challenge in response")
+		}
+	}
+
+	challengeDomain, err := v.challenges.Consume(challenge)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return ErrInvalidChallenge
+	}
+
+	if err != nil {
+		return fmt.Errorf("get challenge : %w", err)
+	}
+
+	if string(challengeDomain) != domain {
+		return fmt.Errorf("%w : challenge was issued for another domain", ErrInvalidChallenge)
+	}
+
+	return nil
+}
+
+// publicKeyFetcher returns the public keys of the authentication verification methods of the holder DID only.
+func (v *Verifier) publicKeyFetcher(holder string) verifiable.PublicKeyFetcher {
+	return func(issuerID, keyID string) (*verifier.PublicKey, error) {
+		if issuerID != holder {
+			return nil, fmt.Errorf("proof is not signed by the holder %s", holder)
+		}
+
+		docResolution, err := v.vdr.Resolve(issuerID)
+		if err != nil {
+			return nil, fmt.Errorf("resolve DID %s : %w", issuerID, err)
+		}
+
+		methods := docResolution.DIDDocument.VerificationMethods(did.Authentication)[did.Authentication]
+
+		for _, method := range methods {
+			vm := method.VerificationMethod
+
+			// the verification method IDs are either absolute or relative to the DID
+			if vm.ID == issuerID+keyID || vm.ID == keyID {
+				return &verifier.PublicKey{
+					Type:  vm.Type,
+					Value: vm.Value,
+					JWK:   vm.JSONWebKey(),
+				}, nil
+			}
+		}
+
+		return nil, fmt.Errorf("authentication key %s not found for DID %s", keyID, issuerID)
+	}
+}
@@ -0,0 +1,341 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package didauth
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	memstore "github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/ld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	mockldstore "github.com/hyperledger/aries-framework-go/pkg/mock/ld"
+	mockvdr "github.com/hyperledger/aries-framework-go/pkg/mock/vdr"
+	ldstore "github.com/hyperledger/aries-framework-go/pkg/store/ld"
+	vdrpkg "github.com/hyperledger/aries-framework-go/pkg/vdr"
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/key"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/kms"
+)
+
+const domain = "issuer.example.com"
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v, err := New(memstore.NewProvider(), vdrpkg.New(), createTestDocumentLoader(t),
+			WithChallengeTTL(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, v.ttl)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")}, vdrpkg.New(),
+			createTestDocumentLoader(t))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open challenge store")
+	})
+}
+
+func TestVerifier_IssueChallenge(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := newVerifier(t)
+
+		c1, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		c2, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+		require.NotEqual(t, c1, c2)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		v, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("put error")}},
+			vdrpkg.New(), createTestDocumentLoader(t))
+		require.NoError(t, err)
+
+		_, err = v.IssueChallenge(domain)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "store challenge")
+	})
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	holderKey := createKey(t)
+
+	t.Run("success", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		require.NoError(t, v.Verify(authResp, holderKey.DID, domain, challenge))
+	})
+
+	t.Run("challenge is single use", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		require.NoError(t, v.Verify(authResp, holderKey.DID, domain, challenge))
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.True(t, errors.Is(err, ErrInvalidChallenge))
+	})
+
+	t.Run("challenge not issued", func(t *testing.T) {
+		v := newVerifier(t)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, "client-challenge", domain, authenticationPurpose)
+
+		err := v.Verify(authResp, holderKey.DID, domain, "client-challenge")
+		require.True(t, errors.Is(err, ErrInvalidChallenge))
+	})
+
+	t.Run("challenge expired", func(t *testing.T) {
+		v, err := New(memstore.NewProvider(), vdrpkg.New(vdrpkg.WithVDR(key.New())), createTestDocumentLoader(t),
+			WithChallengeTTL(-time.Minute))
+		require.NoError(t, err)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.True(t, errors.Is(err, ErrInvalidChallenge))
+	})
+
+	t.Run("challenge issued for another domain", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge("other.example.com")
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.True(t, errors.Is(err, ErrInvalidChallenge))
+		require.Contains(t, err.Error(), "another domain")
+	})
+
+	t.Run("forged signature", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		var raw map[string]interface{}
+		require.NoError(t, json.Unmarshal(authResp, &raw))
+
+		raw["proof"].(map[string]interface{})["proofValue"] = "z3FXQjecWufY46yg5abdVZsXqLhxhueuSoZgNSARiKBk"
+
+		authResp, err = json.Marshal(raw)
+		require.NoError(t, err)
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid auth response")
+	})
+
+	t.Run("signed by another DID", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		otherKey := createKey(t)
+		authResp := signAuthResp(t, otherKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "proof is not signed by the holder")
+	})
+
+	t.Run("invalid holder", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		err = v.Verify(authResp, "did:example:other", domain, challenge)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "proof is not signed by the holder")
+	})
+
+	t.Run("invalid proof purpose", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, "assertionMethod")
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "proof purpose must be authentication")
+	})
+
+	t.Run("missing proof", func(t *testing.T) {
+		v := newVerifier(t)
+
+		vp, err := verifiable.NewPresentation()
+		require.NoError(t, err)
+
+		vp.Holder = holderKey.DID
+
+		authResp, err := json.Marshal(vp)
+		require.NoError(t, err)
+
+		err = v.Verify(authResp, holderKey.DID, domain, "challenge")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing proof")
+	})
+
+	t.Run("missing challenge", func(t *testing.T) {
+		v := newVerifier(t)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, "", domain, authenticationPurpose)
+
+		err := v.Verify(authResp, holderKey.DID, domain, "challenge")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing challenge")
+	})
+
+	t.Run("missing domain", func(t *testing.T) {
+		v := newVerifier(t)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, "challenge", "", authenticationPurpose)
+
+		err := v.Verify(authResp, holderKey.DID, domain, "challenge")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing domain")
+	})
+
+	t.Run("challenge mismatch", func(t *testing.T) {
+		v := newVerifier(t)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, "other", domain, authenticationPurpose)
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid proof and challenge in response")
+	})
+
+	t.Run("resolve error", func(t *testing.T) {
+		v, err := New(memstore.NewProvider(), &mockvdr.MockVDRegistry{ResolveErr: errors.New("resolve error")},
+			createTestDocumentLoader(t))
+		require.NoError(t, err)
+
+		challenge, err := v.IssueChallenge(domain)
+		require.NoError(t, err)
+
+		authResp := signAuthResp(t, holderKey, holderKey.DID, challenge, domain, authenticationPurpose)
+
+		err = v.Verify(authResp, holderKey.DID, domain, challenge)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "resolve error")
+	})
+
+	t.Run("invalid auth response", func(t *testing.T) {
+		err := newVerifier(t).Verify([]byte("invalid"), holderKey.DID, domain, "challenge")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid auth response")
+	})
+}
+
+func newVerifier(t *testing.T) *Verifier {
+	t.Helper()
+
+	v, err := New(memstore.NewProvider(), vdrpkg.New(vdrpkg.WithVDR(key.New())), createTestDocumentLoader(t))
+	require.NoError(t, err)
+
+	return v
+}
+
+func createKey(t *testing.T) *kms.Key {
+	t.Helper()
+
+	keyManager, err := kms.New(memstore.NewProvider())
+	require.NoError(t, err)
+
+	k, err := keyManager.Create("holder", kms.Ed25519)
+	require.NoError(t, err)
+
+	return k
+}
+
+func signAuthResp(t *testing.T, k *kms.Key, holder, challenge, domain, purpose string) []byte {
+	t.Helper()
+
+	vp, err := verifiable.NewPresentation()
+	require.NoError(t, err)
+
+	vp.Holder = holder
+
+	created := time.Now()
+
+	err = vp.AddLinkedDataProof(&verifiable.LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: verifiable.SignatureProofValue,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(k.Signer())),
+		VerificationMethod:      k.ID,
+		Purpose:                 purpose,
+		Challenge:               challenge,
+		Domain:                  domain,
+		Created:                 &created,
+	}, jsonld.WithDocumentLoader(createTestDocumentLoader(t)))
+	require.NoError(t, err)
+
+	authResp, err := json.Marshal(vp)
+	require.NoError(t, err)
+
+	return authResp
+}
+
+type mockLDStoreProvider struct {
+	ContextStore        ldstore.ContextStore
+	RemoteProviderStore ldstore.RemoteProviderStore
+}
+
+func (p *mockLDStoreProvider) JSONLDContextStore() ldstore.ContextStore {
+	return p.ContextStore
+}
+
+func (p *mockLDStoreProvider) JSONLDRemoteProviderStore() ldstore.RemoteProviderStore {
+	return p.RemoteProviderStore
+}
+
+func createTestDocumentLoader(t *testing.T) *ld.DocumentLoader {
+	t.Helper()
+
+	loader, err := ld.NewDocumentLoader(&mockLDStoreProvider{
+		ContextStore:        mockldstore.NewMockContextStore(),
+		RemoteProviderStore: mockldstore.NewMockRemoteProviderStore(),
+	})
+	require.NoError(t, err)
+
+	return loader
+}
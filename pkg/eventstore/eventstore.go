@@ -0,0 +1,282 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+
+	"github.com/trustbloc/sandbox/pkg/txnstore"
+)
+
+const (
+	// DefaultTTL is the time the events of a transaction are kept after its last update.
+	DefaultTTL = time.Hour
+)
+
+// ErrNoEvent is returned when there is no new event of the transaction.
+var ErrNoEvent = errors.New("no event found")
+
+// Event is a webhook event of a transaction.
+type Event struct {
+	// ID is the sequence number of the event within the transaction, starting at 1.
+	ID         int             `json:"id"`
+	TxID       string          `json:"txID"`
+	ReceivedAt time.Time       `json:"receivedAt"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// transaction is the record of the events of a transaction.
+type transaction struct {
+	Events []*Event `json:"events"`
+	// Delivered is the number of the events returned by Next.
+	Delivered int `json:"delivered"`
+}
+
+// Store keeps the webhook events of the transactions in a storage provider. Events of a transaction expire after
+// the TTL since the last update of the transaction, i.e. the last event added or returned by Next.
+type Store struct {
+	store   *txnstore.Store
+	ttl     time.Duration
+	now     func() time.Time
+	mutex   sync.Mutex
+	waiters map[string][]chan struct{}
+}
+
+// Option configures the store.
+type Option func(s *Store)
+
+// WithTTL sets the time the events of a transaction are kept after its last update, DefaultTTL by default.
+func WithTTL(ttl time.Duration) Option {
+	return func(s *Store) {
+		s.ttl = ttl
+	}
+}
+
+// New returns new event store keeping the events in the named store of the provider.
+func New(provider storage.Provider, name string, opts ...Option) (*Store, error) {
+	store, err := txnstore.New(provider, name)
+	if err != nil {
+		return nil, fmt.Errorf("open event store : %w", err)
+	}
+
+	s := &Store{
+		store:   store,
+		ttl:     DefaultTTL,
+		now:     time.Now,
+		waiters: map[string][]chan struct{}{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
+}
+
+// Add appends the event to the events of the transaction and notifies the waiting readers.
+func (s *Store) Add(txID string, payload []byte) (*Event, error) {
+	if !json.Valid(payload) {
+		return nil, errors.New("event payload is not valid JSON")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	txn, err := s.get(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &Event{
+		ID:         len(txn.Events) + 1,
+		TxID:       txID,
+		ReceivedAt: s.now().UTC(),
+		Payload:    payload,
+	}
+
+	txn.Events = append(txn.Events, event)
+
+	err = s.put(txID, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, waiter := range s.waiters[txID] {
+		close(waiter)
+	}
+
+	delete(s.waiters, txID)
+
+	return event, nil
+}
+
+// Events returns all the events of the transaction in the order they were received.
+func (s *Store) Events(txID string) ([]*Event, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	txn, err := s.get(txID)
+	if err != nil {
+		return nil, err
+	}
+
+	return txn.Events, nil
+}
+
+// Next returns the oldest event of the transaction not returned by Next yet, waiting for it until the context is
+// done. ErrNoEvent is returned if there is no such event.
+func (s *Store) Next(ctx context.Context, txID string) (*Event, error) {
+	var event *Event
+
+	err := s.wait(ctx, txID, func(txn *transaction) (bool, error) {
+		if txn.Delivered == len(txn.Events) {
+			return false, nil
+		}
+
+		event = txn.Events[txn.Delivered]
+		txn.Delivered++
+
+		return true, s.put(txID, txn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// EventsAfter returns the events of the transaction received after the event with the given ID, waiting for them
+// until the context is done. Events of the transaction are numbered from 1, so lastID 0 returns all of them.
+// ErrNoEvent is returned if there are no such events.
+func (s *Store) EventsAfter(ctx context.Context, txID string, lastID int) ([]*Event, error) {
+	var events []*Event
+
+	err := s.wait(ctx, txID, func(txn *transaction) (bool, error) {
+		if len(txn.Events) <= lastID {
+			return false, nil
+		}
+
+		events = txn.Events[lastID:]
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// wait calls the read function with the transaction under the lock each time an event is added to it, until the
+// function reports it's done or the context is done.
+func (s *Store) wait(ctx context.Context, txID string, read func(txn *transaction) (bool, error)) error {
+	for {
+		s.mutex.Lock()
+
+		txn, err := s.get(txID)
+		if err != nil {
+			s.mutex.Unlock()
+
+			return err
+		}
+
+		done, err := read(txn)
+		if done || err != nil {
+			s.mutex.Unlock()
+
+			return err
+		}
+
+		waiter := make(chan struct{})
+		s.waiters[txID] = append(s.waiters[txID], waiter)
+
+		s.mutex.Unlock()
+
+		select {
+		case <-waiter:
+		case <-ctx.Done():
+			s.removeWaiter(txID, waiter)
+
+			return ErrNoEvent
+		}
+	}
+}
+
+// Sweep deletes the events of the expired transactions and returns the number of the deleted transactions.
+func (s *Store) Sweep() (int, error) {
+	return s.store.Sweep()
+}
+
+// StartSweeper deletes the events of the expired transactions periodically until the returned stop function is
+// called.
+func (s *Store) StartSweeper(interval time.Duration) func() {
+	return s.store.StartSweeper(interval)
+}
+
+func (s *Store) removeWaiter(txID string, waiter chan struct{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	waiters := s.waiters[txID]
+
+	for i, w := range waiters {
+		if w == waiter {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+
+			break
+		}
+	}
+
+	if len(waiters) == 0 {
+		delete(s.waiters, txID)
+
+		return
+	}
+
+	s.waiters[txID] = waiters
+}
+
+func (s *Store) get(txID string) (*transaction, error) {
+	txnBytes, err := s.store.Get(txID)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return &transaction{Events: []*Event{}}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get events of transaction %s : %w", txID, err)
+	}
+
+	txn := &transaction{}
+
+	err = json.Unmarshal(txnBytes, txn)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal events of transaction %s : %w", txID, err)
+	}
+
+	return txn, nil
+}
+
+func (s *Store) put(txID string, txn *transaction) error {
+	txnBytes, err := json.Marshal(txn)
+	if err != nil {
+		return fmt.Errorf("marshal events of transaction %s : %w", txID, err)
+	}
+
+	err = s.store.PutWithTTL(txID, txnBytes, s.ttl)
+	if err != nil {
+		return fmt.Errorf("store events of transaction %s : %w", txID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	memstore "github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const txID = "tx1"
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s, err := New(memstore.NewProvider(), "events", WithTTL(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, s.ttl)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")}, "events")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open event store")
+	})
+}
+
+func TestStore_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s := newStore(t)
+
+		e1, err := s.Add(txID, []byte(`{"type":"first"}`))
+		require.NoError(t, err)
+		require.Equal(t, 1, e1.ID)
+		require.Equal(t, txID, e1.TxID)
+		require.False(t, e1.ReceivedAt.IsZero())
+
+		e2, err := s.Add(txID, []byte(`{"type":"second"}`))
+		require.NoError(t, err)
+		require.Equal(t, 2, e2.ID)
+
+		_, err = s.Add("tx2", []byte(`{"type":"other"}`))
+		require.NoError(t, err)
+
+		events, err := s.Events(txID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.JSONEq(t, `{"type":"first"}`, string(events[0].Payload))
+		require.JSONEq(t, `{"type":"second"}`, string(events[1].Payload))
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := newStore(t).Add(txID, []byte("invalid"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not valid JSON")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		s, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrGet: storage.ErrDataNotFound,
+			ErrPut: errors.New("put error"),
+		}}, "events")
+		require.NoError(t, err)
+
+		_, err = s.Add(txID, []byte(`{}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "put error")
+	})
+
+	t.Run("concurrent events", func(t *testing.T) {
+		s := newStore(t)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := s.Add(txID, []byte(`{}`))
+				require.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+
+		events, err := s.Events(txID)
+		require.NoError(t, err)
+		require.Len(t, events, 20)
+
+		for i, e := range events {
+			require.Equal(t, i+1, e.ID)
+		}
+	})
+}
+
+func TestStore_Events(t *testing.T) {
+	t.Run("no events", func(t *testing.T) {
+		events, err := newStore(t).Events(txID)
+		require.NoError(t, err)
+		require.Empty(t, events)
+		require.NotNil(t, events)
+	})
+
+	t.Run("events are persisted", func(t *testing.T) {
+		provider := memstore.NewProvider()
+
+		s1, err := New(provider, "events")
+		require.NoError(t, err)
+
+		_, err = s1.Add(txID, []byte(`{"type":"first"}`))
+		require.NoError(t, err)
+
+		s2, err := New(provider, "events")
+		require.NoError(t, err)
+
+		events, err := s2.Events(txID)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	})
+
+	t.Run("events expired", func(t *testing.T) {
+		s, err := New(memstore.NewProvider(), "events", WithTTL(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = s.Add(txID, []byte(`{}`))
+		require.NoError(t, err)
+
+		n, err := s.Sweep()
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		_, err = s.Add(txID, []byte(`{}`))
+		require.NoError(t, err)
+
+		events, err := s.Events(txID)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		s, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrGet: errors.New("get error")}},
+			"events")
+		require.NoError(t, err)
+
+		_, err = s.Events(txID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get error")
+	})
+}
+
+func TestStore_Next(t *testing.T) {
+	t.Run("returns each event once", func(t *testing.T) {
+		s := newStore(t)
+
+		_, err := s.Add(txID, []byte(`{"type":"first"}`))
+		require.NoError(t, err)
+
+		_, err = s.Add(txID, []byte(`{"type":"second"}`))
+		require.NoError(t, err)
+
+		e, err := s.Next(context.Background(), txID)
+		require.NoError(t, err)
+		require.Equal(t, 1, e.ID)
+
+		e, err = s.Next(context.Background(), txID)
+		require.NoError(t, err)
+		require.Equal(t, 2, e.ID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = s.Next(ctx, txID)
+		require.True(t, errors.Is(err, ErrNoEvent))
+		require.Empty(t, s.waiters)
+
+		events, err := s.Events(txID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+	})
+
+	t.Run("waits for event", func(t *testing.T) {
+		s := newStore(t)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+
+			_, err := s.Add(txID, []byte(`{"type":"first"}`))
+			require.NoError(t, err)
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		e, err := s.Next(ctx, txID)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type":"first"}`, string(e.Payload))
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		s, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrGet: errors.New("get error")}},
+			"events")
+		require.NoError(t, err)
+
+		_, err = s.Next(context.Background(), txID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get error")
+	})
+}
+
+func TestStore_EventsAfter(t *testing.T) {
+	t.Run("returns events after the last ID", func(t *testing.T) {
+		s := newStore(t)
+
+		for _, payload := range []string{`{"type":"first"}`, `{"type":"second"}`, `{"type":"third"}`} {
+			_, err := s.Add(txID, []byte(payload))
+			require.NoError(t, err)
+		}
+
+		events, err := s.EventsAfter(context.Background(), txID, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+
+		events, err = s.EventsAfter(context.Background(), txID, 1)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, 2, events[0].ID)
+		require.Equal(t, 3, events[1].ID)
+
+		// events returned by EventsAfter are still returned by Next
+		e, err := s.Next(context.Background(), txID)
+		require.NoError(t, err)
+		require.Equal(t, 1, e.ID)
+	})
+
+	t.Run("waits for events", func(t *testing.T) {
+		s := newStore(t)
+
+		_, err := s.Add(txID, []byte(`{"type":"first"}`))
+		require.NoError(t, err)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+
+			_, e := s.Add(txID, []byte(`{"type":"second"}`))
+			require.NoError(t, e)
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		events, err := s.EventsAfter(ctx, txID, 1)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.JSONEq(t, `{"type":"second"}`, string(events[0].Payload))
+	})
+
+	t.Run("no events", func(t *testing.T) {
+		s := newStore(t)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := s.EventsAfter(ctx, txID, 0)
+		require.True(t, errors.Is(err, ErrNoEvent))
+		require.Empty(t, s.waiters)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		s, err := New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrGet: errors.New("get error")}},
+			"events")
+		require.NoError(t, err)
+
+		_, err = s.EventsAfter(context.Background(), txID, 0)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get error")
+	})
+}
+
+func TestStore_StartSweeper(t *testing.T) {
+	s, err := New(memstore.NewProvider(), "events", WithTTL(-time.Minute))
+	require.NoError(t, err)
+
+	_, err = s.Add(txID, []byte(`{}`))
+	require.NoError(t, err)
+
+	stop := s.StartSweeper(time.Millisecond)
+	defer stop()
+
+	require.Eventually(t, func() bool {
+		_, e := s.store.Store.Get(txID)
+
+		return errors.Is(e, storage.ErrDataNotFound)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func newStore(t *testing.T) *Store {
+	t.Helper()
+
+	s, err := New(memstore.NewProvider(), "events")
+	require.NoError(t, err)
+
+	return s
+}
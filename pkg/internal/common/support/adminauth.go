@@ -0,0 +1,38 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package support
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const bearerPrefix = "Bearer "
+
+// NewAdminHTTPHandler returns instance of HTTPHandler which handles only the requests authorized with the admin
+// token as bearer token. The handler is disabled, responding 403 to all the requests, if the admin token is empty.
+func NewAdminHTTPHandler(path, method, adminToken string, handle http.HandlerFunc) *HTTPHandler {
+	return NewHTTPHandler(path, method, func(w http.ResponseWriter, r *http.Request) {
+		if adminToken == "" {
+			http.Error(w, "admin API is disabled", http.StatusForbidden)
+
+			return
+		}
+
+		authHeader := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authHeader, bearerPrefix) ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(authHeader, bearerPrefix)), []byte(adminToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "invalid admin token", http.StatusUnauthorized)
+
+			return
+		}
+
+		handle(w, r)
+	})
+}
@@ -0,0 +1,59 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package support
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAdminHTTPHandler(t *testing.T) {
+	handlerFn := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	serve := func(handler *HTTPHandler, authHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, handler.Path(), nil)
+		if authHeader != "" {
+			req.Header.Set("Authorization", authHeader)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.Handle()(rr, req)
+
+		return rr
+	}
+
+	t.Run("authorized", func(t *testing.T) {
+		handler := NewAdminHTTPHandler("/admin", http.MethodPost, "secret", handlerFn)
+		require.Equal(t, "/admin", handler.Path())
+		require.Equal(t, http.MethodPost, handler.Method())
+
+		require.Equal(t, http.StatusNoContent, serve(handler, "Bearer secret").Code)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		handler := NewAdminHTTPHandler("/admin", http.MethodPost, "secret", handlerFn)
+
+		for _, authHeader := range []string{"", "secret", "Bearer other", "Basic secret"} {
+			rr := serve(handler, authHeader)
+			require.Equal(t, http.StatusUnauthorized, rr.Code)
+			require.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+		}
+	})
+
+	t.Run("disabled without admin token", func(t *testing.T) {
+		handler := NewAdminHTTPHandler("/admin", http.MethodPost, "", handlerFn)
+
+		rr := serve(handler, "Bearer ")
+		require.Equal(t, http.StatusForbidden, rr.Code)
+		require.Contains(t, rr.Body.String(), "admin API is disabled")
+	})
+}
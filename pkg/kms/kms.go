@@ -0,0 +1,337 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kms
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/fingerprint"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/square/go-jose/v3"
+
+	"github.com/trustbloc/sandbox/pkg/txnstore"
+)
+
+const (
+	// store
+	keyStoreName = "issuer_kms"
+
+	// Ed25519 key type.
+	Ed25519 KeyType = "Ed25519"
+	// P256 NIST P-256 ECDSA key type.
+	P256 KeyType = "P-256"
+	// P384 NIST P-384 ECDSA key type.
+	P384 KeyType = "P-384"
+)
+
+var (
+	// ErrKeyNotFound is returned when no key set exists for the given ID.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrUnsupportedKeyType is returned when a key of an unknown type is requested.
+	ErrUnsupportedKeyType = errors.New("unsupported key type")
+)
+
+// KeyType of the signing key.
+type KeyType string
+
+// KMS manages signing keys of the issuers, one key set per issuer.
+// The last key in a key set is the active signing key, previous keys are kept for verification.
+// Key sets are kept for good, since the keys must outlive the credentials and the status lists they signed, unless
+// the TTL is set.
+type KMS struct {
+	store *txnstore.Store
+	ttl   time.Duration
+	mutex sync.Mutex
+}
+
+// Option configures the KMS.
+type Option func(k *KMS)
+
+// WithTTL sets the time the key sets are kept after they're created or rotated, the key sets don't expire by default.
+func WithTTL(ttl time.Duration) Option {
+	return func(k *KMS) {
+		k.ttl = ttl
+	}
+}
+
+// Key is a signing key managed by the KMS.
+type Key struct {
+	// ID is the did:key verification method of the key.
+	ID      string          `json:"id"`
+	DID     string          `json:"did"`
+	Type    KeyType         `json:"type"`
+	Created time.Time       `json:"created"`
+	JWK     jose.JSONWebKey `json:"jwk"`
+}
+
+type keySet struct {
+	Keys []*Key `json:"keys"`
+}
+
+// New returns new KMS instance backed by the given storage provider.
+func New(provider storage.Provider, opts ...Option) (*KMS, error) {
+	store, err := txnstore.New(provider, keyStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open key store : %w", err)
+	}
+
+	k := &KMS{store: store}
+
+	for _, opt := range opts {
+		opt(k)
+	}
+
+	return k, nil
+}
+
+// Create creates a new key set with a single key of the given type.
+// An existing key set with the same ID is replaced.
+func (k *KMS) Create(keySetID string, keyType KeyType) (*Key, error) {
+	key, err := newKey(keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	err = k.save(keySetID, &keySet{Keys: []*Key{key}})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// Get returns the active signing key of the key set.
+func (k *KMS) Get(keySetID string) (*Key, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	set, err := k.get(keySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return set.Keys[len(set.Keys)-1], nil
+}
+
+// Rotate creates a new key of the same type as the active key and makes it the active signing key.
+func (k *KMS) Rotate(keySetID string) (*Key, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	set, err := k.get(keySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := newKey(set.Keys[len(set.Keys)-1].Type)
+	if err != nil {
+		return nil, err
+	}
+
+	set.Keys = append(set.Keys, key)
+
+	err = k.save(keySetID, set)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// PublicKeys returns public JWKs of all the keys in the key set, including rotated ones.
+func (k *KMS) PublicKeys(keySetID string) (*jose.JSONWebKeySet, error) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	set, err := k.get(keySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := &jose.JSONWebKeySet{}
+
+	for _, key := range set.Keys {
+		jwks.Keys = append(jwks.Keys, key.PublicJWK())
+	}
+
+	return jwks, nil
+}
+
+// StartSweeper deletes the expired key sets periodically until the returned stop function is called.
+func (k *KMS) StartSweeper(interval time.Duration) func() {
+	return k.store.StartSweeper(interval)
+}
+
+func (k *KMS) get(keySetID string) (*keySet, error) {
+	setBytes, err := k.store.Get(keySetID)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return nil, ErrKeyNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get key set : %w", err)
+	}
+
+	set := &keySet{}
+
+	err = json.Unmarshal(setBytes, set)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal key set : %w", err)
+	}
+
+	if len(set.Keys) == 0 {
+		return nil, ErrKeyNotFound
+	}
+
+	return set, nil
+}
+
+func (k *KMS) save(keySetID string, set *keySet) error {
+	setBytes, err := json.Marshal(set)
+	if err != nil {
+		return fmt.Errorf("marshal key set : %w", err)
+	}
+
+	if k.ttl == 0 {
+		err = k.store.Put(keySetID, setBytes)
+	} else {
+		err = k.store.PutWithTTL(keySetID, setBytes, k.ttl)
+	}
+
+	if err != nil {
+		return fmt.Errorf("save key set : %w", err)
+	}
+
+	return nil
+}
+
+// PublicJWK returns public part of the key as JWK, with the verification method as key ID.
+func (k *Key) PublicJWK() jose.JSONWebKey {
+	jwk := k.JWK.Public()
+	jwk.KeyID = k.ID
+	jwk.Algorithm = k.Alg()
+	jwk.Use = "sig"
+
+	return jwk
+}
+
+// Alg returns JWS algorithm of the key.
+func (k *Key) Alg() string {
+	switch k.Type {
+	case P256:
+		return "ES256"
+	case P384:
+		return "ES384"
+	default:
+		return "EdDSA"
+	}
+}
+
+// Signer returns signer for the key.
+func (k *Key) Signer() *Signer {
+	return &Signer{key: k.JWK.Key, alg: k.Alg()}
+}
+
+func newKey(keyType KeyType) (*Key, error) {
+	var (
+		privateKey interface{}
+		code       uint64
+		publicKey  []byte
+	)
+
+	switch keyType {
+	case Ed25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate ed25519 key : %w", err)
+		}
+
+		privateKey, code, publicKey = priv, fingerprint.ED25519PubKeyMultiCodec, pub
+	case P256, P384:
+		curve, multicodec := elliptic.P256(), uint64(fingerprint.P256PubKeyMultiCodec)
+		if keyType == P384 {
+			curve, multicodec = elliptic.P384(), fingerprint.P384PubKeyMultiCodec
+		}
+
+		priv, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate ecdsa key : %w", err)
+		}
+
+		privateKey, code, publicKey = priv, multicodec, elliptic.MarshalCompressed(curve, priv.X, priv.Y)
+	default:
+		return nil, fmt.Errorf("%w : %s", ErrUnsupportedKeyType, keyType)
+	}
+
+	didKey, keyID := fingerprint.CreateDIDKeyByCode(code, publicKey)
+
+	return &Key{
+		ID:      keyID,
+		DID:     didKey,
+		Type:    keyType,
+		Created: time.Now().UTC(),
+		JWK:     jose.JSONWebKey{Key: privateKey, KeyID: keyID},
+	}, nil
+}
+
+// Signer signs data with a KMS key.
+type Signer struct {
+	key interface{}
+	alg string
+}
+
+// Sign signs data. ECDSA signatures are returned in IEEE P1363 (r || s) format as required by JWS.
+func (s *Signer) Sign(data []byte) ([]byte, error) {
+	switch key := s.key.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, data), nil
+	case *ecdsa.PrivateKey:
+		hash := crypto.SHA256
+		if key.Curve == elliptic.P384() {
+			hash = crypto.SHA384
+		}
+
+		h := hash.New()
+
+		_, err := h.Write(data)
+		if err != nil {
+			return nil, err
+		}
+
+		r, sig, err := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+		if err != nil {
+			return nil, fmt.Errorf("ecdsa sign : %w", err)
+		}
+
+		size := (key.Curve.Params().BitSize + 7) / 8 //nolint: gomnd
+		out := make([]byte, 2*size)                  //nolint: gomnd
+
+		r.FillBytes(out[:size])
+		sig.FillBytes(out[size:])
+
+		return out, nil
+	default:
+		return nil, fmt.Errorf("%w : %T", ErrUnsupportedKeyType, key)
+	}
+}
+
+// Alg returns JWS algorithm of the signer.
+func (s *Signer) Alg() string {
+	return s.alg
+}
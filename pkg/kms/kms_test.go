@@ -0,0 +1,237 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package kms
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		k, err := New(mem.NewProvider())
+		require.NoError(t, err)
+		require.NotNil(t, k)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		k, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open key store : open error")
+		require.Nil(t, k)
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		k, err := New(&mockstorage.Provider{ErrSetStoreConfig: errors.New("config error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open key store : set store configuration")
+		require.Nil(t, k)
+	})
+}
+
+func TestKMS_Create(t *testing.T) {
+	for _, keyType := range []KeyType{Ed25519, P256, P384} {
+		keyType := keyType
+
+		t.Run(string(keyType), func(t *testing.T) {
+			k, err := New(mem.NewProvider())
+			require.NoError(t, err)
+
+			key, err := k.Create("issuer1", keyType)
+			require.NoError(t, err)
+			require.Equal(t, keyType, key.Type)
+			require.True(t, strings.HasPrefix(key.DID, "did:key:z"))
+			require.True(t, strings.HasPrefix(key.ID, key.DID+"#z"))
+
+			stored, err := k.Get("issuer1")
+			require.NoError(t, err)
+			require.Equal(t, key.ID, stored.ID)
+
+			data := []byte("data")
+
+			sig, err := stored.Signer().Sign(data)
+			require.NoError(t, err)
+
+			verifySignature(t, stored, data, sig)
+		})
+	}
+
+	t.Run("unsupported key type", func(t *testing.T) {
+		k, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		key, err := k.Create("issuer1", "RSA")
+		require.ErrorIs(t, err, ErrUnsupportedKeyType)
+		require.Nil(t, key)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		k, err := New(&mockstorage.Provider{
+			OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("put error")},
+		})
+		require.NoError(t, err)
+
+		key, err := k.Create("issuer1", Ed25519)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save key set : put error")
+		require.Nil(t, key)
+	})
+}
+
+func TestKMS_Get(t *testing.T) {
+	t.Run("key not found", func(t *testing.T) {
+		k, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		key, err := k.Get("issuer1")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		require.Nil(t, key)
+	})
+
+	t.Run("key set kept without ttl", func(t *testing.T) {
+		k, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		_, err = k.Create("issuer1", Ed25519)
+		require.NoError(t, err)
+
+		tags, err := k.store.GetTags("issuer1")
+		require.NoError(t, err)
+		require.Empty(t, tags)
+
+		_, err = k.Get("issuer1")
+		require.NoError(t, err)
+	})
+
+	t.Run("key set expired", func(t *testing.T) {
+		k, err := New(mem.NewProvider(), WithTTL(-time.Second))
+		require.NoError(t, err)
+
+		_, err = k.Create("issuer1", Ed25519)
+		require.NoError(t, err)
+
+		key, err := k.Get("issuer1")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		require.Nil(t, key)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		k, err := New(&mockstorage.Provider{
+			OpenStoreReturn: &mockstorage.Store{ErrGet: errors.New("get error")},
+		})
+		require.NoError(t, err)
+
+		key, err := k.Get("issuer1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get key set : get error")
+		require.Nil(t, key)
+	})
+
+	t.Run("invalid key set", func(t *testing.T) {
+		store := &mockstorage.Store{GetReturn: []byte("{")}
+
+		k, err := New(&mockstorage.Provider{OpenStoreReturn: store})
+		require.NoError(t, err)
+
+		key, err := k.Get("issuer1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal key set")
+		require.Nil(t, key)
+	})
+}
+
+func TestKMS_Rotate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		k, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		first, err := k.Create("issuer1", P256)
+		require.NoError(t, err)
+
+		second, err := k.Rotate("issuer1")
+		require.NoError(t, err)
+		require.Equal(t, P256, second.Type)
+		require.NotEqual(t, first.ID, second.ID)
+
+		active, err := k.Get("issuer1")
+		require.NoError(t, err)
+		require.Equal(t, second.ID, active.ID)
+
+		jwks, err := k.PublicKeys("issuer1")
+		require.NoError(t, err)
+		require.Len(t, jwks.Keys, 2)
+		require.Equal(t, first.ID, jwks.Keys[0].KeyID)
+		require.Equal(t, second.ID, jwks.Keys[1].KeyID)
+		require.True(t, jwks.Keys[1].IsPublic())
+		require.Equal(t, "ES256", jwks.Keys[1].Algorithm)
+	})
+
+	t.Run("key not found", func(t *testing.T) {
+		k, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		key, err := k.Rotate("issuer1")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		require.Nil(t, key)
+
+		jwks, err := k.PublicKeys("issuer1")
+		require.ErrorIs(t, err, ErrKeyNotFound)
+		require.Nil(t, jwks)
+	})
+}
+
+func TestSigner_Sign(t *testing.T) {
+	s := &Signer{key: "invalid"}
+
+	sig, err := s.Sign([]byte("data"))
+	require.ErrorIs(t, err, ErrUnsupportedKeyType)
+	require.Nil(t, sig)
+}
+
+func verifySignature(t *testing.T, key *Key, data, sig []byte) {
+	t.Helper()
+
+	switch pub := key.JWK.Public().Key.(type) {
+	case ed25519.PublicKey:
+		require.Equal(t, "EdDSA", key.Alg())
+		require.True(t, ed25519.Verify(pub, data, sig))
+	case *ecdsa.PublicKey:
+		var digest []byte
+
+		if key.Type == P384 {
+			require.Equal(t, "ES384", key.Alg())
+
+			h := sha512.Sum384(data)
+			digest = h[:]
+		} else {
+			require.Equal(t, "ES256", key.Alg())
+
+			h := sha256.Sum256(data)
+			digest = h[:]
+		}
+
+		size := len(sig) / 2
+
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+
+		require.True(t, ecdsa.Verify(pub, digest, r, s))
+	default:
+		require.Fail(t, "unexpected public key type")
+	}
+}
@@ -0,0 +1,402 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/trustbloc/edge-core/pkg/log"
+
+	"github.com/trustbloc/sandbox/pkg/statuslist"
+)
+
+const (
+	// stores
+	credentialStoreName = "issuer_credentials"
+	auditStoreName      = "issuer_credential_audit"
+
+	// tags
+	profileTagName      = "profile"
+	credentialIDTagName = "credentialID"
+
+	// Active credential.
+	Active Status = "active"
+	// Suspended credential, it can be reinstated.
+	Suspended Status = "suspended"
+	// Revoked credential, it can't be reinstated.
+	Revoked Status = "revoked"
+
+	// RevokeAction revokes the active or suspended credential.
+	RevokeAction Action = "revoke"
+	// SuspendAction suspends the active credential.
+	SuspendAction Action = "suspend"
+	// ReinstateAction reinstates the suspended credential.
+	ReinstateAction Action = "reinstate"
+)
+
+var logger = log.New("sandbox-lifecycle")
+
+var (
+	// ErrCredentialNotFound is returned when no credential is registered with the given ID.
+	ErrCredentialNotFound = errors.New("credential not found")
+	// ErrInvalidTransition is returned when the action isn't allowed in the current status of the credential.
+	ErrInvalidTransition = errors.New("invalid status transition")
+)
+
+// Status of the credential.
+type Status string
+
+// Action changing the status of the credential.
+type Action string
+
+// Credential is the record of the issued credential.
+type Credential struct {
+	ID          string            `json:"id"`
+	Profile     string            `json:"profile"`
+	Types       []string          `json:"types,omitempty"`
+	Subject     string            `json:"subject,omitempty"`
+	Format      string            `json:"format,omitempty"`
+	IssuedAt    time.Time         `json:"issuedAt"`
+	Status      Status            `json:"status"`
+	StatusEntry *statuslist.Entry `json:"statusEntry,omitempty"`
+	UpdatedBy   string            `json:"updatedBy,omitempty"`
+	UpdatedAt   *time.Time        `json:"updatedAt,omitempty"`
+	Reason      string            `json:"reason,omitempty"`
+}
+
+// AuditRecord is the record of the status change of the credential.
+type AuditRecord struct {
+	ID             string    `json:"id"`
+	CredentialID   string    `json:"credentialID"`
+	Profile        string    `json:"profile"`
+	Action         Action    `json:"action"`
+	PreviousStatus Status    `json:"previousStatus"`
+	Status         Status    `json:"status"`
+	Actor          string    `json:"actor"`
+	Reason         string    `json:"reason,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// AuditQuery filters the audit records, empty fields match any record.
+type AuditQuery struct {
+	CredentialID string
+	Profile      string
+}
+
+type statusUpdater interface {
+	Update(listID string, index int, status bool) error
+}
+
+// Manager keeps the records of the issued credentials and changes their status.
+type Manager struct {
+	store       storage.Store
+	auditStore  storage.Store
+	statusLists statusUpdater
+	now         func() time.Time
+	mutex       sync.Mutex
+}
+
+// New returns new credential lifecycle manager. Status changes are applied to the status lists.
+func New(provider storage.Provider, statusLists statusUpdater) (*Manager, error) {
+	store, err := openStore(provider, credentialStoreName, profileTagName)
+	if err != nil {
+		return nil, err
+	}
+
+	auditStore, err := openStore(provider, auditStoreName, profileTagName, credentialIDTagName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manager{store: store, auditStore: auditStore, statusLists: statusLists, now: time.Now}, nil
+}
+
+// Register saves the record of the issued credential as active one.
+func (m *Manager) Register(credential *Credential) error {
+	c := *credential
+	c.Status = Active
+
+	if c.IssuedAt.IsZero() {
+		c.IssuedAt = m.now().UTC()
+	}
+
+	return m.save(&c)
+}
+
+// Get returns the record of the credential.
+func (m *Manager) Get(credentialID string) (*Credential, error) {
+	credentialBytes, err := m.store.Get(credentialID)
+	if err != nil {
+		if errors.Is(err, storage.ErrDataNotFound) {
+			return nil, ErrCredentialNotFound
+		}
+
+		return nil, fmt.Errorf("get credential : %w", err)
+	}
+
+	credential := &Credential{}
+
+	err = json.Unmarshal(credentialBytes, credential)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal credential : %w", err)
+	}
+
+	return credential, nil
+}
+
+// List returns the records of the credentials issued by the profile, the most recent first.
+func (m *Manager) List(profile string) ([]*Credential, error) {
+	credentials := []*Credential{}
+
+	err := query(m.store, tagQuery(profileTagName, profile), func(value []byte) error {
+		credential := &Credential{}
+
+		e := json.Unmarshal(value, credential)
+		if e != nil {
+			return fmt.Errorf("unmarshal credential : %w", e)
+		}
+
+		credentials = append(credentials, credential)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(credentials, func(i, j int) bool {
+		return credentials[i].IssuedAt.After(credentials[j].IssuedAt)
+	})
+
+	return credentials, nil
+}
+
+// Revoke revokes the credential. Credential having suspension status entry stays suspended for good.
+func (m *Manager) Revoke(credentialID, actor, reason string) (*Credential, error) {
+	return m.change(credentialID, RevokeAction, actor, reason)
+}
+
+// Suspend suspends the credential, the status entry of the credential must have suspension purpose.
+func (m *Manager) Suspend(credentialID, actor, reason string) (*Credential, error) {
+	return m.change(credentialID, SuspendAction, actor, reason)
+}
+
+// Reinstate reinstates the suspended credential.
+func (m *Manager) Reinstate(credentialID, actor, reason string) (*Credential, error) {
+	return m.change(credentialID, ReinstateAction, actor, reason)
+}
+
+// AuditLog returns the audit records matching the query in chronological order.
+func (m *Manager) AuditLog(q *AuditQuery) ([]*AuditRecord, error) {
+	expression := tagQuery(profileTagName, q.Profile)
+	if q.CredentialID != "" {
+		expression = tagQuery(credentialIDTagName, q.CredentialID)
+	}
+
+	records := []*AuditRecord{}
+
+	err := query(m.auditStore, expression, func(value []byte) error {
+		record := &AuditRecord{}
+
+		e := json.Unmarshal(value, record)
+		if e != nil {
+			return fmt.Errorf("unmarshal audit record : %w", e)
+		}
+
+		if q.Profile != "" && record.Profile != q.Profile {
+			return nil
+		}
+
+		records = append(records, record)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Timestamp.Before(records[j].Timestamp) })
+
+	return records, nil
+}
+
+func (m *Manager) change(credentialID string, action Action, actor, reason string) (*Credential, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	credential, err := m.Get(credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, bit, err := transition(credential, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential.StatusEntry != nil {
+		err = m.statusLists.Update(credential.StatusEntry.ListID, credential.StatusEntry.Index, bit)
+		if err != nil {
+			return nil, fmt.Errorf("update credential status : %w", err)
+		}
+	}
+
+	now := m.now().UTC()
+
+	record := &AuditRecord{
+		ID:             uuid.NewString(),
+		CredentialID:   credential.ID,
+		Profile:        credential.Profile,
+		Action:         action,
+		PreviousStatus: credential.Status,
+		Status:         status,
+		Actor:          actor,
+		Reason:         reason,
+		Timestamp:      now,
+	}
+
+	credential.Status = status
+	credential.UpdatedBy = actor
+	credential.UpdatedAt = &now
+	credential.Reason = reason
+
+	err = m.save(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.audit(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// transition returns the new status of the credential and the status list bit of the action.
+func transition(credential *Credential, action Action) (Status, bool, error) {
+	suspendable := credential.StatusEntry != nil && credential.StatusEntry.Purpose == statuslist.Suspension
+
+	switch {
+	case action == RevokeAction && credential.Status != Revoked:
+		return Revoked, true, nil
+	case action == SuspendAction && credential.Status == Active:
+		if !suspendable {
+			return "", false, fmt.Errorf("%w : credential status doesn't support suspension", ErrInvalidTransition)
+		}
+
+		return Suspended, true, nil
+	case action == ReinstateAction && credential.Status == Suspended:
+		return Active, false, nil
+	default:
+		return "", false, fmt.Errorf("%w : can't %s %s credential", ErrInvalidTransition, action, credential.Status)
+	}
+}
+
+func (m *Manager) save(credential *Credential) error {
+	credentialBytes, err := json.Marshal(credential)
+	if err != nil {
+		return fmt.Errorf("marshal credential : %w", err)
+	}
+
+	err = m.store.Put(credential.ID, credentialBytes,
+		storage.Tag{Name: profileTagName, Value: encodeTagValue(credential.Profile)})
+	if err != nil {
+		return fmt.Errorf("save credential : %w", err)
+	}
+
+	return nil
+}
+
+func (m *Manager) audit(record *AuditRecord) error {
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal audit record : %w", err)
+	}
+
+	err = m.auditStore.Put(record.ID, recordBytes,
+		storage.Tag{Name: profileTagName, Value: encodeTagValue(record.Profile)},
+		storage.Tag{Name: credentialIDTagName, Value: encodeTagValue(record.CredentialID)},
+	)
+	if err != nil {
+		return fmt.Errorf("save audit record : %w", err)
+	}
+
+	logger.Infof("credential %s : %s by %s, %s -> %s", record.CredentialID, record.Action, record.Actor,
+		record.PreviousStatus, record.Status)
+
+	return nil
+}
+
+func openStore(provider storage.Provider, name string, tagNames ...string) (storage.Store, error) {
+	store, err := provider.OpenStore(name)
+	if err != nil {
+		return nil, fmt.Errorf("open store %s : %w", name, err)
+	}
+
+	err = provider.SetStoreConfig(name, storage.StoreConfiguration{TagNames: tagNames})
+	if err != nil {
+		return nil, fmt.Errorf("set store configuration of %s : %w", name, err)
+	}
+
+	return store, nil
+}
+
+func query(store storage.Store, expression string, handle func(value []byte) error) error {
+	iter, err := store.Query(expression)
+	if err != nil {
+		return fmt.Errorf("query %s : %w", expression, err)
+	}
+
+	defer func() {
+		if e := iter.Close(); e != nil {
+			logger.Warnf("failed to close iterator : %s", e)
+		}
+	}()
+
+	more, err := iter.Next()
+
+	for ; err == nil && more; more, err = iter.Next() {
+		value, e := iter.Value()
+		if e != nil {
+			return fmt.Errorf("get value : %w", e)
+		}
+
+		e = handle(value)
+		if e != nil {
+			return e
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("iterate %s : %w", expression, err)
+	}
+
+	return nil
+}
+
+// tagQuery returns query expression matching the tag value, or any value if it's empty.
+func tagQuery(name, value string) string {
+	if value == "" {
+		return name
+	}
+
+	return name + ":" + encodeTagValue(value)
+}
+
+// encodeTagValue encodes the tag value, since tag values can't contain ':' and are restricted by some backends.
+func encodeTagValue(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
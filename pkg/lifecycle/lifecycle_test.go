@@ -0,0 +1,279 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package lifecycle
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/statuslist"
+)
+
+const baseURL = "https://issuer.example.com/issuer/status"
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), &mockStatusUpdater{})
+		require.NoError(t, err)
+		require.NotNil(t, m)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")}, &mockStatusUpdater{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open store issuer_credentials : open error")
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{
+			OpenStoreReturn:   &mockstorage.Store{},
+			ErrSetStoreConfig: errors.New("config error"),
+		}, &mockStatusUpdater{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "set store configuration of issuer_credentials : config error")
+	})
+}
+
+func TestManager_RegisterAndList(t *testing.T) {
+	m, err := New(mem.NewProvider(), &mockStatusUpdater{})
+	require.NoError(t, err)
+
+	issuedAt := time.Now().Add(-time.Hour)
+
+	require.NoError(t, m.Register(&Credential{ID: "urn:uuid:1", Profile: "https://profile/1", IssuedAt: issuedAt}))
+	require.NoError(t, m.Register(&Credential{ID: "urn:uuid:2", Profile: "https://profile/1", Status: Revoked}))
+	require.NoError(t, m.Register(&Credential{ID: "urn:uuid:3", Profile: "profile2"}))
+
+	credential, err := m.Get("urn:uuid:2")
+	require.NoError(t, err)
+	require.Equal(t, Active, credential.Status)
+	require.False(t, credential.IssuedAt.IsZero())
+
+	credentials, err := m.List("https://profile/1")
+	require.NoError(t, err)
+	require.Len(t, credentials, 2)
+	require.Equal(t, "urn:uuid:2", credentials[0].ID)
+	require.Equal(t, "urn:uuid:1", credentials[1].ID)
+
+	credentials, err = m.List("")
+	require.NoError(t, err)
+	require.Len(t, credentials, 3)
+
+	credentials, err = m.List("unknown")
+	require.NoError(t, err)
+	require.Empty(t, credentials)
+
+	_, err = m.Get("unknown")
+	require.ErrorIs(t, err, ErrCredentialNotFound)
+}
+
+func TestManager_Lifecycle(t *testing.T) {
+	t.Run("suspend, reinstate and revoke", func(t *testing.T) {
+		m, statusLists := newManager(t)
+
+		entry, err := statusLists.Assign("issuer", baseURL, statuslist.Suspension)
+		require.NoError(t, err)
+
+		require.NoError(t, m.Register(&Credential{ID: "urn:uuid:1", Profile: "profile", StatusEntry: entry}))
+
+		credential, err := m.Suspend("urn:uuid:1", "alice", "investigation")
+		require.NoError(t, err)
+		require.Equal(t, Suspended, credential.Status)
+		require.Equal(t, "alice", credential.UpdatedBy)
+		require.Equal(t, "investigation", credential.Reason)
+		require.NotNil(t, credential.UpdatedAt)
+		requireStatus(t, statusLists, entry, true)
+
+		_, err = m.Suspend("urn:uuid:1", "alice", "")
+		require.ErrorIs(t, err, ErrInvalidTransition)
+
+		credential, err = m.Reinstate("urn:uuid:1", "bob", "cleared")
+		require.NoError(t, err)
+		require.Equal(t, Active, credential.Status)
+		require.Equal(t, "bob", credential.UpdatedBy)
+		requireStatus(t, statusLists, entry, false)
+
+		_, err = m.Reinstate("urn:uuid:1", "bob", "")
+		require.ErrorIs(t, err, ErrInvalidTransition)
+
+		credential, err = m.Revoke("urn:uuid:1", "carol", "")
+		require.NoError(t, err)
+		require.Equal(t, Revoked, credential.Status)
+		requireStatus(t, statusLists, entry, true)
+
+		_, err = m.Reinstate("urn:uuid:1", "bob", "")
+		require.ErrorIs(t, err, ErrInvalidTransition)
+
+		_, err = m.Revoke("urn:uuid:1", "carol", "")
+		require.ErrorIs(t, err, ErrInvalidTransition)
+
+		saved, err := m.Get("urn:uuid:1")
+		require.NoError(t, err)
+		require.Equal(t, Revoked, saved.Status)
+		require.Equal(t, "carol", saved.UpdatedBy)
+
+		records, err := m.AuditLog(&AuditQuery{CredentialID: "urn:uuid:1"})
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, SuspendAction, records[0].Action)
+		require.Equal(t, Active, records[0].PreviousStatus)
+		require.Equal(t, Suspended, records[0].Status)
+		require.Equal(t, "alice", records[0].Actor)
+		require.Equal(t, ReinstateAction, records[1].Action)
+		require.Equal(t, RevokeAction, records[2].Action)
+		require.Equal(t, "carol", records[2].Actor)
+	})
+
+	t.Run("revocation status entry can't be suspended", func(t *testing.T) {
+		m, statusLists := newManager(t)
+
+		entry, err := statusLists.Assign("issuer", baseURL, statuslist.Revocation)
+		require.NoError(t, err)
+
+		require.NoError(t, m.Register(&Credential{ID: "urn:uuid:1", Profile: "profile", StatusEntry: entry}))
+
+		_, err = m.Suspend("urn:uuid:1", "alice", "")
+		require.ErrorIs(t, err, ErrInvalidTransition)
+		require.Contains(t, err.Error(), "credential status doesn't support suspension")
+
+		_, err = m.Revoke("urn:uuid:1", "alice", "")
+		require.NoError(t, err)
+		requireStatus(t, statusLists, entry, true)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		m, _ := newManager(t)
+
+		_, err := m.Revoke("unknown", "alice", "")
+		require.ErrorIs(t, err, ErrCredentialNotFound)
+	})
+
+	t.Run("status update error", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), &mockStatusUpdater{err: errors.New("update error")})
+		require.NoError(t, err)
+
+		require.NoError(t, m.Register(&Credential{ID: "urn:uuid:1", StatusEntry: &statuslist.Entry{ListID: "list"}}))
+
+		_, err = m.Revoke("urn:uuid:1", "alice", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "update credential status : update error")
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		m := &Manager{store: &mockstorage.Store{GetReturn: []byte(`{"id":"1","status":"active"}`),
+			ErrPut: errors.New("put error")}, statusLists: &mockStatusUpdater{}, now: time.Now}
+
+		_, err := m.Revoke("1", "alice", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save credential : put error")
+
+		m.store = &mockstorage.Store{GetReturn: []byte(`{"id":"1","status":"active"}`)}
+		m.auditStore = &mockstorage.Store{ErrPut: errors.New("put error")}
+
+		_, err = m.Revoke("1", "alice", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save audit record : put error")
+
+		m.store = &mockstorage.Store{ErrGet: errors.New("get error")}
+
+		_, err = m.Revoke("1", "alice", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get credential : get error")
+
+		m.store = &mockstorage.Store{GetReturn: []byte("{")}
+
+		_, err = m.Revoke("1", "alice", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal credential")
+	})
+}
+
+func TestManager_AuditLog(t *testing.T) {
+	m, err := New(mem.NewProvider(), &mockStatusUpdater{})
+	require.NoError(t, err)
+
+	require.NoError(t, m.Register(&Credential{ID: "urn:uuid:1", Profile: "profile1"}))
+	require.NoError(t, m.Register(&Credential{ID: "urn:uuid:2", Profile: "profile2"}))
+
+	_, err = m.Revoke("urn:uuid:1", "alice", "")
+	require.NoError(t, err)
+
+	_, err = m.Revoke("urn:uuid:2", "bob", "")
+	require.NoError(t, err)
+
+	records, err := m.AuditLog(&AuditQuery{})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	records, err = m.AuditLog(&AuditQuery{Profile: "profile2"})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "bob", records[0].Actor)
+
+	records, err = m.AuditLog(&AuditQuery{CredentialID: "urn:uuid:1", Profile: "profile2"})
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func TestManager_QueryErrors(t *testing.T) {
+	for iter, errMsg := range map[*mockstorage.Iterator]string{
+		{ErrNext: errors.New("next error")}:                     "iterate profile : next error",
+		{NextReturn: true, ErrValue: errors.New("value error")}: "get value : value error",
+		{NextReturn: true, ValueReturn: []byte("{")}:            "unmarshal",
+	} {
+		m := &Manager{store: &mockstorage.Store{QueryReturn: iter}, auditStore: &mockstorage.Store{QueryReturn: iter}}
+
+		_, err := m.List("")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errMsg)
+
+		_, err = m.AuditLog(&AuditQuery{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errMsg)
+	}
+
+	m := &Manager{store: &mockstorage.Store{ErrQuery: errors.New("query error")}}
+
+	_, err := m.List("")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query profile : query error")
+}
+
+type mockStatusUpdater struct {
+	err error
+}
+
+func (m *mockStatusUpdater) Update(string, int, bool) error {
+	return m.err
+}
+
+func newManager(t *testing.T) (*Manager, *statuslist.Manager) {
+	t.Helper()
+
+	provider := mem.NewProvider()
+
+	statusLists, err := statuslist.New(provider, statuslist.WithSize(16))
+	require.NoError(t, err)
+
+	m, err := New(provider, statusLists)
+	require.NoError(t, err)
+
+	return m, statusLists
+}
+
+func requireStatus(t *testing.T, statusLists *statuslist.Manager, entry *statuslist.Entry, expected bool) {
+	t.Helper()
+
+	status, err := statusLists.Status(entry.ListID, entry.Index)
+	require.NoError(t, err)
+	require.Equal(t, expected, status)
+}
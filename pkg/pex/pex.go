@@ -0,0 +1,358 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	submissionProperty = "presentation_submission"
+	subjectProperty    = "credentialSubject"
+)
+
+// ErrNotSatisfied is returned when the presentation submission doesn't satisfy the presentation definition.
+var ErrNotSatisfied = errors.New("presentation definition not satisfied")
+
+// subjectPathRegex matches the JSONPath of the credential subject and captures the selected subject property.
+var subjectPathRegex = regexp.MustCompile(`^\$\.credentialSubject(?:\.([^.\[]+)|\['([^']+)'\]|\["([^"]+)"\])?`)
+
+// singleQuotedKeyRegex matches the single quoted bracket notation segments of a JSONPath, which the JSONPath
+// evaluator doesn't support.
+var singleQuotedKeyRegex = regexp.MustCompile(`\['([^']*)'\]`)
+
+// Result of the evaluation of the presentation submission.
+type Result struct {
+	DefinitionID string              `json:"definitionID"`
+	SubmissionID string              `json:"submissionID,omitempty"`
+	Descriptors  []*DescriptorResult `json:"descriptors"`
+}
+
+// DescriptorResult is the credential matched for the input descriptor and its field values.
+type DescriptorResult struct {
+	ID         string        `json:"id"`
+	Name       string        `json:"name,omitempty"`
+	Credential *Credential   `json:"credential"`
+	Fields     []*FieldValue `json:"fields,omitempty"`
+}
+
+// Credential summarizes the matched credential.
+type Credential struct {
+	ID     string   `json:"id,omitempty"`
+	Types  []string `json:"types"`
+	Issuer string   `json:"issuer"`
+}
+
+// FieldValue is the value of the constraint field extracted from the credential. The value is true for the fields
+// with a required predicate, since only whether the field satisfies the filter is disclosed.
+type FieldValue struct {
+	ID    string      `json:"id,omitempty"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// Evaluator evaluates the presentation submissions against the presentation definitions.
+type Evaluator struct {
+	documentLoader ld.DocumentLoader
+}
+
+// New returns new evaluator loading the JSON-LD contexts of the credentials with the given loader.
+func New(documentLoader ld.DocumentLoader) *Evaluator {
+	return &Evaluator{documentLoader: documentLoader}
+}
+
+// Evaluate matches the credentials of the presentation to the input descriptors of the definition through the
+// descriptor map of the submission and checks the schemas, the constraint fields and the limit disclosure of the
+// descriptors. The submission embedded in the presentation is used if the given one is nil. The proofs of the
+// credentials aren't checked.
+func (e *Evaluator) Evaluate(pd *presexch.PresentationDefinition, vp *verifiable.Presentation,
+	submission *presexch.PresentationSubmission) (*Result, error) {
+	if pd == nil {
+		return nil, fmt.Errorf("%w : missing presentation definition", ErrNotSatisfied)
+	}
+
+	submissionVP, submission, err := withSubmission(vp, submission)
+	if err != nil {
+		return nil, fmt.Errorf("%w : %s", ErrNotSatisfied, err)
+	}
+
+	if submission.DefinitionID != "" && submission.DefinitionID != pd.ID {
+		return nil, fmt.Errorf("%w : submission is for definition %s", ErrNotSatisfied, submission.DefinitionID)
+	}
+
+	matched, err := pd.Match(submissionVP, e.documentLoader, presexch.WithCredentialOptions(
+		verifiable.WithJSONLDDocumentLoader(e.documentLoader),
+		verifiable.WithDisabledProofCheck(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("%w : %s", ErrNotSatisfied, err)
+	}
+
+	result := &Result{
+		DefinitionID: pd.ID,
+		SubmissionID: submission.ID,
+	}
+
+	for _, descriptor := range pd.InputDescriptors {
+		descriptorResult, descriptorErr := evaluateDescriptor(descriptor, matched[descriptor.ID])
+		if descriptorErr != nil {
+			return nil, fmt.Errorf("%w : input descriptor %s : %s", ErrNotSatisfied, descriptor.ID, descriptorErr)
+		}
+
+		result.Descriptors = append(result.Descriptors, descriptorResult)
+	}
+
+	return result, nil
+}
+
+// withSubmission returns a copy of the presentation embedding the submission, with the submission context and type
+// required by the presexch match. The JWT of the copy is dropped so that the descriptor paths apply to the JSON
+// presentation.
+func withSubmission(vp *verifiable.Presentation,
+	submission *presexch.PresentationSubmission) (*verifiable.Presentation, *presexch.PresentationSubmission, error) {
+	if submission == nil {
+		embedded, ok := vp.CustomFields[submissionProperty]
+		if !ok {
+			return nil, nil, errors.New("missing presentation submission")
+		}
+
+		submission = &presexch.PresentationSubmission{}
+
+		if err := remarshal(embedded, submission); err != nil {
+			return nil, nil, fmt.Errorf("parse presentation submission : %w", err)
+		}
+	}
+
+	submissionFields := map[string]interface{}{}
+
+	if err := remarshal(submission, &submissionFields); err != nil {
+		return nil, nil, fmt.Errorf("marshal presentation submission : %w", err)
+	}
+
+	submissionVP := *vp
+	submissionVP.JWT = ""
+	submissionVP.Context = appendMissing(vp.Context, presexch.PresentationSubmissionJSONLDContextIRI)
+	submissionVP.Type = appendMissing(vp.Type, presexch.PresentationSubmissionJSONLDType)
+	submissionVP.CustomFields = verifiable.CustomFields{}
+
+	for k, v := range vp.CustomFields {
+		submissionVP.CustomFields[k] = v
+	}
+
+	submissionVP.CustomFields[submissionProperty] = submissionFields
+
+	return &submissionVP, submission, nil
+}
+
+func evaluateDescriptor(descriptor *presexch.InputDescriptor, vc *verifiable.Credential) (*DescriptorResult, error) {
+	credential, err := credentialFields(vc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DescriptorResult{
+		ID:   descriptor.ID,
+		Name: descriptor.Name,
+		Credential: &Credential{
+			ID:     vc.ID,
+			Types:  vc.Types,
+			Issuer: vc.Issuer.ID,
+		},
+	}
+
+	if descriptor.Constraints == nil {
+		return result, nil
+	}
+
+	for _, field := range descriptor.Constraints.Fields {
+		value, e := evaluateField(field, credential)
+		if e != nil {
+			return nil, e
+		}
+
+		result.Fields = append(result.Fields, value)
+	}
+
+	if descriptor.Constraints.LimitDisclosure != nil && *descriptor.Constraints.LimitDisclosure == presexch.Required {
+		if e := checkLimitDisclosure(result.Fields, credential); e != nil {
+			return nil, e
+		}
+	}
+
+	return result, nil
+}
+
+// evaluateField returns the value of the first field path which is present in the credential and satisfies the
+// field filter.
+func evaluateField(field *presexch.Field, credential map[string]interface{}) (*FieldValue, error) {
+	var filter gojsonschema.JSONLoader
+
+	if field.Filter != nil {
+		filter = gojsonschema.NewGoLoader(field.Filter)
+	}
+
+	reason := "no path is present in the credential"
+
+	for _, path := range field.Path {
+		value, err := jsonpath.Get(normalizePath(path), credential)
+		if err != nil {
+			continue
+		}
+
+		if filter != nil {
+			result, e := gojsonschema.Validate(filter, gojsonschema.NewGoLoader(value))
+			if e != nil {
+				return nil, fmt.Errorf("field %s : invalid filter : %w", fieldName(field), e)
+			}
+
+			if !result.Valid() {
+				reason = fmt.Sprintf("value of %s doesn't match the filter : %s", path, result.Errors()[0])
+
+				continue
+			}
+		}
+
+		if field.Predicate != nil && *field.Predicate == presexch.Required {
+			value = true
+		}
+
+		return &FieldValue{ID: field.ID, Path: path, Value: value}, nil
+	}
+
+	return nil, fmt.Errorf("field %s : %s", fieldName(field), reason)
+}
+
+// normalizePath rewrites the single quoted bracket notation segments of the path to double quoted ones, so that the
+// path resolves the same properties as matched by subjectPathRegex.
+func normalizePath(path string) string {
+	return singleQuotedKeyRegex.ReplaceAllStringFunc(path, func(segment string) string {
+		return "[" + strconv.Quote(singleQuotedKeyRegex.FindStringSubmatch(segment)[1]) + "]"
+	})
+}
+
+// checkLimitDisclosure checks that the credential subject discloses only the subject properties selected by the
+// constraint fields, besides the subject ID.
+func checkLimitDisclosure(fields []*FieldValue, credential map[string]interface{}) error {
+	selected := map[string]bool{"id": true}
+
+	for _, field := range fields {
+		match := subjectPathRegex.FindStringSubmatch(field.Path)
+		if match == nil {
+			continue
+		}
+
+		property := match[1] + match[2] + match[3]
+		if property == "" {
+			// the whole subject is selected
+			return nil
+		}
+
+		selected[property] = true
+	}
+
+	var disclosed []string
+
+	for _, property := range subjectProperties(credential) {
+		if !selected[property] {
+			disclosed = append(disclosed, property)
+		}
+	}
+
+	if len(disclosed) > 0 {
+		return fmt.Errorf("limit disclosure is required but the credential discloses %s",
+			strings.Join(disclosed, ", "))
+	}
+
+	return nil
+}
+
+// subjectProperties returns the sorted properties of the credential subjects.
+func subjectProperties(credential map[string]interface{}) []string {
+	var subjects []interface{}
+
+	switch subject := credential[subjectProperty].(type) {
+	case []interface{}:
+		subjects = subject
+	case map[string]interface{}:
+		subjects = []interface{}{subject}
+	}
+
+	properties := map[string]struct{}{}
+
+	for _, s := range subjects {
+		if subject, ok := s.(map[string]interface{}); ok {
+			for property := range subject {
+				properties[property] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(properties))
+	for property := range properties {
+		result = append(result, property)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// credentialFields returns the JSON fields of the credential, the claims of the JWT credentials in their JSON-LD
+// form.
+func credentialFields(vc *verifiable.Credential) (map[string]interface{}, error) {
+	jsonVC := *vc
+	jsonVC.JWT = ""
+
+	credential := map[string]interface{}{}
+
+	if err := remarshal(&jsonVC, &credential); err != nil {
+		return nil, fmt.Errorf("marshal credential : %w", err)
+	}
+
+	return credential, nil
+}
+
+func fieldName(field *presexch.Field) string {
+	if field.ID != "" {
+		return field.ID
+	}
+
+	return strings.Join(field.Path, ", ")
+}
+
+func appendMissing(values []string, value string) []string {
+	result := append([]string{}, values...)
+
+	for _, v := range values {
+		if v == value {
+			return result
+		}
+	}
+
+	return append(result, value)
+}
+
+func remarshal(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, to)
+}
@@ -0,0 +1,265 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package pex
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/ld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/ldcontext"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	mockldstore "github.com/hyperledger/aries-framework-go/pkg/mock/ld"
+	ldstore "github.com/hyperledger/aries-framework-go/pkg/store/ld"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	definitionID = "degree-definition"
+	descriptorID = "degree"
+	credentialID = "http://example.com/credentials/1"
+	issuerDID    = "did:example:issuer"
+	degreeSchema = "https://example.com/vocab#DegreeCredential"
+	testContext  = "https://example.com/context/v1"
+)
+
+const testContextDocument = `{
+  "@context": {
+    "DegreeCredential": {"@id": "https://example.com/vocab#DegreeCredential"},
+    "degree": {"@id": "https://example.com/vocab#degree"},
+    "name": {"@id": "https://example.com/vocab#name"},
+    "age": {"@id": "https://example.com/vocab#age"}
+  }
+}`
+
+const testCredential = `{
+  "@context": ["https://www.w3.org/2018/credentials/v1", "https://example.com/context/v1"],
+  "id": "http://example.com/credentials/1",
+  "type": ["VerifiableCredential", "DegreeCredential"],
+  "issuer": "did:example:issuer",
+  "issuanceDate": "2022-01-01T00:00:00Z",
+  "credentialSubject": {
+    "id": "did:example:holder",
+    "degree": "BachelorDegree",
+    "name": "Jayden Doe",
+    "age": 25
+  }
+}`
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	loader := createTestDocumentLoader(t)
+	e := New(loader)
+
+	t.Run("success", func(t *testing.T) {
+		pd := degreeDefinition(
+			&presexch.Field{
+				ID:     "degree",
+				Path:   []string{"$.credentialSubject.type", "$.credentialSubject.degree"},
+				Filter: &presexch.Filter{Type: stringPtr("string"), Const: "BachelorDegree"},
+			},
+			&presexch.Field{
+				ID:        "adult",
+				Path:      []string{"$.credentialSubject.age"},
+				Filter:    &presexch.Filter{Type: stringPtr("number"), Minimum: 18},
+				Predicate: preferencePtr(presexch.Required),
+			},
+			&presexch.Field{Path: []string{"$.issuer"}},
+		)
+
+		result, err := e.Evaluate(pd, createPresentation(t, loader), submission(definitionID, descriptorID))
+		require.NoError(t, err)
+		require.Equal(t, definitionID, result.DefinitionID)
+		require.Equal(t, "submission-1", result.SubmissionID)
+		require.Len(t, result.Descriptors, 1)
+
+		descriptor := result.Descriptors[0]
+		require.Equal(t, descriptorID, descriptor.ID)
+		require.Equal(t, "Degree", descriptor.Name)
+		require.Equal(t, &Credential{
+			ID:     credentialID,
+			Types:  []string{"VerifiableCredential", "DegreeCredential"},
+			Issuer: issuerDID,
+		}, descriptor.Credential)
+		require.Equal(t, []*FieldValue{
+			{ID: "degree", Path: "$.credentialSubject.degree", Value: "BachelorDegree"},
+			{ID: "adult", Path: "$.credentialSubject.age", Value: true},
+			{Path: "$.issuer", Value: issuerDID},
+		}, descriptor.Fields)
+	})
+
+	t.Run("success - submission embedded in the presentation", func(t *testing.T) {
+		vp := createPresentation(t, loader)
+		vp.CustomFields = verifiable.CustomFields{submissionProperty: map[string]interface{}{
+			"id":            "embedded",
+			"definition_id": definitionID,
+			"descriptor_map": []interface{}{
+				map[string]interface{}{"id": descriptorID, "format": "ldp_vc", "path": "$.verifiableCredential[0]"},
+			},
+		}}
+
+		result, err := e.Evaluate(degreeDefinition(), vp, nil)
+		require.NoError(t, err)
+		require.Equal(t, "embedded", result.SubmissionID)
+		require.Empty(t, result.Descriptors[0].Fields)
+		require.NotContains(t, vp.Context, presexch.PresentationSubmissionJSONLDContextIRI)
+	})
+
+	t.Run("limit disclosure", func(t *testing.T) {
+		pd := degreeDefinition(
+			&presexch.Field{Path: []string{"$.credentialSubject.degree"}},
+			&presexch.Field{Path: []string{"$.credentialSubject['age']"}},
+		)
+		pd.InputDescriptors[0].Constraints.LimitDisclosure = preferencePtr(presexch.Required)
+
+		_, err := e.Evaluate(pd, createPresentation(t, loader), submission(definitionID, descriptorID))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "the credential discloses name")
+
+		pd.InputDescriptors[0].Constraints.Fields = append(pd.InputDescriptors[0].Constraints.Fields,
+			&presexch.Field{Path: []string{`$.credentialSubject["name"]`}})
+
+		_, err = e.Evaluate(pd, createPresentation(t, loader), submission(definitionID, descriptorID))
+		require.NoError(t, err)
+
+		pd.InputDescriptors[0].Constraints.Fields = []*presexch.Field{{Path: []string{"$.credentialSubject"}}}
+
+		_, err = e.Evaluate(pd, createPresentation(t, loader), submission(definitionID, descriptorID))
+		require.NoError(t, err)
+	})
+
+	t.Run("error - field not satisfied", func(t *testing.T) {
+		pd := degreeDefinition(&presexch.Field{
+			ID:     "degree",
+			Path:   []string{"$.credentialSubject.degree"},
+			Filter: &presexch.Filter{Type: stringPtr("string"), Const: "MasterDegree"},
+		})
+
+		_, err := e.Evaluate(pd, createPresentation(t, loader), submission(definitionID, descriptorID))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "input descriptor degree : field degree : value of "+
+			"$.credentialSubject.degree doesn't match the filter")
+
+		pd = degreeDefinition(&presexch.Field{Path: []string{"$.credentialSubject.missing"}})
+
+		_, err = e.Evaluate(pd, createPresentation(t, loader), submission(definitionID, descriptorID))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "field $.credentialSubject.missing : no path is present in the credential")
+	})
+
+	t.Run("error - submission not matched", func(t *testing.T) {
+		vp := createPresentation(t, loader)
+
+		_, err := e.Evaluate(nil, vp, submission(definitionID, descriptorID))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "missing presentation definition")
+
+		_, err = e.Evaluate(degreeDefinition(), vp, nil)
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "missing presentation submission")
+
+		_, err = e.Evaluate(degreeDefinition(), vp, submission("other-definition", descriptorID))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "submission is for definition other-definition")
+
+		_, err = e.Evaluate(degreeDefinition(), vp, submission(definitionID, "other"))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "did not match the `id` property of any input descriptor")
+
+		pd := degreeDefinition()
+		pd.InputDescriptors[0].Schema = []*presexch.Schema{{URI: "https://example.com/vocab#OtherCredential"}}
+
+		_, err = e.Evaluate(pd, vp, submission(definitionID, descriptorID))
+		require.True(t, errors.Is(err, ErrNotSatisfied))
+		require.Contains(t, err.Error(), "requires schemas")
+	})
+}
+
+func degreeDefinition(fields ...*presexch.Field) *presexch.PresentationDefinition {
+	descriptor := &presexch.InputDescriptor{
+		ID:     descriptorID,
+		Name:   "Degree",
+		Schema: []*presexch.Schema{{URI: degreeSchema}},
+	}
+
+	if len(fields) > 0 {
+		descriptor.Constraints = &presexch.Constraints{Fields: fields}
+	}
+
+	return &presexch.PresentationDefinition{
+		ID:               definitionID,
+		InputDescriptors: []*presexch.InputDescriptor{descriptor},
+	}
+}
+
+func submission(definition, descriptor string) *presexch.PresentationSubmission {
+	return &presexch.PresentationSubmission{
+		ID:           "submission-1",
+		DefinitionID: definition,
+		DescriptorMap: []*presexch.InputDescriptorMapping{
+			{ID: descriptor, Format: "ldp_vc", Path: "$.verifiableCredential[0]"},
+		},
+	}
+}
+
+func createPresentation(t *testing.T, loader *ld.DocumentLoader) *verifiable.Presentation {
+	t.Helper()
+
+	vc, err := verifiable.ParseCredential([]byte(testCredential), verifiable.WithJSONLDDocumentLoader(loader),
+		verifiable.WithDisabledProofCheck())
+	require.NoError(t, err)
+
+	vp, err := verifiable.NewPresentation(verifiable.WithCredentials(vc))
+	require.NoError(t, err)
+
+	vp.Holder = "did:example:holder"
+
+	return vp
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func preferencePtr(p presexch.Preference) *presexch.Preference {
+	return &p
+}
+
+func TestNormalizePath(t *testing.T) {
+	require.Equal(t, "$.credentialSubject.age", normalizePath("$.credentialSubject.age"))
+	require.Equal(t, `$.credentialSubject["age"]`, normalizePath("$.credentialSubject['age']"))
+	require.Equal(t, `$["credentialSubject"]["given.name"]`, normalizePath("$['credentialSubject']['given.name']"))
+	require.Equal(t, `$.credentialSubject["say \"hi\""]`, normalizePath(`$.credentialSubject['say "hi"']`))
+}
+
+type mockLDStoreProvider struct {
+	ContextStore        ldstore.ContextStore
+	RemoteProviderStore ldstore.RemoteProviderStore
+}
+
+func (p *mockLDStoreProvider) JSONLDContextStore() ldstore.ContextStore {
+	return p.ContextStore
+}
+
+func (p *mockLDStoreProvider) JSONLDRemoteProviderStore() ldstore.RemoteProviderStore {
+	return p.RemoteProviderStore
+}
+
+func createTestDocumentLoader(t *testing.T) *ld.DocumentLoader {
+	t.Helper()
+
+	loader, err := ld.NewDocumentLoader(&mockLDStoreProvider{
+		ContextStore:        mockldstore.NewMockContextStore(),
+		RemoteProviderStore: mockldstore.NewMockRemoteProviderStore(),
+	}, ld.WithExtraContexts(ldcontext.Document{
+		URL:     testContext,
+		Content: []byte(testContextDocument),
+	}))
+	require.NoError(t, err)
+
+	return loader
+}
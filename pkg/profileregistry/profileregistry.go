@@ -0,0 +1,310 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profileregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/trustbloc/edge-core/pkg/log"
+)
+
+const (
+	// DefaultTTL is the time the profiles fetched from the VCS are cached by default.
+	DefaultTTL = 5 * time.Minute
+
+	// SourceVCS is the source of the profiles fetched from the VCS.
+	SourceVCS = "vcs"
+	// SourceOverride is the source of the profiles of the local override file.
+	SourceOverride = "override"
+
+	profileURLFormat = "%s/profile/%s"
+)
+
+var logger = log.New("sandbox-profileregistry")
+
+// ErrProfileNotFound is returned when the profile isn't overridden and the VCS has no profile with the given name.
+var ErrProfileNotFound = errors.New("issuer profile not found")
+
+// Profile is the VCS issuer profile.
+type Profile struct {
+	Name   string `json:"name"`
+	DID    string `json:"did,omitempty"`
+	Source string `json:"source"`
+	// Data is the profile as returned by the VCS or defined by the override file.
+	Data json.RawMessage `json:"-"`
+}
+
+type cacheEntry struct {
+	profile   *Profile
+	expiresAt time.Time
+}
+
+// Registry of the VCS issuer profiles. The profiles are fetched from the VCS and cached for the TTL, the profiles of
+// the override file take precedence over the VCS ones and are never expired.
+type Registry struct {
+	vcsURL       string
+	httpClient   *http.Client
+	requestToken string
+	ttl          time.Duration
+	names        []string
+	overrides    map[string]*Profile
+	now          func() time.Time
+
+	mutex sync.RWMutex
+	cache map[string]*cacheEntry
+}
+
+// Opt configures the registry.
+type Opt func(r *Registry)
+
+// WithHTTPClient sets the HTTP client of the VCS requests.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(r *Registry) {
+		r.httpClient = client
+	}
+}
+
+// WithRequestToken sets the bearer token of the VCS requests.
+func WithRequestToken(token string) Opt {
+	return func(r *Registry) {
+		r.requestToken = token
+	}
+}
+
+// WithTTL sets the time the profiles fetched from the VCS are cached, DefaultTTL by default. Profiles aren't cached
+// if the TTL isn't positive.
+func WithTTL(ttl time.Duration) Opt {
+	return func(r *Registry) {
+		r.ttl = ttl
+	}
+}
+
+// WithProfileNames sets the names of the VCS profiles listed besides the overridden and the cached ones, since the
+// VCS doesn't list its profiles.
+func WithProfileNames(names ...string) Opt {
+	return func(r *Registry) {
+		r.names = names
+	}
+}
+
+// New returns new registry of the profiles of the VCS at the given URL. The override file, if set, is a JSON object
+// of the profiles by name.
+func New(vcsURL, overridesFile string, opts ...Opt) (*Registry, error) {
+	r := &Registry{
+		vcsURL:     vcsURL,
+		httpClient: http.DefaultClient,
+		ttl:        DefaultTTL,
+		overrides:  map[string]*Profile{},
+		now:        time.Now,
+		cache:      map[string]*cacheEntry{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if overridesFile != "" {
+		overrides, err := readOverrides(overridesFile)
+		if err != nil {
+			return nil, err
+		}
+
+		r.overrides = overrides
+
+		logger.Infof("loaded %d issuer profile overrides from %s", len(overrides), overridesFile)
+	}
+
+	return r, nil
+}
+
+// Get returns the profile with the given name, the cached one unless expired.
+func (r *Registry) Get(name string) (*Profile, error) {
+	if name == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	if p, ok := r.overrides[name]; ok {
+		return p, nil
+	}
+
+	r.mutex.RLock()
+	entry, ok := r.cache[name]
+	r.mutex.RUnlock()
+
+	if ok && r.now().Before(entry.expiresAt) {
+		return entry.profile, nil
+	}
+
+	p, err := r.fetch(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.ttl > 0 {
+		r.mutex.Lock()
+		r.cache[name] = &cacheEntry{profile: p, expiresAt: r.now().Add(r.ttl)}
+		r.mutex.Unlock()
+	}
+
+	return p, nil
+}
+
+// Invalidate removes the given profiles from the cache, or all of them if none is given. The overridden profiles
+// aren't affected.
+func (r *Registry) Invalidate(names ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if len(names) == 0 {
+		r.cache = map[string]*cacheEntry{}
+
+		return
+	}
+
+	for _, name := range names {
+		delete(r.cache, name)
+	}
+}
+
+// List returns the overridden profiles, the profiles of the configured names and the cached ones sorted by name.
+// The configured profiles failing to resolve are left out.
+func (r *Registry) List() []*Profile {
+	profiles := map[string]*Profile{}
+
+	for name, p := range r.overrides {
+		profiles[name] = p
+	}
+
+	r.mutex.RLock()
+	now := r.now()
+
+	for name, entry := range r.cache {
+		if now.Before(entry.expiresAt) {
+			profiles[name] = entry.profile
+		}
+	}
+	r.mutex.RUnlock()
+
+	for _, name := range r.names {
+		if _, ok := profiles[name]; ok {
+			continue
+		}
+
+		p, err := r.Get(name)
+		if err != nil {
+			logger.Warnf("failed to get issuer profile %s : %s", name, err)
+
+			continue
+		}
+
+		profiles[name] = p
+	}
+
+	result := make([]*Profile, 0, len(profiles))
+	for _, p := range profiles {
+		result = append(result, p)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+func (r *Registry) fetch(name string) (*Profile, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf(profileURLFormat, r.vcsURL, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create profile request : %w", err)
+	}
+
+	if r.requestToken != "" {
+		req.Header.Add("Authorization", "Bearer "+r.requestToken)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get profile %s : %w", name, err)
+	}
+
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Warnf("failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read profile %s : %w", name, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w : %s", ErrProfileNotFound, name)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get profile %s : %s: %s", name, resp.Status, string(body))
+	}
+
+	p, err := parseProfile(body, SourceVCS)
+	if err != nil {
+		return nil, fmt.Errorf("profile %s : %w", name, err)
+	}
+
+	if p.Name == "" {
+		p.Name = name
+	}
+
+	return p, nil
+}
+
+func parseProfile(data []byte, source string) (*Profile, error) {
+	p := &Profile{}
+
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("parse profile : %w", err)
+	}
+
+	p.Source = source
+	p.Data = data
+
+	return p, nil
+}
+
+func readOverrides(path string) (map[string]*Profile, error) {
+	data, err := os.ReadFile(path) // nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("read profile overrides : %w", err)
+	}
+
+	var raw map[string]json.RawMessage
+
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse profile overrides : %w", err)
+	}
+
+	overrides := make(map[string]*Profile, len(raw))
+
+	for name, profileData := range raw {
+		p, e := parseProfile(profileData, SourceOverride)
+		if e != nil {
+			return nil, fmt.Errorf("profile override %s : %w", name, e)
+		}
+
+		p.Name = name
+		overrides[name] = p
+	}
+
+	return overrides, nil
+}
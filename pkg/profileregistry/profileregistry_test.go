@@ -0,0 +1,270 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package profileregistry
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testProfile = "vc-issuer-1"
+
+type mockVCS struct {
+	mutex    sync.Mutex
+	requests map[string]int
+	token    string
+}
+
+func (m *mockVCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/profile/")
+
+	m.mutex.Lock()
+	m.requests[name]++
+	m.token = r.Header.Get("Authorization")
+	m.mutex.Unlock()
+
+	switch name {
+	case "error":
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, "server error")
+	case "invalid":
+		fmt.Fprint(w, "invalid")
+	case "unnamed":
+		fmt.Fprint(w, `{"did":"did:example:unnamed"}`)
+	case testProfile, "vc-issuer-2":
+		fmt.Fprintf(w, `{"name":%q,"did":"did:example:%s"}`, name, name)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (m *mockVCS) count(name string) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.requests[name]
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r, err := New("https://vcs.example.com", writeOverrides(t, `{"local":{"did":"did:example:local"}}`),
+			WithTTL(time.Minute), WithProfileNames(testProfile), WithRequestToken("token"))
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, r.ttl)
+		require.Equal(t, []string{testProfile}, r.names)
+		require.Equal(t, "token", r.requestToken)
+		require.Len(t, r.overrides, 1)
+	})
+
+	t.Run("missing override file", func(t *testing.T) {
+		_, err := New("https://vcs.example.com", filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read profile overrides")
+	})
+
+	t.Run("invalid override file", func(t *testing.T) {
+		_, err := New("https://vcs.example.com", writeOverrides(t, "invalid"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse profile overrides")
+
+		_, err = New("https://vcs.example.com", writeOverrides(t, `{"local":"invalid"}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "profile override local")
+	})
+}
+
+func TestRegistry_Get(t *testing.T) {
+	t.Run("profile is cached", func(t *testing.T) {
+		vcs, r := newRegistry(t, WithRequestToken("token"))
+
+		p, err := r.Get(testProfile)
+		require.NoError(t, err)
+		require.Equal(t, testProfile, p.Name)
+		require.Equal(t, "did:example:"+testProfile, p.DID)
+		require.Equal(t, SourceVCS, p.Source)
+		require.JSONEq(t, `{"name":"vc-issuer-1","did":"did:example:vc-issuer-1"}`, string(p.Data))
+		require.Equal(t, "Bearer token", vcs.token)
+
+		_, err = r.Get(testProfile)
+		require.NoError(t, err)
+		require.Equal(t, 1, vcs.count(testProfile))
+	})
+
+	t.Run("profile expired", func(t *testing.T) {
+		vcs, r := newRegistry(t, WithTTL(time.Minute))
+
+		now := time.Now()
+		r.now = func() time.Time { return now }
+
+		_, err := r.Get(testProfile)
+		require.NoError(t, err)
+
+		now = now.Add(2 * time.Minute)
+
+		_, err = r.Get(testProfile)
+		require.NoError(t, err)
+		require.Equal(t, 2, vcs.count(testProfile))
+	})
+
+	t.Run("cache disabled", func(t *testing.T) {
+		vcs, r := newRegistry(t, WithTTL(0))
+
+		for i := 0; i < 2; i++ {
+			_, err := r.Get(testProfile)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, 2, vcs.count(testProfile))
+	})
+
+	t.Run("override takes precedence", func(t *testing.T) {
+		vcs := &mockVCS{requests: map[string]int{}}
+
+		srv := httptest.NewServer(vcs)
+		defer srv.Close()
+
+		r, err := New(srv.URL, writeOverrides(t, `{"vc-issuer-1":{"name":"other","did":"did:example:local"}}`))
+		require.NoError(t, err)
+
+		p, err := r.Get(testProfile)
+		require.NoError(t, err)
+		require.Equal(t, testProfile, p.Name)
+		require.Equal(t, "did:example:local", p.DID)
+		require.Equal(t, SourceOverride, p.Source)
+		require.Zero(t, vcs.count(testProfile))
+	})
+
+	t.Run("unnamed profile", func(t *testing.T) {
+		_, r := newRegistry(t)
+
+		p, err := r.Get("unnamed")
+		require.NoError(t, err)
+		require.Equal(t, "unnamed", p.Name)
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		_, r := newRegistry(t)
+
+		_, err := r.Get("unknown")
+		require.True(t, errors.Is(err, ErrProfileNotFound))
+
+		_, err = r.Get("")
+		require.True(t, errors.Is(err, ErrProfileNotFound))
+	})
+
+	t.Run("vcs errors", func(t *testing.T) {
+		_, r := newRegistry(t)
+
+		_, err := r.Get("error")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "server error")
+		require.False(t, errors.Is(err, ErrProfileNotFound))
+
+		_, err = r.Get("invalid")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse profile")
+
+		r, err = New("http://127.0.0.1:0", "")
+		require.NoError(t, err)
+
+		_, err = r.Get(testProfile)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get profile")
+
+		r, err = New("://invalid", "")
+		require.NoError(t, err)
+
+		_, err = r.Get(testProfile)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "create profile request")
+	})
+}
+
+func TestRegistry_Invalidate(t *testing.T) {
+	vcs, r := newRegistry(t)
+
+	for _, name := range []string{testProfile, "vc-issuer-2"} {
+		_, err := r.Get(name)
+		require.NoError(t, err)
+	}
+
+	r.Invalidate(testProfile)
+
+	for _, name := range []string{testProfile, "vc-issuer-2"} {
+		_, err := r.Get(name)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 2, vcs.count(testProfile))
+	require.Equal(t, 1, vcs.count("vc-issuer-2"))
+
+	r.Invalidate()
+
+	_, err := r.Get("vc-issuer-2")
+	require.NoError(t, err)
+	require.Equal(t, 2, vcs.count("vc-issuer-2"))
+}
+
+func TestRegistry_List(t *testing.T) {
+	vcs := &mockVCS{requests: map[string]int{}}
+
+	srv := httptest.NewServer(vcs)
+	defer srv.Close()
+
+	r, err := New(srv.URL, writeOverrides(t, `{"local":{"did":"did:example:local"}}`),
+		WithProfileNames("vc-issuer-2", "unknown"))
+	require.NoError(t, err)
+
+	_, err = r.Get(testProfile)
+	require.NoError(t, err)
+
+	profiles := r.List()
+	require.Len(t, profiles, 3)
+	require.Equal(t, "local", profiles[0].Name)
+	require.Equal(t, SourceOverride, profiles[0].Source)
+	require.Equal(t, testProfile, profiles[1].Name)
+	require.Equal(t, "vc-issuer-2", profiles[2].Name)
+	require.Equal(t, SourceVCS, profiles[2].Source)
+
+	// configured profiles are cached
+	require.Len(t, r.List(), 3)
+	require.Equal(t, 1, vcs.count("vc-issuer-2"))
+	require.Equal(t, 2, vcs.count("unknown"))
+}
+
+func newRegistry(t *testing.T, opts ...Opt) (*mockVCS, *Registry) {
+	t.Helper()
+
+	vcs := &mockVCS{requests: map[string]int{}}
+
+	srv := httptest.NewServer(vcs)
+	t.Cleanup(srv.Close)
+
+	r, err := New(srv.URL, "", opts...)
+	require.NoError(t, err)
+
+	return vcs, r
+}
+
+func writeOverrides(t *testing.T, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "profiles.json")
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+
+	return file
+}
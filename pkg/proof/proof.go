@@ -0,0 +1,211 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package proof
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/fingerprint"
+	"github.com/square/go-jose/v3"
+	"github.com/square/go-jose/v3/jwt"
+)
+
+const (
+	// TypeJWT is the JWT proof type of the OpenID4VCI credential request.
+	TypeJWT = "jwt"
+	// JWTType is the required "typ" header of the JWT proof.
+	JWTType = "openid4vci-proof+jwt"
+
+	defaultMaxAge = 5 * time.Minute
+	clockSkew     = time.Minute
+)
+
+// ErrInvalidProof is returned when the proof of possession fails validation.
+var ErrInvalidProof = errors.New("invalid proof")
+
+// Proof is the proof of possession of the key material the issued credential is bound to.
+type Proof struct {
+	ProofType string `json:"proof_type"`
+	JWT       string `json:"jwt"`
+}
+
+// KeyResolver resolves the public key referenced by the "kid" header of the proof.
+type KeyResolver func(kid string) (interface{}, error)
+
+// Verifier verifies OpenID4VCI proofs of possession.
+type Verifier struct {
+	keyResolver KeyResolver
+	maxAge      time.Duration
+	now         func() time.Time
+}
+
+// Option configures the verifier.
+type Option func(opts *Verifier)
+
+// WithKeyResolver option is for resolving "kid" headers other than did:key.
+func WithKeyResolver(resolver KeyResolver) Option {
+	return func(opts *Verifier) {
+		opts.keyResolver = resolver
+	}
+}
+
+// WithMaxAge option is for the maximum age of the "iat" claim of the proof.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(opts *Verifier) {
+		opts.maxAge = maxAge
+	}
+}
+
+// NewVerifier returns new proof verifier.
+func NewVerifier(opts ...Option) *Verifier {
+	v := &Verifier{
+		keyResolver: ResolveDIDKey,
+		maxAge:      defaultMaxAge,
+		now:         time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify checks the signature, audience, nonce and issuance time of the proof and returns the DID of the holder.
+// Proofs signed with an embedded "jwk" header are bound to the corresponding did:jwk.
+func (v *Verifier) Verify(p *Proof, audience, nonce string) (string, error) {
+	if p == nil || p.ProofType != TypeJWT || p.JWT == "" {
+		return "", fmt.Errorf("%w : missing jwt proof", ErrInvalidProof)
+	}
+
+	token, err := jwt.ParseSigned(p.JWT)
+	if err != nil {
+		return "", fmt.Errorf("%w : parse jwt : %s", ErrInvalidProof, err)
+	}
+
+	if len(token.Headers) != 1 {
+		return "", fmt.Errorf("%w : single signature expected", ErrInvalidProof)
+	}
+
+	header := token.Headers[0]
+
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != JWTType {
+		return "", fmt.Errorf("%w : unexpected typ header %q", ErrInvalidProof, typ)
+	}
+
+	holder, key, err := v.holderKey(&header)
+	if err != nil {
+		return "", err
+	}
+
+	claims := &struct {
+		jwt.Claims
+		Nonce string `json:"nonce"`
+	}{}
+
+	err = token.Claims(key, claims)
+	if err != nil {
+		return "", fmt.Errorf("%w : verify signature : %s", ErrInvalidProof, err)
+	}
+
+	if !claims.Audience.Contains(audience) {
+		return "", fmt.Errorf("%w : unexpected audience", ErrInvalidProof)
+	}
+
+	if nonce == "" || claims.Nonce != nonce {
+		return "", fmt.Errorf("%w : unexpected nonce", ErrInvalidProof)
+	}
+
+	if claims.IssuedAt == nil {
+		return "", fmt.Errorf("%w : missing iat", ErrInvalidProof)
+	}
+
+	now := v.now()
+	iat := claims.IssuedAt.Time()
+
+	if iat.After(now.Add(clockSkew)) || iat.Before(now.Add(-v.maxAge)) {
+		return "", fmt.Errorf("%w : iat out of range", ErrInvalidProof)
+	}
+
+	return holder, nil
+}
+
+func (v *Verifier) holderKey(header *jose.Header) (string, interface{}, error) {
+	if header.JSONWebKey != nil && header.KeyID != "" {
+		return "", nil, fmt.Errorf("%w : kid and jwk headers are mutually exclusive", ErrInvalidProof)
+	}
+
+	if header.JSONWebKey != nil {
+		jwkBytes, err := header.JSONWebKey.MarshalJSON()
+		if err != nil {
+			return "", nil, fmt.Errorf("%w : marshal jwk : %s", ErrInvalidProof, err)
+		}
+
+		return "did:jwk:" + base64.RawURLEncoding.EncodeToString(jwkBytes), header.JSONWebKey.Key, nil
+	}
+
+	if header.KeyID == "" {
+		return "", nil, fmt.Errorf("%w : missing kid or jwk header", ErrInvalidProof)
+	}
+
+	key, err := v.keyResolver(header.KeyID)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w : resolve key %s : %s", ErrInvalidProof, header.KeyID, err)
+	}
+
+	return strings.Split(header.KeyID, "#")[0], key, nil
+}
+
+// ResolveDIDKey resolves the public key of a did:key verification method.
+func ResolveDIDKey(kid string) (interface{}, error) {
+	did := strings.Split(kid, "#")[0]
+
+	if !strings.HasPrefix(did, "did:key:") {
+		return nil, fmt.Errorf("unsupported did method : %s", did)
+	}
+
+	pubKey, code, err := fingerprint.PubKeyFromFingerprint(strings.TrimPrefix(did, "did:key:"))
+	if err != nil {
+		return nil, fmt.Errorf("parse did:key : %w", err)
+	}
+
+	switch code {
+	case fingerprint.ED25519PubKeyMultiCodec:
+		if len(pubKey) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 public key")
+		}
+
+		return ed25519.PublicKey(pubKey), nil
+	case fingerprint.P256PubKeyMultiCodec:
+		return ecdsaPublicKey(elliptic.P256(), pubKey)
+	case fingerprint.P384PubKeyMultiCodec:
+		return ecdsaPublicKey(elliptic.P384(), pubKey)
+	default:
+		return nil, fmt.Errorf("unsupported key multicodec code [0x%x]", code)
+	}
+}
+
+func ecdsaPublicKey(curve elliptic.Curve, pubKey []byte) (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey)
+	if x == nil {
+		// did:key values created from JWKs hold the uncompressed point without the prefix.
+		x, y = elliptic.Unmarshal(curve, append([]byte{4}, pubKey...)) //nolint: gomnd
+	}
+
+	if x == nil {
+		return nil, errors.New("invalid ecdsa public key")
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
@@ -0,0 +1,240 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package proof
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/kms"
+)
+
+const (
+	audience = "https://issuer.example.com/123"
+	nonce    = "nonce-123"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	for _, keyType := range []kms.KeyType{kms.Ed25519, kms.P256, kms.P384} {
+		keyType := keyType
+
+		t.Run("success - kid "+string(keyType), func(t *testing.T) {
+			key := createKey(t, keyType)
+
+			holder, err := NewVerifier().Verify(createProof(t, key, map[string]interface{}{"kid": key.ID},
+				validClaims()), audience, nonce)
+			require.NoError(t, err)
+			require.Equal(t, key.DID, holder)
+		})
+	}
+
+	t.Run("success - jwk", func(t *testing.T) {
+		key := createKey(t, kms.P256)
+
+		holder, err := NewVerifier().Verify(createProof(t, key, map[string]interface{}{"jwk": key.PublicJWK()},
+			validClaims()), audience, nonce)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(holder, "did:jwk:"))
+	})
+
+	t.Run("success - custom key resolver", func(t *testing.T) {
+		key := createKey(t, kms.Ed25519)
+
+		v := NewVerifier(WithKeyResolver(func(kid string) (interface{}, error) {
+			require.Equal(t, "did:example:123#key1", kid)
+
+			return key.JWK.Public().Key, nil
+		}))
+
+		holder, err := v.Verify(createProof(t, key, map[string]interface{}{"kid": "did:example:123#key1"},
+			validClaims()), audience, nonce)
+		require.NoError(t, err)
+		require.Equal(t, "did:example:123", holder)
+	})
+
+	t.Run("error - missing proof", func(t *testing.T) {
+		_, err := NewVerifier().Verify(nil, audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+
+		_, err = NewVerifier().Verify(&Proof{ProofType: "cwt", JWT: "abc"}, audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "missing jwt proof")
+	})
+
+	t.Run("error - malformed jwt", func(t *testing.T) {
+		_, err := NewVerifier().Verify(&Proof{ProofType: TypeJWT, JWT: "abc"}, audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "parse jwt")
+	})
+
+	t.Run("error - invalid typ", func(t *testing.T) {
+		key := createKey(t, kms.Ed25519)
+
+		_, err := NewVerifier().Verify(createProof(t, key, map[string]interface{}{"kid": key.ID, "typ": "JWT"},
+			validClaims()), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "unexpected typ header")
+	})
+
+	t.Run("error - missing key reference", func(t *testing.T) {
+		key := createKey(t, kms.Ed25519)
+
+		_, err := NewVerifier().Verify(createProof(t, key, map[string]interface{}{}, validClaims()), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "missing kid or jwk header")
+
+		_, err = NewVerifier().Verify(createProof(t, key,
+			map[string]interface{}{"kid": key.ID, "jwk": key.PublicJWK()}, validClaims()), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "mutually exclusive")
+	})
+
+	t.Run("error - private jwk", func(t *testing.T) {
+		key := createKey(t, kms.P256)
+
+		_, err := NewVerifier().Verify(createProof(t, key, map[string]interface{}{"jwk": key.JWK}, validClaims()),
+			audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "parse jwt")
+	})
+
+	t.Run("error - resolve key", func(t *testing.T) {
+		key := createKey(t, kms.Ed25519)
+
+		v := NewVerifier(WithKeyResolver(func(string) (interface{}, error) {
+			return nil, errors.New("resolve error")
+		}))
+
+		_, err := v.Verify(createProof(t, key, map[string]interface{}{"kid": key.ID}, validClaims()), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "resolve error")
+	})
+
+	t.Run("error - signature mismatch", func(t *testing.T) {
+		key := createKey(t, kms.Ed25519)
+		other := createKey(t, kms.Ed25519)
+
+		_, err := NewVerifier().Verify(createProof(t, key, map[string]interface{}{"kid": other.ID}, validClaims()),
+			audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "verify signature")
+	})
+
+	t.Run("error - invalid claims", func(t *testing.T) {
+		key := createKey(t, kms.Ed25519)
+		header := map[string]interface{}{"kid": key.ID}
+
+		claims := validClaims()
+		claims["aud"] = "https://other.example.com"
+
+		_, err := NewVerifier().Verify(createProof(t, key, header, claims), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "unexpected audience")
+
+		claims = validClaims()
+		claims["nonce"] = "other"
+
+		_, err = NewVerifier().Verify(createProof(t, key, header, claims), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "unexpected nonce")
+
+		claims = validClaims()
+		delete(claims, "iat")
+
+		_, err = NewVerifier().Verify(createProof(t, key, header, claims), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "missing iat")
+
+		claims = validClaims()
+		claims["iat"] = time.Now().Add(-time.Hour).Unix()
+
+		_, err = NewVerifier().Verify(createProof(t, key, header, claims), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "iat out of range")
+
+		_, err = NewVerifier(WithMaxAge(2*time.Hour)).Verify(createProof(t, key, header, claims), audience, nonce)
+		require.NoError(t, err)
+
+		claims["iat"] = time.Now().Add(time.Hour).Unix()
+
+		_, err = NewVerifier().Verify(createProof(t, key, header, claims), audience, nonce)
+		require.ErrorIs(t, err, ErrInvalidProof)
+		require.Contains(t, err.Error(), "iat out of range")
+	})
+}
+
+func TestResolveDIDKey(t *testing.T) {
+	t.Run("unsupported did method", func(t *testing.T) {
+		_, err := ResolveDIDKey("did:example:123#key1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported did method")
+	})
+
+	t.Run("invalid did:key", func(t *testing.T) {
+		_, err := ResolveDIDKey("did:key:abc")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse did:key")
+	})
+
+	t.Run("unsupported multicodec", func(t *testing.T) {
+		// X25519 key agreement key
+		_, err := ResolveDIDKey("did:key:z6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported key multicodec code")
+	})
+}
+
+func createKey(t *testing.T, keyType kms.KeyType) *kms.Key {
+	t.Helper()
+
+	k, err := kms.New(mem.NewProvider())
+	require.NoError(t, err)
+
+	key, err := k.Create("holder", keyType)
+	require.NoError(t, err)
+
+	return key
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"aud":   audience,
+		"iat":   time.Now().Unix(),
+		"nonce": nonce,
+	}
+}
+
+func createProof(t *testing.T, key *kms.Key, header, claims map[string]interface{}) *Proof {
+	t.Helper()
+
+	h := map[string]interface{}{"alg": key.Alg(), "typ": JWTType}
+
+	for k, v := range header {
+		h[k] = v
+	}
+
+	headerBytes, err := json.Marshal(h)
+	require.NoError(t, err)
+
+	claimsBytes, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsBytes)
+
+	sig, err := key.Signer().Sign([]byte(signingInput))
+	require.NoError(t, err)
+
+	return &Proof{ProofType: TypeJWT, JWT: signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)}
+}
@@ -0,0 +1,349 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the HMAC-SHA256 signature of the webhook request, "sha256=" followed by the hex encoded
+	// HMAC of the timestamp header value, a dot and the request body.
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader holds the time the webhook request was signed at in unix seconds.
+	TimestampHeader = "X-Webhook-Timestamp"
+
+	signaturePrefix = "sha256="
+
+	defaultMaxClockSkew = 5 * time.Minute
+)
+
+// Reasons of the rejected webhook requests.
+const (
+	RejectMissingSignature  = "missing signature"
+	RejectInvalidTimestamp  = "invalid timestamp"
+	RejectExpiredTimestamp  = "expired timestamp"
+	RejectInvalidSignature  = "invalid signature"
+	RejectReplayed          = "replayed request"
+	RejectClientCertificate = "invalid client certificate"
+	RejectNoSecrets         = "no secrets"
+)
+
+// AuthConfig configures the authentication of the webhook requests. Requests aren't authenticated if neither the
+// secrets nor the client CAs are set.
+type AuthConfig struct {
+	// Secrets are the shared secrets the requests are signed with. Any of them is accepted, so that a secret is
+	// rotated by adding the new secret, switching the sender to it and removing the old one.
+	Secrets []string
+	// SecretsFile holds the secrets one per line in addition to Secrets. It's reloaded when modified, so that
+	// the secrets are rotated without restart.
+	SecretsFile string
+	// ClientCAs verify the TLS client certificates of the requests, client certificates aren't required if not set.
+	ClientCAs *x509.CertPool
+	// ClientNames restricts the common names or DNS names of the client certificates, any is allowed if not set.
+	ClientNames []string
+	// MaxClockSkew is the maximum age of the request timestamps, 5 minutes by default.
+	MaxClockSkew time.Duration
+}
+
+// RejectStats are the counts of the rejected webhook requests.
+type RejectStats struct {
+	Total   int            `json:"total"`
+	Reasons map[string]int `json:"reasons"`
+}
+
+// rejectError is the error of the rejected webhook request.
+type rejectError struct {
+	reason string
+	err    error
+}
+
+func (e *rejectError) Error() string {
+	if e.err == nil {
+		return e.reason
+	}
+
+	return fmt.Sprintf("%s : %s", e.reason, e.err)
+}
+
+func reject(reason string, err error) error {
+	return &rejectError{reason: reason, err: err}
+}
+
+// authenticator authenticates the webhook requests.
+type authenticator struct {
+	secrets      []string
+	secretsFile  string
+	clientCAs    *x509.CertPool
+	clientNames  map[string]struct{}
+	maxClockSkew time.Duration
+	now          func() time.Time
+
+	mutex        sync.Mutex
+	fileSecrets  []string
+	fileModTime  time.Time
+	seen         map[string]time.Time
+	rejectCounts map[string]int
+}
+
+func newAuthenticator(config *AuthConfig) *authenticator {
+	a := &authenticator{
+		secrets:      config.Secrets,
+		secretsFile:  config.SecretsFile,
+		clientCAs:    config.ClientCAs,
+		maxClockSkew: config.MaxClockSkew,
+		now:          time.Now,
+		seen:         map[string]time.Time{},
+		rejectCounts: map[string]int{},
+	}
+
+	if a.maxClockSkew <= 0 {
+		a.maxClockSkew = defaultMaxClockSkew
+	}
+
+	if len(config.ClientNames) > 0 {
+		a.clientNames = map[string]struct{}{}
+
+		for _, name := range config.ClientNames {
+			a.clientNames[name] = struct{}{}
+		}
+	}
+
+	return a
+}
+
+func (a *authenticator) signed() bool {
+	return len(a.secrets) > 0 || a.secretsFile != ""
+}
+
+// authenticate checks the client certificate and the signature of the request, and counts the rejected requests.
+func (a *authenticator) authenticate(r *http.Request, body []byte) error {
+	err := a.verify(r, body)
+	if err == nil {
+		return nil
+	}
+
+	reason := RejectInvalidSignature
+
+	var rejectErr *rejectError
+	if errors.As(err, &rejectErr) {
+		reason = rejectErr.reason
+	}
+
+	a.mutex.Lock()
+	a.rejectCounts[reason]++
+	a.mutex.Unlock()
+
+	return err
+}
+
+func (a *authenticator) verify(r *http.Request, body []byte) error {
+	if a.clientCAs != nil {
+		if err := a.verifyClientCertificate(r); err != nil {
+			return reject(RejectClientCertificate, err)
+		}
+	}
+
+	if !a.signed() {
+		return nil
+	}
+
+	signature := r.Header.Get(SignatureHeader)
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return reject(RejectMissingSignature, nil)
+	}
+
+	mac, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return reject(RejectInvalidSignature, err)
+	}
+
+	timestamp := r.Header.Get(TimestampHeader)
+
+	err = a.verifyTimestamp(timestamp)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := a.currentSecrets()
+	if err != nil {
+		return reject(RejectNoSecrets, err)
+	}
+
+	for _, secret := range secrets {
+		if hmac.Equal(mac, Sign([]byte(secret), timestamp, body)) {
+			return a.checkReplay(hex.EncodeToString(mac))
+		}
+	}
+
+	return reject(RejectInvalidSignature, nil)
+}
+
+func (a *authenticator) verifyTimestamp(timestamp string) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return reject(RejectInvalidTimestamp, err)
+	}
+
+	skew := a.now().Sub(time.Unix(unix, 0))
+	if skew > a.maxClockSkew || skew < -a.maxClockSkew {
+		return reject(RejectExpiredTimestamp, nil)
+	}
+
+	return nil
+}
+
+// checkReplay rejects the signatures already seen within the clock skew window, the older ones are rejected by
+// the timestamp check.
+func (a *authenticator) checkReplay(signature string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	now := a.now()
+
+	for s, seenAt := range a.seen {
+		if now.Sub(seenAt) > 2*a.maxClockSkew {
+			delete(a.seen, s)
+		}
+	}
+
+	if _, ok := a.seen[signature]; ok {
+		return reject(RejectReplayed, nil)
+	}
+
+	a.seen[signature] = now
+
+	return nil
+}
+
+func (a *authenticator) verifyClientCertificate(r *http.Request) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return errors.New("missing client certificate")
+	}
+
+	cert := r.TLS.PeerCertificates[0]
+
+	intermediates := x509.NewCertPool()
+	for _, c := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         a.clientCAs,
+		Intermediates: intermediates,
+		CurrentTime:   a.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return err
+	}
+
+	if a.clientNames == nil {
+		return nil
+	}
+
+	for _, name := range append([]string{cert.Subject.CommonName}, cert.DNSNames...) {
+		if _, ok := a.clientNames[name]; ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("client %s is not allowed", cert.Subject.CommonName)
+}
+
+// currentSecrets returns the configured secrets and the secrets of the file, which is reloaded if modified.
+func (a *authenticator) currentSecrets() ([]string, error) {
+	if a.secretsFile == "" {
+		return a.secrets, nil
+	}
+
+	info, err := os.Stat(a.secretsFile)
+	if err != nil {
+		return nil, fmt.Errorf("stat secrets file : %w", err)
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !info.ModTime().Equal(a.fileModTime) {
+		a.fileSecrets, err = readSecrets(a.secretsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		a.fileModTime = info.ModTime()
+
+		logger.Infof("loaded %d webhook secrets from %s", len(a.fileSecrets), a.secretsFile)
+	}
+
+	secrets := append(append([]string{}, a.secrets...), a.fileSecrets...)
+	if len(secrets) == 0 {
+		return nil, errors.New("no webhook secrets configured")
+	}
+
+	return secrets, nil
+}
+
+func (a *authenticator) stats() *RejectStats {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	stats := &RejectStats{Reasons: map[string]int{}}
+
+	for reason, n := range a.rejectCounts {
+		stats.Reasons[reason] = n
+		stats.Total += n
+	}
+
+	return stats
+}
+
+// readSecrets reads the secrets of the file, one per line. Empty lines and lines starting with # are skipped.
+func readSecrets(path string) ([]string, error) {
+	data, err := os.ReadFile(path) // nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("read secrets file : %w", err)
+	}
+
+	var secrets []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		secrets = append(secrets, line)
+	}
+
+	return secrets, scanner.Err()
+}
+
+// Sign returns the HMAC-SHA256 of the timestamp and the body of the webhook request with the secret.
+func Sign(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+
+	// hash.Hash never returns an error
+	mac.Write([]byte(timestamp + ".")) // nolint:errcheck
+	mac.Write(body)                    // nolint:errcheck
+
+	return mac.Sum(nil)
+}
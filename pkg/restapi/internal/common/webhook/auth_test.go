@@ -0,0 +1,266 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const secret = "secret"
+
+func TestHandler_ReceiveWithAuth(t *testing.T) {
+	t.Run("valid signature", func(t *testing.T) {
+		h := newAuthHandler(t, &AuthConfig{Secrets: []string{secret}})
+
+		rr := receiveSigned(h, secret, time.Now(), testEvent)
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("any of the secrets is accepted", func(t *testing.T) {
+		h := newAuthHandler(t, &AuthConfig{Secrets: []string{"old", "new"}})
+
+		require.Equal(t, http.StatusOK, receiveSigned(h, "old", time.Now(), testEvent).Code)
+		require.Equal(t, http.StatusOK,
+			receiveSigned(h, "new", time.Now(), `{"txnid":"tx1","type":"oidc_interaction_succeeded"}`).Code)
+	})
+
+	t.Run("rejected requests", func(t *testing.T) {
+		h := newAuthHandler(t, &AuthConfig{Secrets: []string{secret}})
+
+		rr := receive(h, testEvent)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+		require.Contains(t, rr.Body.String(), "webhook request rejected")
+
+		require.Equal(t, http.StatusUnauthorized, receiveSigned(h, "other", time.Now(), testEvent).Code)
+		require.Equal(t, http.StatusUnauthorized,
+			receiveSigned(h, secret, time.Now().Add(-time.Hour), testEvent).Code)
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(testEvent))
+		req.Header.Set(SignatureHeader, "sha256=invalid")
+		req.Header.Set(TimestampHeader, strconv.FormatInt(time.Now().Unix(), 10))
+
+		rr = httptest.NewRecorder()
+		h.Receive(rr, req)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		req = httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(testEvent))
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(Sign([]byte(secret), "invalid", []byte(testEvent))))
+		req.Header.Set(TimestampHeader, "invalid")
+
+		rr = httptest.NewRecorder()
+		h.Receive(rr, req)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		stats := rejected(t, h)
+		require.Equal(t, 5, stats.Total)
+		require.Equal(t, map[string]int{
+			RejectMissingSignature: 1,
+			RejectInvalidSignature: 2,
+			RejectExpiredTimestamp: 1,
+			RejectInvalidTimestamp: 1,
+		}, stats.Reasons)
+	})
+
+	t.Run("replayed request", func(t *testing.T) {
+		h := newAuthHandler(t, &AuthConfig{Secrets: []string{secret}})
+
+		now := time.Now()
+
+		require.Equal(t, http.StatusOK, receiveSigned(h, secret, now, testEvent).Code)
+		require.Equal(t, http.StatusUnauthorized, receiveSigned(h, secret, now, testEvent).Code)
+
+		require.Equal(t, map[string]int{RejectReplayed: 1}, rejected(t, h).Reasons)
+	})
+
+	t.Run("secrets file is reloaded", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "secrets")
+		require.NoError(t, os.WriteFile(file, []byte("# webhook secrets\n\nold\n"), 0o600))
+
+		h := newAuthHandler(t, &AuthConfig{SecretsFile: file})
+
+		require.Equal(t, http.StatusOK, receiveSigned(h, "old", time.Now(), testEvent).Code)
+		require.Equal(t, http.StatusUnauthorized, receiveSigned(h, "new", time.Now(), testEvent).Code)
+
+		require.NoError(t, os.WriteFile(file, []byte("new\n"), 0o600))
+		require.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+
+		require.Equal(t, http.StatusOK, receiveSigned(h, "new", time.Now(), testEvent).Code)
+		require.Equal(t, http.StatusUnauthorized, receiveSigned(h, "old", time.Now().Add(time.Second), testEvent).Code)
+	})
+
+	t.Run("secrets file errors", func(t *testing.T) {
+		h := newAuthHandler(t, &AuthConfig{SecretsFile: filepath.Join(t.TempDir(), "missing")})
+
+		require.Equal(t, http.StatusUnauthorized, receiveSigned(h, secret, time.Now(), testEvent).Code)
+
+		file := filepath.Join(t.TempDir(), "secrets")
+		require.NoError(t, os.WriteFile(file, []byte("# no secrets\n"), 0o600))
+
+		h = newAuthHandler(t, &AuthConfig{SecretsFile: file})
+
+		require.Equal(t, http.StatusUnauthorized, receiveSigned(h, secret, time.Now(), testEvent).Code)
+		require.Equal(t, map[string]int{RejectNoSecrets: 1}, rejected(t, h).Reasons)
+	})
+
+	t.Run("client certificate", func(t *testing.T) {
+		caCert, caKey := createCA(t)
+		clientCert := createClientCert(t, caCert, caKey, "vcs")
+
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(caCert)
+
+		h := newAuthHandler(t, &AuthConfig{ClientCAs: clientCAs, ClientNames: []string{"vcs"}})
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(testEvent))
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}}
+
+		rr := httptest.NewRecorder()
+		h.Receive(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		// client name not allowed
+		req = httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(testEvent))
+		req.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{createClientCert(t, caCert, caKey, "other")},
+		}
+
+		rr = httptest.NewRecorder()
+		h.Receive(rr, req)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		// not issued by the client CAs
+		otherCA, otherKey := createCA(t)
+
+		req = httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(testEvent))
+		req.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{createClientCert(t, otherCA, otherKey, "vcs")},
+		}
+
+		rr = httptest.NewRecorder()
+		h.Receive(rr, req)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		// missing client certificate
+		require.Equal(t, http.StatusUnauthorized, receive(h, testEvent).Code)
+
+		require.Equal(t, map[string]int{RejectClientCertificate: 3}, rejected(t, h).Reasons)
+	})
+}
+
+func TestHandler_Rejected(t *testing.T) {
+	h, _ := newHandler(t)
+	WithAuth(&AuthConfig{})(h)
+
+	require.Nil(t, h.auth)
+	require.Equal(t, http.StatusOK, receive(h, testEvent).Code)
+
+	stats := rejected(t, h)
+	require.Zero(t, stats.Total)
+	require.Empty(t, stats.Reasons)
+}
+
+func newAuthHandler(t *testing.T, config *AuthConfig) *Handler {
+	t.Helper()
+
+	h, _ := newHandler(t)
+	WithAuth(config)(h)
+
+	return h
+}
+
+func receiveSigned(h *Handler, key string, signedAt time.Time, body string) *httptest.ResponseRecorder {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body))
+	req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(Sign([]byte(key), timestamp, []byte(body))))
+	req.Header.Set(TimestampHeader, timestamp)
+
+	rr := httptest.NewRecorder()
+	h.Receive(rr, req)
+
+	return rr
+}
+
+func rejected(t *testing.T, h *Handler) *RejectStats {
+	t.Helper()
+
+	rr := httptest.NewRecorder()
+	h.Rejected(rr, httptest.NewRequest(http.MethodGet, "/rejected", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	stats := &RejectStats{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), stats))
+
+	return stats
+}
+
+func createCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "webhook CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
+func createClientCert(t *testing.T, ca *x509.Certificate, caKey *ecdsa.PrivateKey, name string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
@@ -0,0 +1,310 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/trustbloc/edge-core/pkg/log"
+
+	"github.com/trustbloc/sandbox/pkg/eventstore"
+)
+
+const (
+	txQueryParam          = "tx"
+	lastEventIDQueryParam = "lastEventID"
+	lastEventIDHeader     = "Last-Event-ID"
+
+	defaultCheckTimeout      = time.Second
+	defaultKeepAliveInterval = 15 * time.Second
+)
+
+var logger = log.New("sandbox-webhook")
+
+type event struct {
+	// TransactionID defines transaction ID(optional).
+	TransactionID string `json:"txnid,omitempty"`
+}
+
+// Handler receives the webhook events of the VCS and serves them to the UI.
+type Handler struct {
+	events            *eventstore.Store
+	auth              *authenticator
+	checkTimeout      time.Duration
+	keepAliveInterval time.Duration
+}
+
+// Option configures the handler.
+type Option func(h *Handler)
+
+// WithCheckTimeout sets the time Check waits for the next event, 1 second by default.
+func WithCheckTimeout(timeout time.Duration) Option {
+	return func(h *Handler) {
+		h.checkTimeout = timeout
+	}
+}
+
+// WithKeepAliveInterval sets the interval of the keep-alive comments of the idle event streams, 15 seconds
+// by default.
+func WithKeepAliveInterval(interval time.Duration) Option {
+	return func(h *Handler) {
+		h.keepAliveInterval = interval
+	}
+}
+
+// WithAuth authenticates the received webhook requests, the rejected requests are counted and logged. The requests
+// aren't authenticated if neither the secrets nor the client CAs are configured.
+func WithAuth(config *AuthConfig) Option {
+	return func(h *Handler) {
+		if len(config.Secrets) == 0 && config.SecretsFile == "" && config.ClientCAs == nil {
+			return
+		}
+
+		h.auth = newAuthenticator(config)
+	}
+}
+
+// New returns new handler keeping the events in the event store.
+func New(events *eventstore.Store, opts ...Option) *Handler {
+	h := &Handler{
+		events:            events,
+		checkTimeout:      defaultCheckTimeout,
+		keepAliveInterval: defaultKeepAliveInterval,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Receive stores the webhook event posted by the VCS under its transaction.
+func (h *Handler) Receive(w http.ResponseWriter, r *http.Request) {
+	msg, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to read event, cause: %s", err))
+
+		return
+	}
+
+	if h.auth != nil {
+		if err = h.auth.authenticate(r, msg); err != nil {
+			logger.Warnf("rejected webhook request from %s : %s", r.RemoteAddr, err)
+
+			writeError(w, http.StatusUnauthorized, "webhook request rejected")
+
+			return
+		}
+	}
+
+	logger.Infof("received topic message: %s", string(msg))
+
+	d := &event{}
+	if err = json.Unmarshal(msg, d); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed unmarshal event, cause: %s", err))
+
+		return
+	}
+
+	if d.TransactionID == "" {
+		writeError(w, http.StatusBadRequest, "missing transaction ID of the event")
+
+		return
+	}
+
+	_, err = h.events.Add(d.TransactionID, msg)
+	if err != nil {
+		logger.Errorf("failed to store event of transaction %s : %s", d.TransactionID, err)
+
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to store event, cause: %s", err))
+	}
+}
+
+// Rejected writes the counts of the rejected webhook requests by reason.
+func (h *Handler) Rejected(w http.ResponseWriter, _ *http.Request) {
+	stats := &RejectStats{Reasons: map[string]int{}}
+	if h.auth != nil {
+		stats = h.auth.stats()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		logger.Errorf("failed to write rejected requests : %s", err)
+	}
+}
+
+// Check writes the payload of the next event of the transaction, waiting for it until the check timeout.
+func (h *Handler) Check(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.checkTimeout)
+	defer cancel()
+
+	e, err := h.events.Next(ctx, r.URL.Query().Get(txQueryParam))
+	if errors.Is(err, eventstore.ErrNoEvent) {
+		writeError(w, http.StatusOK, "no topic found in queue")
+
+		return
+	}
+
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to pull topics, cause: %s", err))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if _, err = w.Write(e.Payload); err != nil {
+		logger.Errorf("failed to write event : %s", err)
+	}
+}
+
+// Events writes all the events of the transaction.
+func (h *Handler) Events(w http.ResponseWriter, r *http.Request) {
+	txID := r.URL.Query().Get(txQueryParam)
+	if txID == "" {
+		writeError(w, http.StatusBadRequest, "missing tx query parameter")
+
+		return
+	}
+
+	events, err := h.events.Events(txID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to get events, cause: %s", err))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err = json.NewEncoder(w).Encode(events); err != nil {
+		logger.Errorf("failed to write events : %s", err)
+	}
+}
+
+// Stream streams the events of the transaction as server-sent events until the client disconnects. The events are
+// sent with their IDs, so that the client resumes after the last received event on reconnect with the Last-Event-ID
+// header, or the lastEventID query parameter.
+func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
+	txID := r.URL.Query().Get(txQueryParam)
+	if txID == "" {
+		writeError(w, http.StatusBadRequest, "missing tx query parameter")
+
+		return
+	}
+
+	lastID, err := lastEventID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming is not supported")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// disables the response buffering of the nginx proxies
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for r.Context().Err() == nil {
+		lastID, err = h.writeNext(r.Context(), w, txID, lastID)
+		if err != nil {
+			logger.Warnf("failed to stream events of transaction %s : %s", txID, err)
+
+			return
+		}
+
+		flusher.Flush()
+	}
+}
+
+// writeNext writes the events received after the last event, or the keep-alive comment if there are none within
+// the keep-alive interval, and returns the ID of the last written event.
+func (h *Handler) writeNext(ctx context.Context, w io.Writer, txID string, lastID int) (int, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, h.keepAliveInterval)
+	defer cancel()
+
+	events, err := h.events.EventsAfter(waitCtx, txID, lastID)
+	if errors.Is(err, eventstore.ErrNoEvent) {
+		_, err = fmt.Fprint(w, ": keep-alive\n\n")
+
+		return lastID, err
+	}
+
+	if err != nil {
+		return lastID, err
+	}
+
+	return writeEvents(w, events)
+}
+
+func lastEventID(r *http.Request) (int, error) {
+	id := r.Header.Get(lastEventIDHeader)
+	if id == "" {
+		id = r.URL.Query().Get(lastEventIDQueryParam)
+	}
+
+	if id == "" {
+		return 0, nil
+	}
+
+	lastID, err := strconv.Atoi(id)
+	if err != nil || lastID < 0 {
+		return 0, fmt.Errorf("invalid last event ID %s", id)
+	}
+
+	return lastID, nil
+}
+
+// writeEvents writes the events in the server-sent events format and returns the ID of the last written event.
+func writeEvents(w io.Writer, events []*eventstore.Event) (int, error) {
+	var lastID int
+
+	for _, e := range events {
+		// the payload is compacted, since the event data must not contain new lines
+		var data bytes.Buffer
+
+		if err := json.Compact(&data, e.Payload); err != nil {
+			return 0, fmt.Errorf("compact event %d : %w", e.ID, err)
+		}
+
+		if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", e.ID, data.Bytes()); err != nil {
+			return 0, err
+		}
+
+		lastID = e.ID
+	}
+
+	return lastID, nil
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": msg}); err != nil {
+		logger.Errorf("failed to write error : %s", err)
+	}
+}
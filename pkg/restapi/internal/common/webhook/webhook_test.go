@@ -0,0 +1,283 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	memstore "github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/eventstore"
+)
+
+const (
+	txID      = "tx1"
+	testEvent = `{"txnid":"tx1","type":"oidc_interaction_initiated"}`
+)
+
+func TestHandler_Receive(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		h, events := newHandler(t)
+
+		rr := receive(h, testEvent)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		stored, err := events.Events(txID)
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.JSONEq(t, testEvent, string(stored[0].Payload))
+	})
+
+	t.Run("invalid event", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		rr := receive(h, "invalid")
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed unmarshal event")
+	})
+
+	t.Run("missing transaction ID", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		rr := receive(h, `{"type":"oidc_interaction_initiated"}`)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "missing transaction ID")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		events, err := eventstore.New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrGet: storage.ErrDataNotFound,
+			ErrPut: errors.New("put error"),
+		}}, "events")
+		require.NoError(t, err)
+
+		rr := receive(New(events), testEvent)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "put error")
+	})
+}
+
+func TestHandler_Check(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		require.Equal(t, http.StatusOK, receive(h, testEvent).Code)
+
+		rr := check(h, txID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, testEvent, rr.Body.String())
+
+		rr = check(h, txID)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"error":"no topic found in queue"}`, rr.Body.String())
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		events, err := eventstore.New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrGet: errors.New("get error"),
+		}}, "events")
+		require.NoError(t, err)
+
+		rr := check(New(events), txID)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "get error")
+	})
+}
+
+func TestHandler_Events(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		require.Equal(t, http.StatusOK, receive(h, testEvent).Code)
+		require.Equal(t, http.StatusOK,
+			receive(h, `{"txnid":"tx1","type":"oidc_interaction_succeeded"}`).Code)
+
+		// events already checked are still returned
+		require.Equal(t, http.StatusOK, check(h, txID).Code)
+
+		rr := httptest.NewRecorder()
+		h.Events(rr, httptest.NewRequest(http.MethodGet, "/events?tx="+txID, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var events []*eventstore.Event
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &events))
+		require.Len(t, events, 2)
+		require.Equal(t, 1, events[0].ID)
+		require.Equal(t, 2, events[1].ID)
+	})
+
+	t.Run("missing tx", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		rr := httptest.NewRecorder()
+		h.Events(rr, httptest.NewRequest(http.MethodGet, "/events", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		events, err := eventstore.New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrGet: errors.New("get error"),
+		}}, "events")
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		New(events).Events(rr, httptest.NewRequest(http.MethodGet, "/events?tx="+txID, nil))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestHandler_Stream(t *testing.T) {
+	t.Run("streams events", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		require.Equal(t, http.StatusOK, receive(h, testEvent).Code)
+
+		srv := httptest.NewServer(http.HandlerFunc(h.Stream))
+		defer srv.Close()
+
+		resp, body := stream(t, srv.URL+"?tx="+txID, "")
+		defer closeBody(t, resp)
+
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		require.Equal(t, "id: 1", readLine(t, body))
+		require.Equal(t, "data: "+testEvent, readLine(t, body))
+		require.Equal(t, "", readLine(t, body))
+
+		require.Equal(t, http.StatusOK,
+			receive(h, `{"txnid":"tx1","type":"oidc_interaction_succeeded"}`).Code)
+
+		require.Equal(t, "id: 2", readLine(t, body))
+		require.Equal(t, `data: {"txnid":"tx1","type":"oidc_interaction_succeeded"}`, readLine(t, body))
+	})
+
+	t.Run("resumes after last event ID", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		require.Equal(t, http.StatusOK, receive(h, testEvent).Code)
+		require.Equal(t, http.StatusOK,
+			receive(h, `{"txnid":"tx1","type":"oidc_interaction_succeeded"}`).Code)
+
+		srv := httptest.NewServer(http.HandlerFunc(h.Stream))
+		defer srv.Close()
+
+		resp, body := stream(t, srv.URL+"?tx="+txID, "1")
+		defer closeBody(t, resp)
+
+		require.Equal(t, "id: 2", readLine(t, body))
+
+		resp2, body2 := stream(t, srv.URL+"?tx="+txID+"&lastEventID=1", "")
+		defer closeBody(t, resp2)
+
+		require.Equal(t, "id: 2", readLine(t, body2))
+	})
+
+	t.Run("keep-alive", func(t *testing.T) {
+		events, err := eventstore.New(memstore.NewProvider(), "events")
+		require.NoError(t, err)
+
+		srv := httptest.NewServer(http.HandlerFunc(New(events, WithKeepAliveInterval(time.Millisecond)).Stream))
+		defer srv.Close()
+
+		resp, body := stream(t, srv.URL+"?tx="+txID, "")
+		defer closeBody(t, resp)
+
+		require.Equal(t, ": keep-alive", readLine(t, body))
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		h, _ := newHandler(t)
+
+		rr := httptest.NewRecorder()
+		h.Stream(rr, httptest.NewRequest(http.MethodGet, "/stream", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "missing tx")
+
+		rr = httptest.NewRecorder()
+		h.Stream(rr, httptest.NewRequest(http.MethodGet, "/stream?tx=tx1&lastEventID=invalid", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "invalid last event ID")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		events, err := eventstore.New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrGet: errors.New("get error"),
+		}}, "events")
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		New(events).Stream(rr, httptest.NewRequest(http.MethodGet, "/stream?tx="+txID, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Empty(t, rr.Body.String())
+	})
+}
+
+func newHandler(t *testing.T) (*Handler, *eventstore.Store) {
+	t.Helper()
+
+	events, err := eventstore.New(memstore.NewProvider(), "events")
+	require.NoError(t, err)
+
+	return New(events, WithCheckTimeout(10*time.Millisecond)), events
+}
+
+func receive(h *Handler, body string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.Receive(rr, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewBufferString(body)))
+
+	return rr
+}
+
+func check(h *Handler, tx string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	h.Check(rr, httptest.NewRequest(http.MethodGet, "/check?tx="+tx, nil))
+
+	return rr
+}
+
+func stream(t *testing.T, url, lastEventID string) (*http.Response, *bufio.Reader) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	return resp, bufio.NewReader(resp.Body)
+}
+
+func readLine(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+
+	line, err := r.ReadString('\n')
+	require.NoError(t, err)
+
+	return strings.TrimSuffix(line, "\n")
+}
+
+func closeBody(t *testing.T, resp *http.Response) {
+	t.Helper()
+
+	require.NoError(t, resp.Body.Close())
+}
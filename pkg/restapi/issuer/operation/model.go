@@ -8,6 +8,11 @@ package operation
 
 import (
 	"encoding/json"
+	"time"
+
+	"github.com/trustbloc/sandbox/pkg/credtemplate"
+	"github.com/trustbloc/sandbox/pkg/proof"
+	"github.com/trustbloc/sandbox/pkg/subjectdata"
 )
 
 type verifyDIDAuthReq struct {
@@ -17,6 +22,11 @@ type verifyDIDAuthReq struct {
 	DIDAuthResp json.RawMessage `json:"didAuthResp"`
 }
 
+type didAuthChallengeResp struct {
+	Challenge string `json:"challenge"`
+	Domain    string `json:"domain"`
+}
+
 type createCredentialReq struct {
 	Holder            string                 `json:"holder"`
 	VCSProfile        string                 `json:"vcsProfile"`
@@ -24,6 +34,10 @@ type createCredentialReq struct {
 	Collection        string                 `json:"collection"`
 	UserID            string                 `json:"userID"`
 	CustomSubjectData map[string]interface{} `json:"customSubjectData"`
+	// Template of the credential, the template of the scope by default.
+	Template string `json:"template,omitempty"`
+	// SearchID is the search handle of the subject data, which is used instead of the user data of the collection.
+	SearchID string `json:"searchID,omitempty"`
 }
 
 type txnData struct {
@@ -37,6 +51,30 @@ type searchData struct {
 	UserData map[string]interface{} `json:"userData"`
 }
 
+type searchReq struct {
+	Scope string `json:"scope"`
+	// Collection of the subject data, the pluralized scope by default.
+	Collection string               `json:"collection,omitempty"`
+	Filters    []subjectdata.Filter `json:"filters,omitempty"`
+	Fields     []string             `json:"fields,omitempty"`
+	Offset     int                  `json:"offset,omitempty"`
+	Limit      int                  `json:"limit,omitempty"`
+}
+
+type searchResp struct {
+	Total   int            `json:"total"`
+	Offset  int            `json:"offset"`
+	Limit   int            `json:"limit"`
+	Results []searchResult `json:"results"`
+}
+
+type searchResult struct {
+	// ID is the search handle of the subject data.
+	ID     string                 `json:"id"`
+	UserID string                 `json:"userID,omitempty"`
+	Data   map[string]interface{} `json:"data"`
+}
+
 type generateCredentialReq struct {
 	ID         string `json:"id"`
 	Holder     string `json:"holder"`
@@ -50,14 +88,121 @@ type oidcIssuanceRequest struct {
 	IssuerURL             string          `json:"issuerURL"`
 	CredManifest          json.RawMessage `json:"credManifest"`
 	Credential            json.RawMessage `json:"credToIssue"`
+	KeyType               string          `json:"keyType,omitempty"`
+	GrantType             string          `json:"grantType,omitempty"`
+	UserPinRequired       bool            `json:"userPinRequired,omitempty"`
+	Deferred              bool            `json:"deferred,omitempty"`
+	// Profile the issued credentials are recorded for, issuer ID by default.
+	Profile string `json:"profile,omitempty"`
+	// Credentials to be issued keyed by credential type or manifest ID.
+	Credentials map[string]json.RawMessage `json:"credentialsToIssue,omitempty"`
+	// TemplateID is the template the credential is built with from ClaimData, unless credToIssue is given.
+	TemplateID string                 `json:"templateID,omitempty"`
+	ClaimData  map[string]interface{} `json:"claimData,omitempty"`
 }
 
 type issuerConfiguration struct {
-	Issuer                string          `json:"issuer"`
-	AuthorizationEndpoint string          `json:"authorization_endpoint"`
-	CredentialEndpoint    string          `json:"credential_endpoint"`
-	TokenEndpoint         string          `json:"token_endpoint"`
-	CredentialManifests   json.RawMessage `json:"credential_manifests"`
+	Issuer                            string                `json:"issuer"`
+	AuthorizationEndpoint             string                `json:"authorization_endpoint"`
+	CredentialEndpoint                string                `json:"credential_endpoint"`
+	BatchCredentialEndpoint           string                `json:"batch_credential_endpoint,omitempty"`
+	RegistrationEndpoint              string                `json:"registration_endpoint,omitempty"`
+	TokenEndpoint                     string                `json:"token_endpoint"`
+	JWKSURI                           string                `json:"jwks_uri"`
+	CredentialManifests               json.RawMessage       `json:"credential_manifests"`
+	GrantTypesSupported               []string              `json:"grant_types_supported,omitempty"`
+	TokenEndpointAuthMethodsSupported []string              `json:"token_endpoint_auth_methods_supported,omitempty"`
+	CodeChallengeMethodsSupported     []string              `json:"code_challenge_methods_supported,omitempty"`
+	DeferredCredentialEndpoint        string                `json:"deferred_credential_endpoint,omitempty"`
+	CredentialsSupported              []credentialSupported `json:"credentials_supported,omitempty"`
+}
+
+type credentialSupported struct {
+	Format                               string                 `json:"format"`
+	Types                                []string               `json:"types"`
+	CryptographicBindingMethodsSupported []string               `json:"cryptographic_binding_methods_supported,omitempty"`
+	CryptographicSuitesSupported         []string               `json:"cryptographic_suites_supported,omitempty"`
+	Display                              []credtemplate.Display `json:"display,omitempty"`
+}
+
+type credentialOffer struct {
+	CredentialIssuer string                 `json:"credential_issuer"`
+	Credentials      []string               `json:"credentials"`
+	Grants           map[string]interface{} `json:"grants"`
+}
+
+type preAuthorizedCodeGrant struct {
+	PreAuthorizedCode string `json:"pre-authorized_code"`
+	UserPinRequired   bool   `json:"user_pin_required"`
+}
+
+type preAuthorizedCode struct {
+	Code     string `json:"code"`
+	IssuerID string `json:"issuerID"`
+	UserPin  string `json:"userPin,omitempty"`
+}
+
+type credentialRequest struct {
+	Format string       `json:"format,omitempty"`
+	Type   string       `json:"type,omitempty"`
+	Types  []string     `json:"types,omitempty"`
+	Proof  *proof.Proof `json:"proof,omitempty"`
+}
+
+type batchCredentialRequest struct {
+	CredentialRequests []*credentialRequest `json:"credential_requests"`
+}
+
+type cNonce struct {
+	Nonce     string    `json:"nonce"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type deferredCredentialRequest struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+type deferredDecisionRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type credentialLifecycleRequest struct {
+	CredentialID string `json:"credentialID"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+type deferredCredential struct {
+	TransactionID string    `json:"transactionID"`
+	IssuerID      string    `json:"issuerID"`
+	Holder        string    `json:"holder"`
+	Format        string    `json:"format,omitempty"`
+	Types         []string  `json:"types,omitempty"`
+	Status        string    `json:"status"`
+	Reason        string    `json:"reason,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt,omitempty"`
+}
+
+// initiateIssuanceReq configures the OIDC4CI issuance initiated through the VCS.
+type initiateIssuanceReq struct {
+	// CredentialTemplateID is the VCS credential template.
+	CredentialTemplateID string `json:"credentialTemplateID,omitempty"`
+	// ClaimData are the inline claims of the pre-authorized issuance.
+	ClaimData map[string]interface{} `json:"claimData,omitempty"`
+	// Scope and UserID read the claims of the pre-authorized issuance from the subject data source of the scope.
+	Scope  string `json:"scope,omitempty"`
+	UserID string `json:"userID,omitempty"`
+	// UserPinRequired requires the PIN of the pre-authorized issuance, true by default.
+	UserPinRequired *bool `json:"userPinRequired,omitempty"`
+	// IssuerProfile is the VCS issuer profile, the demo issuer by default.
+	IssuerProfile string `json:"issuerProfile,omitempty"`
+	GrantType     string `json:"grantType,omitempty"`
+}
+
+type initiateIssuanceResp struct {
+	OfferCredentialURL string `json:"offerCredentialURL"`
+	TxID               string `json:"txID"`
+	Pin                string `json:"pin,omitempty"`
 }
 
 type initiateOIDC4CIResponse struct {
@@ -17,8 +17,11 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -26,12 +29,26 @@ import (
 	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/ld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/ldcontext"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	mockldstore "github.com/hyperledger/aries-framework-go/pkg/mock/ld"
 	ldstore "github.com/hyperledger/aries-framework-go/pkg/store/ld"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/square/go-jose/v3"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 
+	"github.com/trustbloc/sandbox/pkg/clientregistry"
+	"github.com/trustbloc/sandbox/pkg/credtemplate"
+	"github.com/trustbloc/sandbox/pkg/kms"
+	"github.com/trustbloc/sandbox/pkg/lifecycle"
+	"github.com/trustbloc/sandbox/pkg/sdjwt"
+	"github.com/trustbloc/sandbox/pkg/statuslist"
+	"github.com/trustbloc/sandbox/pkg/subjectdata"
 	"github.com/trustbloc/sandbox/pkg/token"
+	"github.com/trustbloc/sandbox/pkg/txnstore"
 )
 
 const (
@@ -217,53 +234,7 @@ const foo = `{
 }`
 const jsonArray = `[{}]`
 
-const (
-	holder    = "did:example.com"
-	domain    = "issuer.interop.transmute.world"
-	challenge = "3970cad8-14ff-4ac1-ada9-0995c862df2e"
-	authResp  = `{
-    "@context": "https://www.w3.org/2018/credentials/v1",
-    "type": "VerifiablePresentation",
-    "holder": "did:example.com",
-    "proof": {
-        "type": "Ed25519Signature2018",
-        "created": "2020-04-21T21:25:18Z",
-        "verificationMethod": "did:example.com#key-1",
-        "proofPurpose": "authentication",
-        "challenge": "3970cad8-14ff-4ac1-ada9-0995c862df2e",
-        "domain": "issuer.interop.transmute.world",
-        "jws": "6wDkNVRBs3zebe_PSIROTN3K8hBfE18ZI-Ieg_9KYI5-sDA"
-    }
-}`
-)
-
-const authRespWithoutChallenge = `{
-    "@context": "https://www.w3.org/2018/credentials/v1",
-    "type": "VerifiablePresentation",
-    "holder": "did:example.com",
-    "proof": {
-        "type": "Ed25519Signature2018",
-        "created": "2020-04-21T21:25:18Z",
-        "verificationMethod": "did:example.com#key-1",
-        "proofPurpose": "authentication",
-        "domain": "issuer.interop.transmute.world",
-        "jws": "6wDkNVRBs3zebe_PSIROTN3K8hBfE18ZI-Ieg_9KYI5-sDA"
-    }
-}`
-
-const authRespWithoutDomain = `{
-    "@context": "https://www.w3.org/2018/credentials/v1",
-    "type": "VerifiablePresentation",
-    "holder": "did:example.com",
-    "proof": {
-        "type": "Ed25519Signature2018",
-        "created": "2020-04-21T21:25:18Z",
-        "verificationMethod": "did:example.com#key-1",
-        "proofPurpose": "authentication",
-        "challenge": "3970cad8-14ff-4ac1-ada9-0995c862df2e",
-        "jws": "6wDkNVRBs3zebe_PSIROTN3K8hBfE18ZI-Ieg_9KYI5-sDA"
-    }
-}`
+const domain = "issuer.interop.transmute.world"
 
 const assuranceData = `{
 	  "data":{
@@ -323,11 +294,25 @@ var (
 	examplesV1Context []byte
 	//go:embed testdata/examples-ext-v1.jsonld
 	examplesExtV1Context []byte
+	//go:embed testdata/citizenship-v1.jsonld
+	citizenshipV1Context []byte
 )
 
 func TestController_New(t *testing.T) {
 	t.Run("test new - success", func(t *testing.T) {
-		op, err := New(&Config{StoreProvider: &mockstorage.Provider{}})
+		op, err := New(&Config{StoreProvider: memstore.NewProvider()})
+		require.NoError(t, err)
+		require.NotNil(t, op)
+	})
+
+	t.Run("test new - with transaction store sweeper", func(t *testing.T) {
+		op, err := New(&Config{StoreProvider: memstore.NewProvider(), TxnStoreSweepInterval: time.Minute})
+		require.NoError(t, err)
+		require.NotNil(t, op)
+	})
+
+	t.Run("test new - with webhook event ttl", func(t *testing.T) {
+		op, err := New(&Config{StoreProvider: memstore.NewProvider(), WebhookEventTTL: time.Minute})
 		require.NoError(t, err)
 		require.NotNil(t, op)
 	})
@@ -340,10 +325,25 @@ func TestController_New(t *testing.T) {
 		require.Contains(t, err.Error(), "issuer store provider : store open error")
 		require.Nil(t, op)
 
-		op, err = New(&Config{StoreProvider: &mockstorage.Provider{}, OIDCProviderURL: "url"})
+		op, err = New(&Config{StoreProvider: memstore.NewProvider(), OIDCProviderURL: "url"})
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "failed to create oidc client")
 		require.Nil(t, op)
+
+		op, err = New(&Config{StoreProvider: memstore.NewProvider(), StatusListType: "RevocationList2020"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "issuer status lists : unsupported credential status type")
+		require.Nil(t, op)
+
+		op, err = New(&Config{StoreProvider: memstore.NewProvider(), StatusListPurpose: "refresh"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported status list purpose refresh")
+		require.Nil(t, op)
+
+		op, err = New(&Config{StoreProvider: memstore.NewProvider(), VCSProfilesFile: "missing.json"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "issuer profile registry")
+		require.Nil(t, op)
 	})
 }
 
@@ -352,7 +352,7 @@ func TestOperation_Login(t *testing.T) {
 		TokenIssuer:    &mockTokenIssuer{},
 		TokenResolver:  &mockTokenResolver{},
 		ExtTokenIssuer: &mockTokenIssuer{},
-		StoreProvider:  &mockstorage.Provider{},
+		StoreProvider:  memstore.NewProvider(),
 	}
 	handler := getHandlerWithConfig(t, login, cfg)
 
@@ -458,6 +458,156 @@ func TestCreateOIDCIssuanceRequest(t *testing.T) {
 
 		svc.initiateIssuance(w, req)
 		require.Equal(t, http.StatusOK, w.Code)
+
+		u, err := url.Parse(w.Body.String())
+		require.NoError(t, err)
+
+		issuer := u.Query().Get("issuer")
+		key, err := svc.keyManager.Get(issuer[strings.LastIndex(issuer, "/")+1:])
+		require.NoError(t, err)
+		require.Equal(t, kms.Ed25519, key.Type)
+	})
+	t.Run("oidc issuance success - P-256 key", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:  memstore.NewProvider(),
+			DefaultKeyType: "P-384",
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		p256Request := *issuanceRequest
+		p256Request.KeyType = "P-256"
+
+		issuanceRequestBytes, err := json.Marshal(p256Request)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, oidcIssuerIssuance, bytes.NewReader(issuanceRequestBytes))
+		require.NoError(t, err)
+
+		svc.initiateIssuance(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		u, err := url.Parse(w.Body.String())
+		require.NoError(t, err)
+
+		issuer := u.Query().Get("issuer")
+		key, err := svc.keyManager.Get(issuer[strings.LastIndex(issuer, "/")+1:])
+		require.NoError(t, err)
+		require.Equal(t, kms.P256, key.Type)
+
+		issuerConf, err := svc.getIssuerConfiguration(issuer[strings.LastIndex(issuer, "/")+1:])
+		require.NoError(t, err)
+		require.Len(t, issuerConf.CredentialsSupported, 3)
+		require.Equal(t, []string{"JsonWebSignature2020"}, issuerConf.CredentialsSupported[0].CryptographicSuitesSupported)
+		require.Equal(t, []string{"ES256"}, issuerConf.CredentialsSupported[1].CryptographicSuitesSupported)
+		require.Equal(t, []string{"ES256"}, issuerConf.CredentialsSupported[2].CryptographicSuitesSupported)
+	})
+	t.Run("oidc issuance success - deferred", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		deferredRequest := *issuanceRequest
+		deferredRequest.Deferred = true
+
+		issuanceRequestBytes, err := json.Marshal(deferredRequest)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, oidcIssuerIssuance, bytes.NewReader(issuanceRequestBytes))
+		require.NoError(t, err)
+
+		svc.initiateIssuance(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		u, err := url.Parse(w.Body.String())
+		require.NoError(t, err)
+
+		issuer := u.Query().Get("issuer")
+		issuerConf, err := svc.getIssuerConfiguration(issuer[strings.LastIndex(issuer, "/")+1:])
+		require.NoError(t, err)
+		require.Equal(t, issuer+"/oidc/credential/deferred", issuerConf.DeferredCredentialEndpoint)
+		require.Equal(t, issuer+"/oidc/batch_credential", issuerConf.BatchCredentialEndpoint)
+		require.Equal(t, issuer+"/oidc/register", issuerConf.RegistrationEndpoint)
+		require.Len(t, issuerConf.CredentialsSupported, 3)
+		require.Equal(t, sdJWTVCFormat, issuerConf.CredentialsSupported[2].Format)
+		require.Equal(t, []string{"Ed25519Signature2018"}, issuerConf.CredentialsSupported[0].CryptographicSuitesSupported)
+		require.Equal(t, []string{"EdDSA"}, issuerConf.CredentialsSupported[2].CryptographicSuitesSupported)
+	})
+	t.Run("oidc issuance success - pre-authorized code", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+
+		for _, pinRequired := range []bool{true, false} {
+			w := httptest.NewRecorder()
+
+			preAuthRequest := *issuanceRequest
+			preAuthRequest.WalletInitIssuanceURL = ""
+			preAuthRequest.CredentialTypes = "UniversityDegreeCredential"
+			preAuthRequest.GrantType = preAuthorizedCodeGrantType
+			preAuthRequest.UserPinRequired = pinRequired
+
+			issuanceRequestBytes, err := json.Marshal(preAuthRequest)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodGet, oidcIssuerIssuance, bytes.NewReader(issuanceRequestBytes))
+			require.NoError(t, err)
+
+			svc.initiateIssuance(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+
+			resp := &initiateOIDC4CIResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+			require.True(t, strings.HasPrefix(resp.OfferCredentialURL, credentialOfferScheme))
+
+			u, err := url.Parse(resp.OfferCredentialURL)
+			require.NoError(t, err)
+
+			offer := &struct {
+				CredentialIssuer string                            `json:"credential_issuer"`
+				Credentials      []string                          `json:"credentials"`
+				Grants           map[string]preAuthorizedCodeGrant `json:"grants"`
+			}{}
+			require.NoError(t, json.Unmarshal([]byte(u.Query().Get("credential_offer")), offer))
+			require.Equal(t, "https://issuer/oidc/share/"+resp.TxID, offer.CredentialIssuer)
+			require.Equal(t, []string{"UniversityDegreeCredential"}, offer.Credentials)
+
+			grant := offer.Grants[preAuthorizedCodeGrantType]
+			require.NotEmpty(t, grant.PreAuthorizedCode)
+			require.Equal(t, pinRequired, grant.UserPinRequired)
+
+			if pinRequired {
+				require.NotNil(t, resp.UserPin)
+				require.Len(t, *resp.UserPin, userPinLength)
+			} else {
+				require.Nil(t, resp.UserPin)
+			}
+
+			_, err = svc.store.Get(getPreAuthCodeKeyPrefix(grant.PreAuthorizedCode))
+			require.NoError(t, err)
+		}
+	})
+	t.Run("error - unsupported key type", func(t *testing.T) {
+		// the key type is validated before anything is stored
+		svc, err := New(&Config{
+			StoreProvider: &mockstorage.Provider{
+				OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("save error")},
+			},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		rsaRequest := *issuanceRequest
+		rsaRequest.KeyType = "RSA"
+
+		issuanceRequestBytes, err := json.Marshal(rsaRequest)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, oidcIssuerIssuance, bytes.NewReader(issuanceRequestBytes))
+		require.NoError(t, err)
+
+		svc.initiateIssuance(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "failed to create issuer signing key")
 	})
 	t.Run("error - failed to parse wallet init issuance URL", func(t *testing.T) {
 		svc, err := New(&Config{
@@ -490,10 +640,14 @@ func TestCreateOIDCIssuanceRequest(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, w.Code)
 	})
 	t.Run("error - failed to store issuer server configuration", func(t *testing.T) {
+		keyManager, err := kms.New(memstore.NewProvider())
+		require.NoError(t, err)
+
 		svc, err := New(&Config{
 			StoreProvider: &mockstorage.Provider{
 				OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("save error")},
 			},
+			KeyManager: keyManager,
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -515,9 +669,75 @@ func TestSaveIssuanceConfig(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	err = svc.saveIssuanceConfig(uuid.NewString(), []byte("issuerConf"), nil)
+	err = svc.saveIssuanceConfig(uuid.NewString(), []byte("issuerConf"), nil, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to store credential")
+
+	t.Run("credentials keyed by type", func(t *testing.T) {
+		key := uuid.NewString()
+
+		err = svc.saveIssuanceConfig(key, []byte("issuerConf"), nil, map[string]json.RawMessage{
+			"UniversityDegreeCredential": json.RawMessage(testCredentialRequest),
+		})
+		require.NoError(t, err)
+
+		credential, err := svc.getIssuanceCredential(key, []string{"UniversityDegreeCredential"})
+		require.NoError(t, err)
+		require.JSONEq(t, testCredentialRequest, string(credential))
+	})
+}
+
+func TestGetIssuanceCredential(t *testing.T) {
+	svc, err := New(&Config{
+		StoreProvider: memstore.NewProvider(),
+	})
+	require.NoError(t, err)
+
+	alumniCredential := strings.Replace(testCredentialRequest, "UniversityDegreeCredential", "AlumniCredential", 1)
+
+	err = svc.saveIssuanceConfig("issuer", []byte("issuerConf"), []byte(testCredentialRequest),
+		map[string]json.RawMessage{
+			"alumni_manifest": json.RawMessage(alumniCredential),
+			"degree":          json.RawMessage(testCredentialRequest),
+			"invalid":         json.RawMessage(`{"type":1}`),
+		})
+	require.NoError(t, err)
+
+	t.Run("match by key", func(t *testing.T) {
+		credential, err := svc.getIssuanceCredential("issuer", []string{"alumni_manifest"})
+		require.NoError(t, err)
+		require.Contains(t, string(credential), "AlumniCredential")
+	})
+	t.Run("match by credential type", func(t *testing.T) {
+		credential, err := svc.getIssuanceCredential("issuer", []string{"OtherCredential", "AlumniCredential"})
+		require.NoError(t, err)
+		require.Contains(t, string(credential), "AlumniCredential")
+	})
+	t.Run("default credential", func(t *testing.T) {
+		credential, err := svc.getIssuanceCredential("issuer", nil)
+		require.NoError(t, err)
+		require.Contains(t, string(credential), "UniversityDegreeCredential")
+	})
+	t.Run("unsupported credential type", func(t *testing.T) {
+		_, err := svc.getIssuanceCredential("issuer", []string{"OtherCredential"})
+		require.ErrorIs(t, err, errUnsupportedCredentialType)
+	})
+	t.Run("single credential ignores type", func(t *testing.T) {
+		err := svc.saveIssuanceConfig("single", []byte("issuerConf"), []byte(testCredentialRequest), nil)
+		require.NoError(t, err)
+
+		credential, err := svc.getIssuanceCredential("single", []string{"OtherCredential"})
+		require.NoError(t, err)
+		require.Contains(t, string(credential), "UniversityDegreeCredential")
+	})
+	t.Run("invalid credentials", func(t *testing.T) {
+		err := svc.store.Put(getCredSetKeyPrefix("invalid"), []byte("{"))
+		require.NoError(t, err)
+
+		_, err = svc.getIssuanceCredential("invalid", []string{"AlumniCredential"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to read credentials")
+	})
 }
 
 func TestWellConfiguration(t *testing.T) {
@@ -542,7 +762,8 @@ func TestWellConfiguration(t *testing.T) {
 func TestOIDCAuthorize(t *testing.T) {
 	t.Run("success - issue authorize", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -560,9 +781,70 @@ func TestOIDCAuthorize(t *testing.T) {
 		svc.oidcAuthorize(w, req)
 		require.Equal(t, http.StatusFound, w.Code)
 	})
+	t.Run("success - issue authorize with code challenge", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodGet, oidcIssuanceAuthorize, nil)
+		require.NoError(t, err)
+		req.Form = url.Values{
+			"claims":         {"claims"},
+			"redirect_uri":   {"redirect_uri"},
+			"client_id":      {"client_id"},
+			"state":          {"state"},
+			"code_challenge": {"challenge"},
+		}
+
+		svc.oidcAuthorize(w, req)
+		require.Equal(t, http.StatusFound, w.Code)
+
+		var authState string
+
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == "state" {
+				authState = cookie.Value
+			}
+		}
+
+		authRqstBytes, err := svc.store.Get(getAuthStateKeyPrefix(authState))
+		require.NoError(t, err)
+
+		authRequest := map[string]string{}
+		require.NoError(t, json.Unmarshal(authRqstBytes, &authRequest))
+		require.Equal(t, "challenge", authRequest["code_challenge"])
+		require.Equal(t, codeChallengeMethodPlain, authRequest["code_challenge_method"])
+	})
+	t.Run("failure - unsupported code challenge method", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodGet, oidcIssuanceAuthorize, nil)
+		require.NoError(t, err)
+		req.Form = url.Values{
+			"claims":                {"claims"},
+			"redirect_uri":          {"redirect_uri"},
+			"client_id":             {"client_id"},
+			"state":                 {"state"},
+			"code_challenge":        {"challenge"},
+			"code_challenge_method": {"S512"},
+		}
+
+		svc.oidcAuthorize(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unsupported code challenge method")
+	})
 	t.Run("failure - failed to read claims", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -580,7 +862,8 @@ func TestOIDCAuthorize(t *testing.T) {
 	})
 	t.Run("failure - failed to read redirect URI", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -600,8 +883,12 @@ func TestOIDCAuthorize(t *testing.T) {
 	t.Run("failure - failed to save state", func(t *testing.T) {
 		svc, err := New(&Config{
 			StoreProvider: &mockstorage.Provider{
-				OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("save error")},
+				OpenStoreReturn: &mockstorage.Store{
+					ErrPut: errors.New("save error"),
+					ErrGet: storage.ErrDataNotFound,
+				},
 			},
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -622,7 +909,8 @@ func TestOIDCAuthorize(t *testing.T) {
 	})
 	t.Run("failure - failed to parse request", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -636,7 +924,8 @@ func TestOIDCAuthorize(t *testing.T) {
 	})
 	t.Run("failure - invalid request basic validation", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -648,87 +937,356 @@ func TestOIDCAuthorize(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, w.Code)
 		require.Contains(t, w.Body.String(), "Invalid Request")
 	})
-}
-
-func TestOIDCSendAuthorizeResponse(t *testing.T) {
-	t.Run("success send authorize response", func(t *testing.T) {
+	t.Run("client registry enforcement", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:                memstore.NewProvider(),
+			OIDCRedirectURIAllowlist:     []string{"https://wallet.example.com/*"},
+			OIDCRequireRegisteredClients: true,
 		})
 		require.NoError(t, err)
-		w := httptest.NewRecorder()
 
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceAuthorizeRequest,
-			strings.NewReader(`testing`))
+		_, err = svc.clientRegistry.Register(&clientregistry.Client{
+			ClientID:     "wallet",
+			RedirectURIs: []string{"https://wallet.example.com/cb"},
+		})
 		require.NoError(t, err)
-		c := http.Cookie{
-			Name:  "state",
-			Value: "testing",
-		}
-		req.AddCookie(&c)
-		authRequest := map[string]string{
-			"state":        "state",
-			"redirect_uri": "redirect_uri",
-		}
 
-		authBytes, err := json.Marshal(authRequest)
-		require.NoError(t, err, authBytes)
-
-		err = svc.store.Put(getAuthStateKeyPrefix("testing"), authBytes)
+		_, err = svc.clientRegistry.Register(&clientregistry.Client{
+			ClientID:   "pre-auth-wallet",
+			GrantTypes: []string{preAuthorizedCodeGrantType},
+		})
 		require.NoError(t, err)
 
-		svc.oidcSendAuthorizeResponse(w, req)
+		authorize := func(clientID, redirectURI, responseType string) *httptest.ResponseRecorder {
+			req, e := http.NewRequest(http.MethodGet, oidcIssuanceAuthorize, nil)
+			require.NoError(t, e)
+			req.Form = url.Values{
+				"claims":        {"claims"},
+				"redirect_uri":  {redirectURI},
+				"client_id":     {clientID},
+				"state":         {"state"},
+				"response_type": {responseType},
+			}
+
+			w := httptest.NewRecorder()
+			svc.oidcAuthorize(w, req)
+
+			return w
+		}
+
+		w := authorize("wallet", "https://wallet.example.com/cb", "code")
 		require.Equal(t, http.StatusFound, w.Code)
+
+		w = authorize("wallet", "https://wallet.example.com/other", "code")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid redirect URI")
+
+		w = authorize("wallet", "https://wallet.example.com/cb", "token")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unauthorized client")
+
+		w = authorize("pre-auth-wallet", "https://wallet.example.com/cb", "code")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unauthorized client")
+
+		w = authorize("unknown", "https://wallet.example.com/cb", "code")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid client")
+	})
+	t.Run("unknown client", func(t *testing.T) {
+		for allowlist, status := range map[string]int{
+			"":                              http.StatusBadRequest,
+			"https://wallet.example.com/*":  http.StatusBadRequest,
+			"https://wallet.example.com/cb": http.StatusFound,
+		} {
+			svc, err := New(&Config{
+				StoreProvider:            memstore.NewProvider(),
+				OIDCRedirectURIAllowlist: strings.Fields(allowlist),
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodGet, oidcIssuanceAuthorize, nil)
+			require.NoError(t, err)
+			req.Form = url.Values{
+				"claims":       {"claims"},
+				"redirect_uri": {"https://wallet.example.com/cb"},
+				"client_id":    {"unknown"},
+				"state":        {"state"},
+			}
+
+			w := httptest.NewRecorder()
+			svc.oidcAuthorize(w, req)
+			require.Equal(t, status, w.Code, allowlist)
+		}
 	})
-	t.Run("failure send authorize response- failed to redirect, invalid URL", func(t *testing.T) {
+	t.Run("redirect uri allowlist", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCIssuanceClients:      map[string]string{"wallet": ""},
+			OIDCRedirectURIAllowlist: []string{"https://wallet.example.com/cb"},
 		})
 		require.NoError(t, err)
-		w := httptest.NewRecorder()
-
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceAuthorizeRequest,
-			strings.NewReader(`testing`))
-		require.NoError(t, err)
-		c := http.Cookie{
-			Name:  "state",
-			Value: "testing",
-		}
-		req.AddCookie(&c)
-		authRequest := map[string]string{
-			"state": "state",
-		}
-
-		authBytes, err := json.Marshal(authRequest)
-		require.NoError(t, err, authBytes)
 
-		err = svc.store.Put(getAuthStateKeyPrefix("testing"), authBytes)
-		require.NoError(t, err)
+		for redirectURI, status := range map[string]int{
+			"https://wallet.example.com/cb":   http.StatusFound,
+			"https://attacker.example.com/cb": http.StatusBadRequest,
+		} {
+			req, err := http.NewRequest(http.MethodGet, oidcIssuanceAuthorize, nil)
+			require.NoError(t, err)
+			req.Form = url.Values{
+				"claims":       {"claims"},
+				"redirect_uri": {redirectURI},
+				"client_id":    {"wallet"},
+				"state":        {"state"},
+			}
 
-		svc.oidcSendAuthorizeResponse(w, req)
-		require.Equal(t, http.StatusInternalServerError, w.Code)
-		require.Contains(t, w.Body.String(), "failed to redirect, invalid URL")
+			w := httptest.NewRecorder()
+			svc.oidcAuthorize(w, req)
+			require.Equal(t, status, w.Code)
+		}
 	})
-	t.Run("failure send authorize response- failed to redirect, invalid state", func(t *testing.T) {
+	t.Run("failure - get client", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider: &mockstorage.Provider{
+				OpenStoreReturn: &mockstorage.Store{ErrGet: errors.New("get error")},
+			},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
 
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceAuthorizeRequest,
-			strings.NewReader(`testing`))
+		req, err := http.NewRequest(http.MethodGet, oidcIssuanceAuthorize, nil)
 		require.NoError(t, err)
-		c := http.Cookie{
-			Name:  "state",
-			Value: "testing",
-		}
-		req.AddCookie(&c)
-		authRequest := map[string]string{
-			"redirect_uri": "redirect_uri",
+		req.Form = url.Values{
+			"claims":       {"claims"},
+			"redirect_uri": {"redirect_uri"},
+			"client_id":    {"client_id"},
+			"state":        {"state"},
+		}
+
+		svc.oidcAuthorize(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to get client")
+	})
+}
+
+func TestOIDCClientRegistry(t *testing.T) {
+	svc, err := New(&Config{
+		StoreProvider:            memstore.NewProvider(),
+		OIDCIssuanceClients:      map[string]string{"static": "secret"},
+		OIDCRedirectURIAllowlist: []string{"https://wallet.example.com/*"},
+	})
+	require.NoError(t, err)
+
+	register := func(handler http.HandlerFunc, body string) *httptest.ResponseRecorder {
+		req, e := http.NewRequest(http.MethodPost, oidcClients, strings.NewReader(body))
+		require.NoError(t, e)
+
+		w := httptest.NewRecorder()
+		handler(w, req)
+
+		return w
+	}
+
+	t.Run("dynamic client registration", func(t *testing.T) {
+		w := register(svc.oidcDynamicClientRegistration,
+			`{"client_id":"chosen","client_name":"Wallet","redirect_uris":["https://wallet.example.com/cb"]}`)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		client := &clientregistry.Client{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), client))
+		require.NotEqual(t, "chosen", client.ClientID)
+		require.NotEmpty(t, client.ClientSecret)
+		require.Equal(t, "Wallet", client.ClientName)
+
+		// registered client can authenticate at the token endpoint
+		r, err := http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
+		require.NoError(t, err)
+		r.SetBasicAuth(client.ClientID, client.ClientSecret)
+
+		authenticated, err := svc.authenticateClient(r)
+		require.NoError(t, err)
+		require.Equal(t, client.ClientID, authenticated.ClientID)
+
+		r.SetBasicAuth(client.ClientID, "wrong")
+
+		_, err = svc.authenticateClient(r)
+		require.Error(t, err)
+	})
+
+	t.Run("register, list and delete client", func(t *testing.T) {
+		w := register(svc.registerOIDCClient,
+			`{"client_id":"wallet","redirect_uris":["https://wallet.example.com/cb"]}`)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		req, err := http.NewRequest(http.MethodGet, oidcClients, nil)
+		require.NoError(t, err)
+
+		w = httptest.NewRecorder()
+		svc.listOIDCClients(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var clients []*clientregistry.Client
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &clients))
+		require.NotEmpty(t, clients)
+
+		found := false
+
+		for _, client := range clients {
+			require.Empty(t, client.ClientSecret)
+
+			if client.ClientID == "wallet" {
+				found = true
+			}
+		}
+
+		require.True(t, found)
+
+		deleteClient := func(clientID string) *httptest.ResponseRecorder {
+			r, e := http.NewRequest(http.MethodDelete, oidcClients+"/"+clientID, nil)
+			require.NoError(t, e)
+
+			rw := httptest.NewRecorder()
+			svc.deleteOIDCClient(rw, mux.SetURLVars(r, map[string]string{"clientID": clientID}))
+
+			return rw
+		}
+
+		require.Equal(t, http.StatusNoContent, deleteClient("wallet").Code)
+		require.Equal(t, http.StatusNotFound, deleteClient("wallet").Code)
+	})
+
+	t.Run("invalid client metadata", func(t *testing.T) {
+		for body, expected := range map[string]string{
+			`{`:                                      clientregistry.InvalidClientMetadataError,
+			`{"client_id":"static"}`:                 clientregistry.InvalidClientMetadataError,
+			`{"grant_types":["implicit"]}`:           clientregistry.InvalidClientMetadataError,
+			`{"redirect_uris":["https://other/cb"]}`: clientregistry.InvalidRedirectURIError,
+		} {
+			w := register(svc.registerOIDCClient, body)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := map[string]string{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			require.Equal(t, expected, resp["error"])
+			require.NotEmpty(t, resp["error_description"])
+		}
+
+		w := register(svc.oidcDynamicClientRegistration, `{`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), clientregistry.InvalidClientMetadataError)
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: &mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrPut:   errors.New("put error"),
+			ErrQuery: errors.New("query error"),
+			ErrGet:   errors.New("get error"),
+		}}})
+		require.NoError(t, err)
+
+		w := register(svc.registerOIDCClient, `{"redirect_uris":["https://wallet.example.com/cb"]}`)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to register client")
+
+		req, err := http.NewRequest(http.MethodGet, oidcClients, nil)
+		require.NoError(t, err)
+
+		w = httptest.NewRecorder()
+		svc.listOIDCClients(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to list clients")
+
+		w = httptest.NewRecorder()
+		svc.deleteOIDCClient(w, mux.SetURLVars(req, map[string]string{"clientID": "wallet"}))
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to delete client")
+	})
+	t.Run("admin token", func(t *testing.T) {
+		requireAdminHandler(t, oidcClients, http.MethodPost, oidcClients)
+		requireAdminHandler(t, oidcClients, http.MethodGet, oidcClients)
+		requireAdminHandler(t, oidcClientPath, http.MethodDelete, oidcClients+"/wallet")
+	})
+}
+
+func TestOIDCSendAuthorizeResponse(t *testing.T) {
+	t.Run("success send authorize response", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceAuthorizeRequest,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+		c := http.Cookie{
+			Name:  "state",
+			Value: "testing",
+		}
+		req.AddCookie(&c)
+		authRequest := map[string]string{
+			"state":        "state",
+			"redirect_uri": "redirect_uri",
+		}
+
+		authBytes, err := json.Marshal(authRequest)
+		require.NoError(t, err, authBytes)
+
+		err = svc.store.Put(getAuthStateKeyPrefix("testing"), authBytes)
+		require.NoError(t, err)
+
+		svc.oidcSendAuthorizeResponse(w, req)
+		require.Equal(t, http.StatusFound, w.Code)
+	})
+	t.Run("failure send authorize response- failed to redirect, invalid URL", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceAuthorizeRequest,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+		c := http.Cookie{
+			Name:  "state",
+			Value: "testing",
+		}
+		req.AddCookie(&c)
+		authRequest := map[string]string{
+			"state": "state",
+		}
+
+		authBytes, err := json.Marshal(authRequest)
+		require.NoError(t, err, authBytes)
+
+		err = svc.store.Put(getAuthStateKeyPrefix("testing"), authBytes)
+		require.NoError(t, err)
+
+		svc.oidcSendAuthorizeResponse(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to redirect, invalid URL")
+	})
+	t.Run("failure send authorize response- failed to redirect, invalid state", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceAuthorizeRequest,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+		c := http.Cookie{
+			Name:  "state",
+			Value: "testing",
+		}
+		req.AddCookie(&c)
+		authRequest := map[string]string{
+			"redirect_uri": "redirect_uri",
 		}
 
 		authBytes, err := json.Marshal(authRequest)
@@ -793,7 +1351,8 @@ func TestOIDCTokenEndpoint(t *testing.T) {
 
 	t.Run("success - oidc token endpoint", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
@@ -814,92 +1373,417 @@ func TestOIDCTokenEndpoint(t *testing.T) {
 		require.Equal(t, http.StatusInternalServerError, w.Code)
 		require.Contains(t, w.Body.String(), "request validation failed")
 	})
-	t.Run("success - oidc Token Endpoint", func(t *testing.T) {
+	t.Run("success - oidc token endpoint returns c_nonce", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
 
+		authReqBytes, err := json.Marshal(map[string]string{
+			"state":        "state",
+			"redirect_uri": "redirect_uri",
+		})
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		require.NoError(t, err)
+		err = svc.store.Put(getAuthStateKeyPrefix("authstate"), authReqBytes)
+		require.NoError(t, err)
+
+		svc.oidcTokenEndpoint(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.NotEmpty(t, resp["access_token"])
+		require.NotEmpty(t, resp["c_nonce"])
+		require.EqualValues(t, 300, resp["c_nonce_expires_in"])
+
+		nonceBytes, err := svc.store.Get(getCNonceKeyPrefix(resp["access_token"].(string)))
+		require.NoError(t, err)
+		require.Contains(t, string(nonceBytes), resp["c_nonce"])
+		require.EqualValues(t, 3600, resp["expires_in"])
+
+		// authorization code can't be reused
+		w = httptest.NewRecorder()
 		svc.oidcTokenEndpoint(w, req)
 		require.Equal(t, http.StatusBadRequest, w.Code)
 		require.Contains(t, w.Body.String(), "invalid state")
 	})
-	t.Run("failure - invalid state", func(t *testing.T) {
+	t.Run("failure - expired authorization code", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
 
+		authReqBytes, err := json.Marshal(map[string]string{
+			"state":        "state",
+			"redirect_uri": "redirect_uri",
+		})
+		require.NoError(t, err)
+
+		err = svc.store.PutWithTTL(getAuthCodeKeyPrefix("code"), []byte("authstate"), -time.Minute)
+		require.NoError(t, err)
+		err = svc.store.Put(getAuthStateKeyPrefix("authstate"), authReqBytes)
+		require.NoError(t, err)
+
 		svc.oidcTokenEndpoint(w, req)
 		require.Equal(t, http.StatusBadRequest, w.Code)
 		require.Contains(t, w.Body.String(), "invalid state")
 	})
-	t.Run("failure - invalid request", func(t *testing.T) {
+	t.Run("authorization code grant - pkce and client authentication", func(t *testing.T) {
+		verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+		challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+		tokenRequest := func(t *testing.T, clients map[string]string, method string,
+			form url.Values, basicAuth ...string) *httptest.ResponseRecorder {
+			t.Helper()
+
+			svc, err := New(&Config{
+				StoreProvider:            memstore.NewProvider(),
+				OIDCIssuanceClients:      clients,
+				OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+			})
+			require.NoError(t, err)
+
+			authReqBytes, err := json.Marshal(map[string]string{
+				"state":                 "state",
+				"client_id":             "wallet",
+				"redirect_uri":          "redirect_uri",
+				"code_challenge":        challenge,
+				"code_challenge_method": method,
+			})
+			require.NoError(t, err)
+
+			err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+			require.NoError(t, err)
+			err = svc.store.Put(getAuthStateKeyPrefix("authstate"), authReqBytes)
+			require.NoError(t, err)
+
+			r, err := http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
+			require.NoError(t, err)
+
+			form.Set("grant_type", authorizationCodeGrantType)
+			form.Set("code", "code")
+			form.Set("redirect_uri", "redirect_uri")
+			r.Form = form
+
+			if len(basicAuth) == 2 {
+				r.SetBasicAuth(basicAuth[0], basicAuth[1])
+			}
+
+			w := httptest.NewRecorder()
+			svc.oidcTokenEndpoint(w, r)
+
+			return w
+		}
+
+		t.Run("success - S256 public client", func(t *testing.T) {
+			w := tokenRequest(t, nil, codeChallengeMethodS256,
+				url.Values{"client_id": {"wallet"}, "code_verifier": {verifier}})
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+		t.Run("success - plain", func(t *testing.T) {
+			w := tokenRequest(t, map[string]string{"wallet": ""}, codeChallengeMethodPlain,
+				url.Values{"client_id": {"wallet"}, "code_verifier": {challenge}})
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+		t.Run("success - client_secret_basic", func(t *testing.T) {
+			w := tokenRequest(t, map[string]string{"wallet": "s3cr:t"}, codeChallengeMethodS256,
+				url.Values{"code_verifier": {verifier}}, "wallet", url.QueryEscape("s3cr:t"))
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+		t.Run("success - client_secret_post", func(t *testing.T) {
+			w := tokenRequest(t, map[string]string{"wallet": "secret"}, codeChallengeMethodS256,
+				url.Values{"client_id": {"wallet"}, "client_secret": {"secret"}, "code_verifier": {verifier}})
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+		t.Run("failure - invalid code verifier", func(t *testing.T) {
+			w := tokenRequest(t, nil, codeChallengeMethodS256,
+				url.Values{"client_id": {"wallet"}, "code_verifier": {challenge}})
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_grant")
+
+			w = tokenRequest(t, nil, codeChallengeMethodS256, url.Values{"client_id": {"wallet"}})
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_grant")
+		})
+		t.Run("failure - client mismatch", func(t *testing.T) {
+			w := tokenRequest(t, nil, codeChallengeMethodS256,
+				url.Values{"client_id": {"other"}, "code_verifier": {verifier}})
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_grant")
+
+			w = tokenRequest(t, nil, codeChallengeMethodS256, url.Values{"code_verifier": {verifier}})
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_grant")
+		})
+		t.Run("failure - invalid client", func(t *testing.T) {
+			w := tokenRequest(t, map[string]string{"wallet": "secret"}, codeChallengeMethodS256,
+				url.Values{"code_verifier": {verifier}}, "wallet", "wrong")
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_client")
+
+			w = tokenRequest(t, map[string]string{"wallet": "secret"}, codeChallengeMethodS256,
+				url.Values{"client_id": {"other"}, "code_verifier": {verifier}})
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_client")
+
+			w = tokenRequest(t, map[string]string{"wallet": "secret"}, codeChallengeMethodS256,
+				url.Values{"code_verifier": {verifier}}, "wallet", "%zz")
+			require.Equal(t, http.StatusUnauthorized, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_client")
+		})
+	})
+	t.Run("grant type enforcement", func(t *testing.T) {
 		svc, err := New(&Config{
 			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
+
+		preAuthClient, err := svc.clientRegistry.Register(&clientregistry.Client{
+			GrantTypes: []string{preAuthorizedCodeGrantType},
+		})
+		require.NoError(t, err)
+
+		authCodeClient, err := svc.clientRegistry.Register(&clientregistry.Client{
+			RedirectURIs:            []string{"https://wallet.example.com/cb"},
+			TokenEndpointAuthMethod: noneAuthMethod,
+		})
+		require.NoError(t, err)
+
+		r, err := http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
+		require.NoError(t, err)
+		r.Form = url.Values{"grant_type": {authorizationCodeGrantType}, "code": {"code"}}
+		r.SetBasicAuth(preAuthClient.ClientID, preAuthClient.ClientSecret)
+
 		w := httptest.NewRecorder()
+		svc.oidcTokenEndpoint(w, r)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unauthorized_client")
 
-		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		r, err = http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
 		require.NoError(t, err)
+		r.Form = url.Values{
+			"grant_type":          {preAuthorizedCodeGrantType},
+			"pre-authorized_code": {"code"},
+			"client_id":           {authCodeClient.ClientID},
+		}
 
-		svc.oidcTokenEndpoint(w, req)
+		w = httptest.NewRecorder()
+		svc.oidcTokenEndpoint(w, r)
 		require.Equal(t, http.StatusBadRequest, w.Code)
-		require.Contains(t, w.Body.String(), "invalid request")
+		require.Contains(t, w.Body.String(), "unauthorized_client")
+
+		r.Form.Set("client_id", "unknown")
+		r.SetBasicAuth("unknown", "%zz")
+
+		w = httptest.NewRecorder()
+		svc.oidcTokenEndpoint(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_client")
 	})
-	t.Run("failure - failed to read request", func(t *testing.T) {
+	t.Run("success - pre-authorized code grant", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
-		w := httptest.NewRecorder()
 
-		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		preAuthCode, err := json.Marshal(&preAuthorizedCode{Code: "code", IssuerID: "mockIssuer", UserPin: "123456"})
 		require.NoError(t, err)
 
-		err = svc.store.Put(getAuthStateKeyPrefix("authstate"), []byte("authReq"))
+		err = svc.store.Put(getPreAuthCodeKeyPrefix("code"), preAuthCode)
 		require.NoError(t, err)
 
-		svc.oidcTokenEndpoint(w, req)
-		require.Equal(t, http.StatusInternalServerError, w.Code)
-		require.Contains(t, w.Body.String(), "failed to read request")
+		preAuthReq := func(pin string) *http.Request {
+			r, e := http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
+			require.NoError(t, e)
+
+			r.Form = url.Values{
+				"grant_type":          {preAuthorizedCodeGrantType},
+				"pre-authorized_code": {"code"},
+				"user_pin":            {pin},
+			}
+
+			return mux.SetURLVars(r, map[string]string{"id": "mockIssuer"})
+		}
+
+		w := httptest.NewRecorder()
+		svc.oidcTokenEndpoint(w, preAuthReq("123456"))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.NotEmpty(t, resp["c_nonce"])
+
+		issuerID, err := svc.store.Get(getAccessTokenKeyPrefix(resp["access_token"].(string)))
+		require.NoError(t, err)
+		require.Equal(t, "mockIssuer", string(issuerID))
+
+		// pre-authorized code can't be reused
+		w = httptest.NewRecorder()
+		svc.oidcTokenEndpoint(w, preAuthReq("123456"))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_grant")
 	})
+	t.Run("failure - pre-authorized code invalidated by wrong user pin", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
+		require.NoError(t, err)
 
-	t.Run("failure - failed to read request", func(t *testing.T) {
+		preAuthCode, err := json.Marshal(&preAuthorizedCode{Code: "code", IssuerID: "mockIssuer", UserPin: "123456"})
+		require.NoError(t, err)
+
+		err = svc.store.Put(getPreAuthCodeKeyPrefix("code"), preAuthCode)
+		require.NoError(t, err)
+
+		for _, pin := range []string{"000000", "123456"} {
+			r, e := http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
+			require.NoError(t, e)
+
+			r.Form = url.Values{
+				"grant_type":          {preAuthorizedCodeGrantType},
+				"pre-authorized_code": {"code"},
+				"user_pin":            {pin},
+			}
+
+			w := httptest.NewRecorder()
+			svc.oidcTokenEndpoint(w, mux.SetURLVars(r, map[string]string{"id": "mockIssuer"}))
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_grant")
+		}
+	})
+	t.Run("failure - pre-authorized code grant", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
-		w := httptest.NewRecorder()
 
-		authRequest := map[string]string{
-			"state": "state",
-		}
-		authReqBytes, err := json.Marshal(authRequest)
+		err = svc.store.Put(getPreAuthCodeKeyPrefix("invalid"), []byte("{"))
 		require.NoError(t, err)
 
-		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		preAuthCode, err := json.Marshal(&preAuthorizedCode{Code: "code", IssuerID: "otherIssuer"})
 		require.NoError(t, err)
 
-		err = svc.store.Put(getAuthStateKeyPrefix("authstate"), authReqBytes)
+		err = svc.store.Put(getPreAuthCodeKeyPrefix("code"), preAuthCode)
 		require.NoError(t, err)
 
-		svc.oidcTokenEndpoint(w, req)
-		require.Equal(t, http.StatusInternalServerError, w.Code)
-		require.Contains(t, w.Body.String(), "request validation failed")
+		for code, expected := range map[string]string{
+			"unknown": "invalid_grant",
+			"invalid": "failed to read pre-authorized code",
+			"code":    "invalid_grant",
+		} {
+			r, err := http.NewRequest(http.MethodPost, oidcIssuanceToken, nil)
+			require.NoError(t, err)
+
+			r.Form = url.Values{
+				"grant_type":          {preAuthorizedCodeGrantType},
+				"pre-authorized_code": {code},
+			}
+			r = mux.SetURLVars(r, map[string]string{"id": "mockIssuer"})
+
+			w := httptest.NewRecorder()
+			svc.oidcTokenEndpoint(w, r)
+			require.Contains(t, w.Body.String(), expected)
+		}
 	})
-	t.Run("failure - unsupported grant type", func(t *testing.T) {
+	t.Run("success - oidc Token Endpoint", func(t *testing.T) {
 		svc, err := New(&Config{
-			StoreProvider: memstore.NewProvider(),
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
 		})
 		require.NoError(t, err)
 		w := httptest.NewRecorder()
 
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceToken,
+		svc.oidcTokenEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid state")
+	})
+	t.Run("failure - invalid state", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		svc.oidcTokenEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid state")
+	})
+	t.Run("failure - invalid request", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		require.NoError(t, err)
+
+		svc.oidcTokenEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid request")
+	})
+	t.Run("failure - failed to read request", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAuthStateKeyPrefix("authstate"), []byte("authReq"))
+		require.NoError(t, err)
+
+		svc.oidcTokenEndpoint(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to read request")
+	})
+
+	t.Run("failure - failed to read request", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		authRequest := map[string]string{
+			"state": "state",
+		}
+		authReqBytes, err := json.Marshal(authRequest)
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAuthCodeKeyPrefix("code"), []byte("authstate"))
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAuthStateKeyPrefix("authstate"), authReqBytes)
+		require.NoError(t, err)
+
+		svc.oidcTokenEndpoint(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "request validation failed")
+	})
+	t.Run("failure - unsupported grant type", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:            memstore.NewProvider(),
+			OIDCRedirectURIAllowlist: []string{"redirect_uri"},
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceToken,
 			strings.NewReader(`testing`))
 		require.NoError(t, err)
 
@@ -936,11 +1820,184 @@ func TestOIDCCredentialEndpoint(t *testing.T) {
 		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
 		require.NoError(t, err)
 
+		holder, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+		req.Form["proof"] = []string{proofJSON}
+
+		err = svc.store.Put(getCredStoreKeyPrefix("mockIssuer"), []byte(testCredentialRequest))
+		require.NoError(t, err)
+
+		key, err := svc.keyManager.Create("mockIssuer", kms.Ed25519)
+		require.NoError(t, err)
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := &oidcCredentialTestResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, key.DID, resp.Credential.Issuer.ID)
+		require.Equal(t, holder.DID, resp.Credential.CredentialSubject.ID)
+		require.NotEmpty(t, resp.CNonce)
+		require.Equal(t, "Ed25519Signature2018", resp.Credential.Proof["type"])
+		require.Equal(t, key.ID, resp.Credential.Proof["verificationMethod"])
+		require.Contains(t, resp.Credential.Context, statuslist.StatusList2021Context)
+		require.Equal(t, statuslist.StatusList2021Entry, resp.Credential.CredentialStatus["type"])
+		require.Equal(t, "0", resp.Credential.CredentialStatus["statusListIndex"])
+		require.Contains(t, resp.Credential.CredentialStatus["statusListCredential"], "https://issuer/mockIssuer/status/")
+	})
+	t.Run("success - oidc credential endpoint with P-256 key", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+		req.Form["format"] = []string{"ldp_vc"}
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		holder, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+		req.Form["proof"] = []string{proofJSON}
+
 		err = svc.store.Put(getCredStoreKeyPrefix("mockIssuer"), []byte(testCredentialRequest))
 		require.NoError(t, err)
 
+		key, err := svc.keyManager.Create("mockIssuer", kms.P256)
+		require.NoError(t, err)
+
 		svc.oidcCredentialEndpoint(w, req)
 		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := &oidcCredentialTestResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, key.DID, resp.Credential.Issuer.ID)
+		require.Equal(t, holder.DID, resp.Credential.CredentialSubject.ID)
+		require.NotEmpty(t, resp.CNonce)
+		require.Contains(t, resp.Credential.Context, jws2020Context)
+		require.Equal(t, "JsonWebSignature2020", resp.Credential.Proof["type"])
+		require.NotEmpty(t, resp.Credential.Proof["jws"])
+	})
+	t.Run("success - jwt formats", func(t *testing.T) {
+		for _, keyType := range []kms.KeyType{kms.Ed25519, kms.P256} {
+			for _, format := range []string{jwtVCJSONFormat, sdJWTVCFormat} {
+				svc, err := New(&Config{
+					StoreProvider: memstore.NewProvider(),
+				})
+				require.NoError(t, err)
+
+				err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+				require.NoError(t, err)
+
+				holder, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+
+				err = svc.store.Put(getCredStoreKeyPrefix("mockIssuer"), []byte(testCredentialRequest))
+				require.NoError(t, err)
+
+				key, err := svc.keyManager.Create("mockIssuer", keyType)
+				require.NoError(t, err)
+
+				req, err := http.NewRequest(http.MethodPost, oidcIssuanceCredential, nil)
+				require.NoError(t, err)
+
+				req.Form = url.Values{"format": {format}, "proof": {proofJSON}}
+				req.Header.Set("Authorization", "Bearer testToken")
+				req = mux.SetURLVars(req, map[string]string{"id": "mockIssuer"})
+
+				w := httptest.NewRecorder()
+				svc.oidcCredentialEndpoint(w, req)
+				require.Equal(t, http.StatusOK, w.Code)
+
+				resp := &struct {
+					Format     string `json:"format"`
+					Credential string `json:"credential"`
+				}{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+				require.Equal(t, format, resp.Format)
+
+				vcJWT := resp.Credential
+
+				var disclosures []*sdjwt.Disclosure
+
+				if format == sdJWTVCFormat {
+					vcJWT, disclosures, err = sdjwt.Parse(resp.Credential)
+					require.NoError(t, err)
+					require.Len(t, disclosures, 3)
+				}
+
+				jws, err := jose.ParseSigned(vcJWT)
+				require.NoError(t, err)
+				require.Equal(t, key.ID, jws.Signatures[0].Header.KeyID)
+
+				payload, err := jws.Verify(key.JWK.Public())
+				require.NoError(t, err)
+
+				claims := &struct {
+					Iss   string `json:"iss"`
+					Sub   string `json:"sub"`
+					SDAlg string `json:"_sd_alg"`
+					VC    struct {
+						CredentialSubject map[string]interface{} `json:"credentialSubject"`
+					} `json:"vc"`
+				}{}
+				require.NoError(t, json.Unmarshal(payload, claims))
+				require.Equal(t, key.DID, claims.Iss)
+				require.Equal(t, holder.DID, claims.Sub)
+				require.Equal(t, holder.DID, claims.VC.CredentialSubject["id"])
+
+				if format == jwtVCJSONFormat {
+					require.Equal(t, "Jayden Doe", claims.VC.CredentialSubject["name"])
+					continue
+				}
+
+				require.Equal(t, sdjwt.SDAlgorithm, claims.SDAlg)
+				require.NotContains(t, claims.VC.CredentialSubject, "name")
+				require.Len(t, claims.VC.CredentialSubject["_sd"], 3)
+
+				for _, d := range disclosures {
+					require.Contains(t, claims.VC.CredentialSubject["_sd"], d.Digest())
+				}
+			}
+		}
+	})
+	t.Run("failure - failed to issue credential", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+		req.Form["format"] = []string{"ldp_vc"}
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		_, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+		req.Form["proof"] = []string{proofJSON}
+
+		err = svc.store.Put(getCredStoreKeyPrefix("mockIssuer"), []byte(testCredentialRequest))
+		require.NoError(t, err)
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to issue credential")
 	})
 	t.Run("failure - unsupported format requested", func(t *testing.T) {
 		svc, err := New(&Config{
@@ -996,101 +2053,777 @@ func TestOIDCCredentialEndpoint(t *testing.T) {
 		require.Equal(t, http.StatusForbidden, w.Code)
 		require.Contains(t, w.Body.String(), "invalid token")
 	})
-	t.Run("failure - unsupported format requested", func(t *testing.T) {
+	t.Run("failure - unsupported format requested", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+
+		req.Header.Set("Authorization", "Bearer testToken")
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unsupported format requested")
+	})
+	t.Run("failure - invalid transaction", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Contains(t, w.Body.String(), "invalid transaction")
+	})
+	t.Run("failure - expired access token", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+		req.Form["format"] = []string{"ldp_vc"}
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		err = svc.store.PutWithTTL(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"), -time.Second)
+		require.NoError(t, err)
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		_, err = svc.store.Get(getAccessTokenKeyPrefix("testToken"))
+		require.ErrorIs(t, err, storage.ErrDataNotFound)
+	})
+	t.Run("failure - failed to get credential", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+		req.Form["format"] = []string{"ldp_vc"}
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		_, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+		req.Form["proof"] = []string{proofJSON}
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to get credential")
+	})
+	t.Run("failure - failed to prepare credential", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`testing`))
+		require.NoError(t, err)
+
+		req.Form = make(url.Values)
+		req.Form["format"] = []string{"ldp_vc"}
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		_, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+		req.Form["proof"] = []string{proofJSON}
+
+		err = svc.store.Put(getCredStoreKeyPrefix("mockIssuer"), []byte(`{
+			"@context": [
+	"https://www.w3.org/2018/credentials/v1",
+		"https://www.w3.org/2018/credentials/examples/v1"
+],}`))
+		require.NoError(t, err)
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to prepare credential")
+	})
+	t.Run("failure - invalid credential request", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost,
+			oidcIssuanceCredential,
+			strings.NewReader(`{`))
+		require.NoError(t, err)
+
+		req.Header.Set("Content-Type", "application/json")
+
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_request")
+	})
+	t.Run("failure - invalid or missing proof", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		_, proofJSON := createCredentialProof(t, svc, "mockIssuer", "testToken")
+
+		for _, credReq := range []string{
+			`{"format":"ldp_vc"}`,
+			`{"format":"ldp_vc","proof":` + proofJSON + `}`, // nonce is rotated after the previous failure
+		} {
+			w := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodPost, oidcIssuanceCredential, strings.NewReader(credReq))
+			require.NoError(t, err)
+
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer testToken")
+			req = mux.SetURLVars(req, map[string]string{
+				"id": "mockIssuer",
+			})
+
+			svc.oidcCredentialEndpoint(w, req)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			resp := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			require.Equal(t, "invalid_or_missing_proof", resp["error"])
+			require.NotEmpty(t, resp["c_nonce"])
+		}
+	})
+	t.Run("failure - invalid issuer configuration", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		require.NoError(t, err)
+
+		_, err = svc.issueCNonce("testToken")
+		require.NoError(t, err)
+
+		_, err = svc.verifyCredentialProof("mockIssuer", "testToken", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to read issuer configuration")
+
+		err = svc.store.Put(getCNonceKeyPrefix("testToken"), []byte(`{"nonce":"n","expiresAt":"2020-01-01T00:00:00Z"}`))
+		require.NoError(t, err)
+
+		_, err = svc.verifyCredentialProof("mockIssuer", "testToken", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "nonce expired")
+	})
+}
+
+func TestBindCredentialSubject(t *testing.T) {
+	const holder = "did:example:holder"
+
+	for _, subject := range []interface{}{
+		[]verifiable.Subject{{ID: "did:example:1", CustomFields: verifiable.CustomFields{"name": "Jayden"}}},
+		verifiable.Subject{ID: "did:example:1", CustomFields: verifiable.CustomFields{"name": "Jayden"}},
+		map[string]interface{}{"id": "did:example:1", "name": "Jayden"},
+		[]interface{}{map[string]interface{}{"name": "Jayden"}},
+	} {
+		vc := &verifiable.Credential{Subject: subject}
+
+		require.NoError(t, bindCredentialSubject(vc, holder))
+
+		subjects, err := vc.MarshalJSON()
+		require.NoError(t, err)
+		require.Contains(t, string(subjects), `"credentialSubject":`)
+		require.Contains(t, string(subjects), `"id":"`+holder+`"`)
+		require.Contains(t, string(subjects), `"name":"Jayden"`)
+	}
+
+	for _, subject := range []interface{}{"did:example:1", []interface{}{"did:example:1"}, nil} {
+		err := bindCredentialSubject(&verifiable.Credential{Subject: subject}, holder)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported credential subject")
+	}
+}
+
+type oidcCredentialTestResponse struct {
+	Credential struct {
+		Context []string `json:"@context"`
+		Issuer  struct {
+			ID string `json:"id"`
+		} `json:"issuer"`
+		CredentialSubject struct {
+			ID string `json:"id"`
+		} `json:"credentialSubject"`
+		CredentialStatus map[string]interface{} `json:"credentialStatus"`
+		Proof            map[string]interface{} `json:"proof"`
+	} `json:"credential"`
+	CNonce string `json:"c_nonce"`
+}
+
+func TestOIDCCredentialType(t *testing.T) {
+	const issuerID = "mockIssuer"
+
+	// AlumniCredential isn't defined by the examples context, so it's defined inline for the linked data proof.
+	alumniCredential := strings.NewReplacer(
+		"UniversityDegreeCredential", "AlumniCredential",
+		`"https://www.w3.org/2018/credentials/examples/v1"`,
+		`"https://www.w3.org/2018/credentials/examples/v1", {"AlumniCredential": "ex:AlumniCredential"}`,
+	).Replace(testCredentialRequest)
+
+	setup := func(t *testing.T, deferred bool) (*Operation, string) {
+		t.Helper()
+
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte(issuerID))
+		require.NoError(t, err)
+
+		_, proofJSON := createCredentialProof(t, svc, issuerID, "testToken")
+
+		issuerConf := &issuerConfiguration{Issuer: "https://issuer/" + issuerID}
+		if deferred {
+			issuerConf.DeferredCredentialEndpoint = issuerConf.Issuer + "/oidc/credential/deferred"
+		}
+
+		issuerConfBytes, err := json.Marshal(issuerConf)
+		require.NoError(t, err)
+
+		err = svc.saveIssuanceConfig(issuerID, issuerConfBytes, nil, map[string]json.RawMessage{
+			"UniversityDegreeCredential": json.RawMessage(testCredentialRequest),
+			"alumni_manifest":            json.RawMessage(alumniCredential),
+		})
+		require.NoError(t, err)
+
+		_, err = svc.keyManager.Create(issuerID, kms.Ed25519)
+		require.NoError(t, err)
+
+		return svc, proofJSON
+	}
+
+	batchRequest := func(t *testing.T, svc *Operation, body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceBatchCredential, strings.NewReader(body))
+		require.NoError(t, err)
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		req = mux.SetURLVars(req, map[string]string{"id": issuerID})
+
+		w := httptest.NewRecorder()
+		svc.oidcBatchCredentialEndpoint(w, req)
+
+		return w
+	}
+
+	t.Run("success - credential matching requested type", func(t *testing.T) {
+		svc, proofJSON := setup(t, false)
+
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceCredential,
+			strings.NewReader(`{"format":"ldp_vc","types":["VerifiableCredential","AlumniCredential"],"proof":`+
+				proofJSON+`}`))
+		require.NoError(t, err)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer testToken")
+		req = mux.SetURLVars(req, map[string]string{"id": issuerID})
+
+		w := httptest.NewRecorder()
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := &struct {
+			Format     string `json:"format"`
+			Credential struct {
+				Type []string `json:"type"`
+			} `json:"credential"`
+		}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, ldpVCFormat, resp.Format)
+		require.Equal(t, []string{"VerifiableCredential", "AlumniCredential"}, resp.Credential.Type)
+	})
+	t.Run("failure - unsupported credential type", func(t *testing.T) {
+		svc, proofJSON := setup(t, false)
+
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceCredential, nil)
+		require.NoError(t, err)
+
+		req.Form = url.Values{"format": {"ldp_vc"}, "type": {"OtherCredential"}, "proof": {proofJSON}}
+		req.Header.Set("Authorization", "Bearer testToken")
+		req = mux.SetURLVars(req, map[string]string{"id": issuerID})
+
+		w := httptest.NewRecorder()
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unsupported_credential_type")
+	})
+	t.Run("success - batch credential", func(t *testing.T) {
+		svc, proofJSON := setup(t, false)
+
+		w := batchRequest(t, svc, `{"credential_requests":[`+
+			`{"format":"ldp_vc","type":"UniversityDegreeCredential","proof":`+proofJSON+`},`+
+			`{"format":"ldp_vc","type":"alumni_manifest","proof":`+proofJSON+`}]}`)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := &struct {
+			CredentialResponses []struct {
+				Format     string `json:"format"`
+				Credential struct {
+					Type []string `json:"type"`
+				} `json:"credential"`
+			} `json:"credential_responses"`
+			CNonce string `json:"c_nonce"`
+		}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.NotEmpty(t, resp.CNonce)
+		require.Len(t, resp.CredentialResponses, 2)
+		require.Contains(t, resp.CredentialResponses[0].Credential.Type, "UniversityDegreeCredential")
+		require.Contains(t, resp.CredentialResponses[1].Credential.Type, "AlumniCredential")
+	})
+	t.Run("success - deferred batch credential", func(t *testing.T) {
+		svc, proofJSON := setup(t, true)
+
+		w := batchRequest(t, svc, `{"credential_requests":[`+
+			`{"format":"ldp_vc","type":"UniversityDegreeCredential","proof":`+proofJSON+`},`+
+			`{"format":"ldp_vc","type":"AlumniCredential","proof":`+proofJSON+`}]}`)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := &struct {
+			CredentialResponses []struct {
+				AcceptanceToken string `json:"acceptance_token"`
+			} `json:"credential_responses"`
+		}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Len(t, resp.CredentialResponses, 2)
+
+		deferred, err := svc.getDeferredCredential(resp.CredentialResponses[1].AcceptanceToken)
+		require.NoError(t, err)
+		require.Equal(t, []string{"AlumniCredential"}, deferred.Types)
+	})
+	t.Run("failure - invalid batch credential request", func(t *testing.T) {
+		svc, proofJSON := setup(t, false)
+
+		for _, body := range []string{`{`, `{"credential_requests":[]}`, `{"credential_requests":[null]}`} {
+			w := batchRequest(t, svc, body)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), "invalid_request")
+		}
+
+		w := batchRequest(t, svc, `{"credential_requests":[{"format":"mso_mdoc","proof":`+proofJSON+`}]}`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unsupported format requested")
+
+		w = batchRequest(t, svc, `{"credential_requests":[{"format":"ldp_vc","type":"OtherCredential","proof":`+
+			proofJSON+`}]}`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "unsupported_credential_type")
+
+		w = batchRequest(t, svc, `{"credential_requests":[{"format":"ldp_vc","proof":`+proofJSON+`},`+
+			`{"format":"ldp_vc"}]}`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_or_missing_proof")
+	})
+	t.Run("failure - invalid batch access token", func(t *testing.T) {
+		svc, proofJSON := setup(t, false)
+
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceBatchCredential,
+			strings.NewReader(`{"credential_requests":[{"format":"ldp_vc","proof":`+proofJSON+`}]}`))
+		require.NoError(t, err)
+
+		req.Header.Set("Authorization", "Bearer testToken")
+		req = mux.SetURLVars(req, map[string]string{"id": "otherIssuer"})
+
+		w := httptest.NewRecorder()
+		svc.oidcBatchCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusForbidden, w.Code)
+		require.Contains(t, w.Body.String(), "invalid transaction")
+	})
+}
+
+func TestOIDCDeferredCredential(t *testing.T) {
+	const issuerID = "mockIssuer"
+
+	setup := func(t *testing.T) (*Operation, *kms.Key, string) {
+		t.Helper()
+
+		svc, err := New(&Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+		require.NoError(t, err)
+
+		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte(issuerID))
+		require.NoError(t, err)
+
+		holder, proofJSON := createCredentialProof(t, svc, issuerID, "testToken")
+
+		issuerConf, err := json.Marshal(&issuerConfiguration{
+			Issuer:                     "https://issuer/" + issuerID,
+			DeferredCredentialEndpoint: "https://issuer/" + issuerID + "/oidc/credential/deferred",
+		})
+		require.NoError(t, err)
+
+		err = svc.store.Put(issuerID, issuerConf)
+		require.NoError(t, err)
+
+		err = svc.store.Put(getCredStoreKeyPrefix(issuerID), []byte(testCredentialRequest))
+		require.NoError(t, err)
+
+		_, err = svc.keyManager.Create(issuerID, kms.Ed25519)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceCredential, nil)
+		require.NoError(t, err)
+
+		req.Form = url.Values{"format": {"ldp_vc"}, "proof": {proofJSON}}
+		req = mux.SetURLVars(req, map[string]string{"id": issuerID})
+		req.Header.Set("Authorization", "Bearer testToken")
+
+		w := httptest.NewRecorder()
+		svc.oidcCredentialEndpoint(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.NotContains(t, resp, "credential")
+		require.NotEmpty(t, resp["c_nonce"])
+		require.NotEmpty(t, resp["acceptance_token"])
+		require.Equal(t, resp["acceptance_token"], resp["transaction_id"])
+
+		return svc, holder, resp["acceptance_token"].(string)
+	}
+
+	pollDeferred := func(t *testing.T, svc *Operation, token string, body io.Reader) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceDeferredCredential, body)
+		require.NoError(t, err)
+
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+		req = mux.SetURLVars(req, map[string]string{"id": issuerID})
+
+		w := httptest.NewRecorder()
+		svc.oidcDeferredCredentialEndpoint(w, req)
+
+		return w
+	}
+
+	decide := func(t *testing.T, handler http.HandlerFunc, txID, body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodPost, oidcDeferredIssuanceApprove, strings.NewReader(body))
+		require.NoError(t, err)
+
+		req = mux.SetURLVars(req, map[string]string{"txID": txID})
+
+		w := httptest.NewRecorder()
+		handler(w, req)
+
+		return w
+	}
+
+	t.Run("success - approved", func(t *testing.T) {
+		svc, holder, txID := setup(t)
+
+		w := pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "issuance_pending")
+		require.Contains(t, w.Body.String(), `"interval": 5`)
+
+		w = decide(t, svc.approveDeferredIssuance, txID, "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		deferred := &deferredCredential{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), deferred))
+		require.Equal(t, deferredStatusApproved, deferred.Status)
+		require.Equal(t, holder.DID, deferred.Holder)
+
+		w = pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := &oidcCredentialTestResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, holder.DID, resp.Credential.CredentialSubject.ID)
+		require.Equal(t, "Ed25519Signature2018", resp.Credential.Proof["type"])
+
+		w = pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_transaction_id")
+	})
+	t.Run("success - approved with transaction id", func(t *testing.T) {
+		svc, _, txID := setup(t)
+
+		w := decide(t, svc.approveDeferredIssuance, txID, "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = pollDeferred(t, svc, "testToken", strings.NewReader(`{"transaction_id":"`+txID+`"}`))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, w.Body.String(), "credential")
+	})
+	t.Run("success - rejected", func(t *testing.T) {
+		svc, _, txID := setup(t)
+
+		w := decide(t, svc.rejectDeferredIssuance, txID, `{"reason":"documents expired"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		req, err := http.NewRequest(http.MethodGet, oidcDeferredIssuance, nil)
+		require.NoError(t, err)
+
+		w = httptest.NewRecorder()
+		svc.getDeferredIssuance(w, mux.SetURLVars(req, map[string]string{"txID": txID}))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		deferred := &deferredCredential{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), deferred))
+		require.Equal(t, deferredStatusRejected, deferred.Status)
+		require.Equal(t, "documents expired", deferred.Reason)
+
+		w = decide(t, svc.approveDeferredIssuance, txID, "")
+		require.Equal(t, http.StatusConflict, w.Code)
+		require.Contains(t, w.Body.String(), "deferred credential is already rejected")
+
+		w = pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "credential_request_denied")
+
+		w = pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_transaction_id")
+	})
+	t.Run("failure - invalid deferred credential request", func(t *testing.T) {
+		svc, _, txID := setup(t)
+
+		w := pollDeferred(t, svc, "", nil)
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_token")
+
+		w = pollDeferred(t, svc, "testToken", strings.NewReader(`{`))
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_request")
+
+		w = pollDeferred(t, svc, "invalidToken", strings.NewReader(`{"transaction_id":"`+txID+`"}`))
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_token")
+
+		w = pollDeferred(t, svc, "testToken", nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "invalid_transaction_id")
+	})
+	t.Run("failure - failed to issue deferred credential", func(t *testing.T) {
+		svc, _, txID := setup(t)
+
+		w := decide(t, svc.approveDeferredIssuance, txID, "")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		err := svc.store.Delete(getCredStoreKeyPrefix(issuerID))
+		require.NoError(t, err)
+
+		w = pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to get credential")
+		err = svc.store.Put(getCredStoreKeyPrefix(issuerID), []byte(testCredentialRequest))
+		require.NoError(t, err)
+
+		w = pollDeferred(t, svc, txID, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("failure - deferred issuance decision", func(t *testing.T) {
+		svc, _, txID := setup(t)
+
+		w := decide(t, svc.approveDeferredIssuance, "unknown", "")
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		w = decide(t, svc.rejectDeferredIssuance, txID, `{`)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "failed to decode request")
+
+		req, err := http.NewRequest(http.MethodGet, oidcDeferredIssuance, nil)
+		require.NoError(t, err)
+
+		w = httptest.NewRecorder()
+		svc.getDeferredIssuance(w, mux.SetURLVars(req, map[string]string{"txID": "unknown"}))
+		require.Equal(t, http.StatusNotFound, w.Code)
+
+		err = svc.store.Put(getDeferredCredentialKeyPrefix("invalid"), []byte("{"))
+		require.NoError(t, err)
+
+		w = decide(t, svc.approveDeferredIssuance, "invalid", "")
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed to read deferred credential")
+	})
+	t.Run("admin token", func(t *testing.T) {
+		requireAdminHandler(t, oidcDeferredIssuance, http.MethodGet, "/oidc/deferred/unknown")
+		requireAdminHandler(t, oidcDeferredIssuanceApprove, http.MethodPost, "/oidc/deferred/unknown/approve")
+		requireAdminHandler(t, oidcDeferredIssuanceReject, http.MethodPost, "/oidc/deferred/unknown/reject")
+	})
+}
+
+func TestOIDCJWKS(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		svc, err := New(&Config{
 			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
+
+		key, err := svc.keyManager.Create("mockIssuer", kms.P384)
+		require.NoError(t, err)
+
 		w := httptest.NewRecorder()
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceCredential,
-			strings.NewReader(`testing`))
+		req, err := http.NewRequest(http.MethodGet, oidcIssuanceJWKS, nil)
 		require.NoError(t, err)
 
-		req.Form = make(url.Values)
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
 
-		req.Header.Set("Authorization", "Bearer testToken")
+		svc.oidcJWKS(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
 
-		svc.oidcCredentialEndpoint(w, req)
-		require.Equal(t, http.StatusBadRequest, w.Code)
-		require.Contains(t, w.Body.String(), "unsupported format requested")
+		jwks := &jose.JSONWebKeySet{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), jwks))
+		require.Len(t, jwks.Keys, 1)
+		require.Equal(t, key.ID, jwks.Keys[0].KeyID)
+		require.True(t, jwks.Keys[0].IsPublic())
 	})
-	t.Run("failure - invalid transaction", func(t *testing.T) {
+	t.Run("error - key not found", func(t *testing.T) {
 		svc, err := New(&Config{
 			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
+
 		w := httptest.NewRecorder()
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceCredential,
-			strings.NewReader(`testing`))
+		req, err := http.NewRequest(http.MethodGet, oidcIssuanceJWKS, nil)
 		require.NoError(t, err)
 
-		req.Form = make(url.Values)
-
-		req.Header.Set("Authorization", "Bearer testToken")
-		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
-		require.NoError(t, err)
+		req = mux.SetURLVars(req, map[string]string{
+			"id": "mockIssuer",
+		})
 
-		svc.oidcCredentialEndpoint(w, req)
-		require.Equal(t, http.StatusForbidden, w.Code)
-		require.Contains(t, w.Body.String(), "invalid transaction")
+		svc.oidcJWKS(w, req)
+		require.Equal(t, http.StatusNotFound, w.Code)
+		require.Contains(t, w.Body.String(), "failed to get issuer public keys")
 	})
-	t.Run("failure - failed to get credential", func(t *testing.T) {
+}
+
+func TestOIDCRotateKey(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		svc, err := New(&Config{
 			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
+
+		key, err := svc.keyManager.Create("mockIssuer", kms.Ed25519)
+		require.NoError(t, err)
+
 		w := httptest.NewRecorder()
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceCredential,
-			strings.NewReader(`testing`))
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceRotateKey, nil)
 		require.NoError(t, err)
 
-		req.Form = make(url.Values)
-		req.Form["format"] = []string{"ldp_vc"}
 		req = mux.SetURLVars(req, map[string]string{
 			"id": "mockIssuer",
 		})
 
-		req.Header.Set("Authorization", "Bearer testToken")
-		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
-		require.NoError(t, err)
+		svc.oidcRotateKey(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
 
-		svc.oidcCredentialEndpoint(w, req)
-		require.Equal(t, http.StatusInternalServerError, w.Code)
-		require.Contains(t, w.Body.String(), "failed to get credential")
+		jwk := &jose.JSONWebKey{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), jwk))
+		require.NotEqual(t, key.ID, jwk.KeyID)
+
+		active, err := svc.keyManager.Get("mockIssuer")
+		require.NoError(t, err)
+		require.Equal(t, active.ID, jwk.KeyID)
 	})
-	t.Run("failure - failed to prepare credential", func(t *testing.T) {
+	t.Run("error - key not found", func(t *testing.T) {
 		svc, err := New(&Config{
 			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
+
 		w := httptest.NewRecorder()
-		req, err := http.NewRequest(http.MethodPost,
-			oidcIssuanceCredential,
-			strings.NewReader(`testing`))
+		req, err := http.NewRequest(http.MethodPost, oidcIssuanceRotateKey, nil)
 		require.NoError(t, err)
 
-		req.Form = make(url.Values)
-		req.Form["format"] = []string{"ldp_vc"}
 		req = mux.SetURLVars(req, map[string]string{
 			"id": "mockIssuer",
 		})
 
-		req.Header.Set("Authorization", "Bearer testToken")
-		err = svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte("mockIssuer"))
+		svc.oidcRotateKey(w, req)
+		require.Equal(t, http.StatusNotFound, w.Code)
+		require.Contains(t, w.Body.String(), "failed to rotate issuer signing key")
+	})
+	t.Run("admin token", func(t *testing.T) {
+		svc, handler := getHandlerWithOps(t, oidcIssuanceRotateKey, &Config{
+			StoreProvider: memstore.NewProvider(),
+			AdminToken:    "admin-token",
+		})
+
+		_, err := svc.keyManager.Create("mockIssuer", kms.Ed25519)
 		require.NoError(t, err)
 
-		err = svc.store.Put(getCredStoreKeyPrefix("mockIssuer"), []byte(`{
-			"@context": [
-	"https://www.w3.org/2018/credentials/v1",
-		"https://www.w3.org/2018/credentials/examples/v1"
-],}`))
+		_, status, err := handleRequest(handler, nil, "/mockIssuer/keys/rotate", false)
 		require.NoError(t, err)
-		svc.oidcCredentialEndpoint(w, req)
-		require.Equal(t, http.StatusInternalServerError, w.Code)
-		require.Contains(t, w.Body.String(), "failed to prepare credential")
+		require.Equal(t, http.StatusUnauthorized, status)
+
+		_, status, err = handleRequest(handler, map[string]string{"Authorization": "Bearer admin-token"},
+			"/mockIssuer/keys/rotate", false)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+	})
+	t.Run("error - admin api disabled", func(t *testing.T) {
+		handler := getHandlerWithConfig(t, oidcIssuanceRotateKey, &Config{
+			StoreProvider: memstore.NewProvider(),
+		})
+
+		_, status, err := handleRequest(handler, map[string]string{"Authorization": "Bearer admin-token"},
+			"/mockIssuer/keys/rotate", false)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, status)
 	})
 }
 
@@ -1218,10 +2951,139 @@ func TestSearch(t *testing.T) {
 	})
 }
 
+func TestSearchSubjectData(t *testing.T) {
+	cms := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/permanentresidentcards" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		fmt.Fprint(w, `[
+			{"userid":"1","givenName":"Foo","familyName":"Bar","birthDate":"1980-01-01"},
+			{"userid":"2","givenName":"Foo","familyName":"Baz","birthDate":"1990-01-01"},
+			{"userid":"3","givenName":"Qux","familyName":"Bar","birthDate":"1985-01-01"}
+		]`)
+	}))
+	defer cms.Close()
+
+	svc, err := New(&Config{
+		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
+		StoreProvider: memstore.NewProvider(),
+		CMSURL:        cms.URL,
+	})
+	require.NoError(t, err)
+
+	search := func(t *testing.T, searchReq interface{}) *httptest.ResponseRecorder {
+		t.Helper()
+
+		reqBytes, err := json.Marshal(searchReq)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, searchPath, bytes.NewReader(reqBytes))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.searchSubjectData(rr, req)
+
+		return rr
+	}
+
+	t.Run("success", func(t *testing.T) {
+		rr := search(t, &searchReq{
+			Scope: "PermanentResidentCard",
+			Filters: []subjectdata.Filter{
+				{Field: "givenName", Op: subjectdata.OpContains, Value: "foo"},
+				{Field: "birthDate", Op: subjectdata.OpGreater, Value: "1979-12-31"},
+			},
+			Fields: []string{"familyName"},
+			Offset: 1,
+			Limit:  1,
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var resp searchResp
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Equal(t, 2, resp.Total)
+		require.Equal(t, 1, resp.Offset)
+		require.Equal(t, 1, resp.Limit)
+		require.Len(t, resp.Results, 1)
+		require.Equal(t, "2", resp.Results[0].UserID)
+		require.Equal(t, map[string]interface{}{"familyName": "Baz"}, resp.Results[0].Data)
+
+		sData, err := svc.getSearchData(resp.Results[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, "PermanentResidentCard", sData.Scope)
+		require.Equal(t, "1990-01-01", sData.UserData["birthDate"])
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		rr := search(t, &searchReq{Collection: "permanentresidentcards"})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var resp searchResp
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		require.Equal(t, 3, resp.Total)
+		require.Equal(t, subjectdata.DefaultSearchLimit, resp.Limit)
+		require.Len(t, resp.Results, 3)
+	})
+
+	t.Run("admin token", func(t *testing.T) {
+		requireAdminHandler(t, searchPath, http.MethodPost, searchPath)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, searchPath, strings.NewReader("invalid-json"))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.searchSubjectData(rr, req)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to decode request")
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		rr := search(t, &searchReq{Scope: "PermanentResidentCard", Limit: subjectdata.MaxSearchLimit + 1})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "invalid query")
+	})
+
+	t.Run("cms error", func(t *testing.T) {
+		rr := search(t, &searchReq{Scope: "StudentCard"})
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to search subject data")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		s, err := New(&Config{
+			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
+			StoreProvider: &mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("put error")}},
+			CMSURL:        cms.URL,
+		})
+		require.NoError(t, err)
+
+		reqBytes, err := json.Marshal(&searchReq{Scope: "PermanentResidentCard"})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, searchPath, bytes.NewReader(reqBytes))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		s.searchSubjectData(rr, req)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to save search result")
+	})
+}
+
 func TestOperation_settings(t *testing.T) {
+	vcs := newProfileVCS(t)
+
 	cfg := &Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(), VCSURL: vcs.URL,
 	}
 	handler := getHandlerWithConfig(t, settings, cfg)
 
@@ -1234,13 +3096,94 @@ func TestOperation_settings(t *testing.T) {
 	require.NoError(t, err)
 	require.Contains(t, buff.String(), "Temporary Redirect")
 	require.Equal(t, http.StatusTemporaryRedirect, status)
+
+	buff, status, err = handleRequest(handler, nil, settings+"?scope=test&vcsProfile=unknown", false)
+	require.NoError(t, err)
+	require.Contains(t, buff.String(), "invalid vcs profile")
+	require.Equal(t, http.StatusBadRequest, status)
+
+	buff, status, err = handleRequest(handler, nil, settings+"?scope=test&vcsProfile=error", false)
+	require.NoError(t, err)
+	require.Contains(t, buff.String(), "invalid vcs profile")
+	require.Equal(t, http.StatusInternalServerError, status)
+}
+
+func TestOperation_VCSProfiles(t *testing.T) {
+	vcs := newProfileVCS(t)
+
+	file := filepath.Join(t.TempDir(), "profiles.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"local":{"did":"did:example:local"}}`), 0o600))
+
+	svc, err := New(&Config{
+		StoreProvider: memstore.NewProvider(), VCSURL: vcs.URL, VCSProfilesFile: file,
+		VCSProfiles: []string{"vc-issuer-1", "unknown"}, VCSProfileCacheTTL: time.Minute,
+	})
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	svc.listVCSProfiles(rr, httptest.NewRequest(http.MethodGet, vcsProfilesPath, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `[
+		{"name":"local","did":"did:example:local","source":"override"},
+		{"name":"vc-issuer-1","did":"did:example:vc-issuer-1","source":"vcs"}
+	]`, rr.Body.String())
+
+	// the profile is cached until invalidated
+	_, err = svc.retrieveProfile("vc-issuer-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(vcs.requests))
+
+	rr = httptest.NewRecorder()
+	svc.invalidateVCSProfiles(rr, httptest.NewRequest(http.MethodDelete, vcsProfilesCachePath+"?name=vc-issuer-1", nil))
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	_, err = svc.retrieveProfile("vc-issuer-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(vcs.requests))
+
+	profile, err := svc.retrieveProfile("local")
+	require.NoError(t, err)
+	require.Equal(t, "did:example:local", profile.DID)
+	requireAdminHandler(t, vcsProfilesCachePath, http.MethodDelete, vcsProfilesCachePath)
+}
+
+type profileVCS struct {
+	*httptest.Server
+	requests *int32
+}
+
+// newProfileVCS returns the VCS serving the profiles vc-issuer-1 and vc-issuer-2, and failing for the profile error.
+func newProfileVCS(t *testing.T) *profileVCS {
+	t.Helper()
+
+	requests := new(int32)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/profile/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+
+		switch id {
+		case "vc-issuer-1", "vc-issuer-2":
+			atomic.AddInt32(requests, 1)
+			fmt.Fprintf(w, `{"name":%q,"did":"did:example:%s"}`, id, id)
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	return &profileVCS{Server: srv, requests: requests}
 }
 
 func TestOperation_Login3(t *testing.T) {
 	cfg := &Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 		ExtTokenIssuer: &mockTokenIssuer{},
-		StoreProvider:  &mockstorage.Provider{},
+		StoreProvider:  memstore.NewProvider(),
 	}
 	handler := getHandlerWithConfig(t, login, cfg)
 
@@ -1261,7 +3204,7 @@ func TestOperation_Login3(t *testing.T) {
 
 func TestOperation_initDIDCommConnection(t *testing.T) {
 	cfg := &Config{
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	}
 	handler := getHandlerWithConfig(t, didcommInit, cfg)
 
@@ -1294,7 +3237,7 @@ func TestOperation_getTokenInfo(t *testing.T) {
 	req, err := http.NewRequest(http.MethodGet, "", nil)
 	require.NoError(t, err)
 
-	op, err := New(&Config{StoreProvider: &mockstorage.Provider{}})
+	op, err := New(&Config{StoreProvider: memstore.NewProvider()})
 	require.NoError(t, err)
 
 	t.Run("success", func(t *testing.T) {
@@ -1375,7 +3318,7 @@ func TestOperation_getIDHandler(t *testing.T) {
 
 	cfg := Config{
 		CMSURL:        cms.URL,
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 		TokenResolver: &mockTokenResolver{
 			info: token.Introspection{
 				Active:  true,
@@ -1416,7 +3359,7 @@ func TestOperation_getIDHandler(t *testing.T) {
 
 		cfg2 := Config{
 			CMSURL:        cms2.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active:  true,
@@ -1461,7 +3404,7 @@ func TestOperation_getCredUsingToken(t *testing.T) {
 
 	cfg := Config{
 		CMSURL:        cms.URL,
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 		TokenResolver: &mockTokenResolver{
 			info: token.Introspection{
 				Active:  true,
@@ -1492,7 +3435,7 @@ func TestOperation_getCredUsingToken(t *testing.T) {
 	t.Run("failure: invalid token", func(t *testing.T) {
 		handler := getHandlerWithConfig(t, didcommCredential, &Config{
 			CMSURL:        cms.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active: false,
@@ -1515,7 +3458,7 @@ func TestOperation_getCredUsingToken(t *testing.T) {
 	t.Run("failure - token does not have valid cred scope", func(t *testing.T) {
 		handler := getHandlerWithConfig(t, didcommCredential, &Config{
 			CMSURL:        cms.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active:  true,
@@ -1544,7 +3487,7 @@ func TestOperation_getCredUsingToken(t *testing.T) {
 
 		handler := getHandlerWithConfig(t, didcommCredential, &Config{
 			CMSURL:        cms2.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active:  true,
@@ -1577,7 +3520,7 @@ func TestOperation_getAssuranceUsingToken(t *testing.T) {
 
 	cfg := Config{
 		CMSURL:        cms.URL,
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 		TokenResolver: &mockTokenResolver{
 			info: token.Introspection{
 				Active:  true,
@@ -1611,7 +3554,7 @@ func TestOperation_getAssuranceUsingToken(t *testing.T) {
 	t.Run("failure: invalid token", func(t *testing.T) {
 		handler := getHandlerWithConfig(t, didcommAssuranceData, &Config{
 			CMSURL:        cms.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active: false,
@@ -1643,7 +3586,7 @@ func TestOperation_getAssuranceUsingToken(t *testing.T) {
 
 		handler := getHandlerWithConfig(t, didcommAssuranceData, &Config{
 			CMSURL:        cms2.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active:  true,
@@ -1672,7 +3615,7 @@ func TestOperation_getAssuranceUsingToken(t *testing.T) {
 	t.Run("failure - token does not have valid cred scope", func(t *testing.T) {
 		handler := getHandlerWithConfig(t, didcommAssuranceData, &Config{
 			CMSURL:        cms.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active:  true,
@@ -1706,7 +3649,7 @@ func TestOperation_getAssuranceUsingToken(t *testing.T) {
 
 		handler := getHandlerWithConfig(t, didcommAssuranceData, &Config{
 			CMSURL:        cms2.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 			TokenResolver: &mockTokenResolver{
 				info: token.Introspection{
 					Active:  true,
@@ -1766,7 +3709,7 @@ func TestOperation_Callback(t *testing.T) {
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: vcs.URL, ReceiveVCHTML: file.Name(),
 			DIDAuthHTML:   file.Name(),
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		}
 		handler := getHandlerWithConfig(t, callback, cfg)
 
@@ -1782,7 +3725,7 @@ func TestOperation_Callback(t *testing.T) {
 		cfg = &Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: vcs.URL, ReceiveVCHTML: file.Name(), DIDAuthHTML: file.Name(),
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		}
 		handler = getHandlerWithConfig(t, callback, cfg)
 
@@ -1795,7 +3738,7 @@ func TestOperation_Callback(t *testing.T) {
 		cfg = &Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: vcs.URL, ReceiveVCHTML: "",
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		}
 		handler = getHandlerWithConfig(t, callback, cfg)
 
@@ -1818,7 +3761,7 @@ func TestOperation_Callback(t *testing.T) {
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: httptest.NewServer(r).URL, ReceiveVCHTML: file.Name(),
 			DIDAuthHTML:   file.Name(),
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		}
 		handler = getHandlerWithConfig(t, callback, cfg)
 
@@ -1838,7 +3781,7 @@ func TestOperation_Callback(t *testing.T) {
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: httptest.NewServer(cmsRouter).URL, VCSURL: httptest.NewServer(r).URL, ReceiveVCHTML: file.Name(),
 			DIDAuthHTML:   file.Name(),
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		}
 		handler = getHandlerWithConfig(t, callback, cfg)
 
@@ -1945,7 +3888,7 @@ func TestOperation_GenerateVC(t *testing.T) {
 		cfg := &Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: vcs.URL, ReceiveVCHTML: file.Name(),
-			StoreProvider:  &mockstorage.Provider{},
+			StoreProvider:  memstore.NewProvider(),
 			DocumentLoader: createTestDocumentLoader(t),
 		}
 
@@ -1955,6 +3898,8 @@ func TestOperation_GenerateVC(t *testing.T) {
 
 		rr := httptest.NewRecorder()
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		req := &http.Request{Form: make(map[string][]string)}
 		req.Header = make(map[string][]string)
 		req.Form.Add("cred", testCredentialRequest)
@@ -1970,10 +3915,12 @@ func TestOperation_GenerateVC(t *testing.T) {
 	})
 
 	t.Run("generate VC - validations", func(t *testing.T) {
-		svc, err := New(&Config{StoreProvider: &mockstorage.Provider{}, DocumentLoader: createTestDocumentLoader(t)})
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider(), DocumentLoader: createTestDocumentLoader(t)})
 		require.NotNil(t, svc)
 		require.NoError(t, err)
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		rr := httptest.NewRecorder()
 		m := make(map[string][]string)
 		req := &http.Request{Form: m}
@@ -2024,7 +3971,7 @@ func TestOperation_GenerateVC(t *testing.T) {
 		req.Form.Set("challenge", challenge)
 		svc.generateVC(rr, req)
 		require.Equal(t, http.StatusInternalServerError, rr.Code)
-		require.Contains(t, rr.Body.String(), "DID Auth failed: invalid auth response, invalid holder proof")
+		require.Contains(t, rr.Body.String(), "DID Auth failed: invalid auth response")
 
 		rr = httptest.NewRecorder()
 		req.AddCookie(&http.Cookie{Name: vcsProfileCookie, Value: "vc-issuer-1"})
@@ -2056,6 +4003,17 @@ func TestOperation_GenerateVC(t *testing.T) {
 
 		rr = httptest.NewRecorder()
 		req.AddCookie(&http.Cookie{Name: vcsProfileCookie, Value: "vc-issuer-1"})
+		svc.generateVC(rr, req)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "DID Auth failed: invalid or expired challenge")
+
+		holder, authResp, challenge = newDIDAuth(t, svc)
+
+		rr = httptest.NewRecorder()
+		req.AddCookie(&http.Cookie{Name: vcsProfileCookie, Value: "vc-issuer-1"})
+		req.Form.Set("holder", holder)
+		req.Form.Set("authresp", authResp)
+		req.Form.Set("challenge", challenge)
 		req.Form.Set("cred", testCredentialRequest)
 		svc.generateVC(rr, req)
 		require.Equal(t, http.StatusInternalServerError, rr.Code)
@@ -2063,17 +4021,34 @@ func TestOperation_GenerateVC(t *testing.T) {
 	})
 
 	t.Run("Validate Auth Resp - validations", func(t *testing.T) {
-		svc, err := New(&Config{StoreProvider: &mockstorage.Provider{}, DocumentLoader: createTestDocumentLoader(t)})
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider(), DocumentLoader: createTestDocumentLoader(t)})
 		require.NotNil(t, svc)
 		require.NoError(t, err)
 
-		err = svc.validateAuthResp([]byte(authRespWithoutChallenge), holder, domain, "")
+		holder, authResp := signDIDAuth(t, "", domain)
+
+		err = svc.validateAuthResp([]byte(authResp), holder, domain, "")
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "invalid auth response proof, missing challenge")
 
-		err = svc.validateAuthResp([]byte(authRespWithoutDomain), holder, domain, "")
+		holder, authResp = signDIDAuth(t, "challenge", "")
+
+		err = svc.validateAuthResp([]byte(authResp), holder, domain, "challenge")
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "invalid auth response proof, missing domain")
+
+		holder, authResp = signDIDAuth(t, "client-challenge", domain)
+
+		err = svc.validateAuthResp([]byte(authResp), holder, domain, "client-challenge")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid or expired challenge")
+
+		holder, authResp, _ = newDIDAuth(t, svc)
+
+		err = svc.validateAuthResp([]byte(strings.Replace(authResp, `"proofValue":"`, `"proofValue":"z`, 1)),
+			holder, domain, "challenge")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid auth response")
 	})
 
 	t.Run("generate VC - store error", func(t *testing.T) {
@@ -2115,7 +4090,7 @@ func TestOperation_GenerateVC(t *testing.T) {
 		cfg := &Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: vcs.URL, ReceiveVCHTML: file.Name(),
-			StoreProvider:  &mockstorage.Provider{},
+			StoreProvider:  memstore.NewProvider(),
 			DocumentLoader: createTestDocumentLoader(t),
 		}
 
@@ -2125,6 +4100,8 @@ func TestOperation_GenerateVC(t *testing.T) {
 
 		rr := httptest.NewRecorder()
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		req := &http.Request{Form: make(map[string][]string)}
 		req.Header = make(map[string][]string)
 		req.Form.Add("cred", testCredentialRequest)
@@ -2174,7 +4151,7 @@ func TestOperation_GenerateVC(t *testing.T) {
 		cfg := &Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			CMSURL: cms.URL, VCSURL: vcs.URL,
-			StoreProvider:  &mockstorage.Provider{},
+			StoreProvider:  memstore.NewProvider(),
 			DocumentLoader: createTestDocumentLoader(t),
 		}
 
@@ -2184,6 +4161,8 @@ func TestOperation_GenerateVC(t *testing.T) {
 
 		rr := httptest.NewRecorder()
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		req := &http.Request{Form: make(map[string][]string)}
 		req.Header = make(map[string][]string)
 		req.Form.Add("cred", testCredentialRequest)
@@ -2213,6 +4192,54 @@ func (p *mockLDStoreProvider) JSONLDRemoteProviderStore() ldstore.RemoteProvider
 	return p.RemoteProviderStore
 }
 
+// newDIDAuth issues new DID Auth challenge of the service and returns the holder, its DID Auth response and the
+// challenge.
+func newDIDAuth(t *testing.T, svc *Operation) (string, string, string) {
+	t.Helper()
+
+	challenge, err := svc.didAuth.IssueChallenge(domain)
+	require.NoError(t, err)
+
+	holder, authResp := signDIDAuth(t, challenge, domain)
+
+	return holder, authResp, challenge
+}
+
+// signDIDAuth returns new did:key holder and its DID Auth response for the challenge and the domain.
+func signDIDAuth(t *testing.T, challenge, domain string) (string, string) {
+	t.Helper()
+
+	keyManager, err := kms.New(memstore.NewProvider())
+	require.NoError(t, err)
+
+	key, err := keyManager.Create("holder", kms.Ed25519)
+	require.NoError(t, err)
+
+	vp, err := verifiable.NewPresentation()
+	require.NoError(t, err)
+
+	vp.Holder = key.DID
+
+	created := time.Now()
+
+	err = vp.AddLinkedDataProof(&verifiable.LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: verifiable.SignatureProofValue,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(key.Signer())),
+		VerificationMethod:      key.ID,
+		Purpose:                 "authentication",
+		Challenge:               challenge,
+		Domain:                  domain,
+		Created:                 &created,
+	}, jsonld.WithDocumentLoader(createTestDocumentLoader(t)))
+	require.NoError(t, err)
+
+	authResp, err := json.Marshal(vp)
+	require.NoError(t, err)
+
+	return key.DID, string(authResp)
+}
+
 func createTestDocumentLoader(t *testing.T) *ld.DocumentLoader {
 	t.Helper()
 
@@ -2235,6 +4262,10 @@ func createTestDocumentLoader(t *testing.T) *ld.DocumentLoader {
 				URL:     "https://trustbloc.github.io/context/vc/examples-ext-v1.jsonld",
 				Content: examplesExtV1Context,
 			},
+			ldcontext.Document{
+				URL:     credtemplate.CitizenshipContext,
+				Content: citizenshipV1Context,
+			},
 		),
 	)
 	require.NoError(t, err)
@@ -2246,7 +4277,7 @@ func TestOperation_Callback_ExchangeCodeError(t *testing.T) {
 	svc, err := New(&Config{
 		TokenIssuer:   &mockTokenIssuer{err: errors.New("exchange code error")},
 		TokenResolver: &mockTokenResolver{},
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	})
 	require.NotNil(t, svc)
 	require.NoError(t, err)
@@ -2267,7 +4298,7 @@ func TestOperation_Callback_TokenIntrospectionError(t *testing.T) {
 	svc, err := New(&Config{
 		TokenIssuer:   &mockTokenIssuer{},
 		TokenResolver: &mockTokenResolver{err: errors.New("token info error")},
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	})
 	require.NoError(t, err)
 	require.NotNil(t, svc)
@@ -2287,7 +4318,7 @@ func TestOperation_Callback_GetCMSData_Error(t *testing.T) {
 	cfg := &Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 		CMSURL:        "cms",
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	}
 	handler := getHandlerWithConfig(t, callback, cfg)
 
@@ -2309,7 +4340,7 @@ func TestOperation_Callback_CreateCredential_Error(t *testing.T) {
 	cfg := &Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 		CMSURL: cms.URL, VCSURL: "vcs",
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	}
 	handler := getHandlerWithConfig(t, callback, cfg)
 
@@ -2328,7 +4359,7 @@ func TestOperation_StoreCredential(t *testing.T) {
 		defer vcs.Close()
 		svc, err := New(&Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{}, VCSURL: vcs.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
 
@@ -2339,7 +4370,7 @@ func TestOperation_StoreCredential(t *testing.T) {
 		svc, err := New(&Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			VCSURL:        "%%&^$",
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
 
@@ -2355,7 +4386,7 @@ func TestOperation_StoreCredential(t *testing.T) {
 		defer vcs.Close()
 		svc, err := New(&Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{}, VCSURL: vcs.URL,
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		})
 		require.NoError(t, err)
 
@@ -2369,27 +4400,69 @@ func TestOperation_GetCMSData_InvalidURL(t *testing.T) {
 	svc, err := New(&Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 		CMSURL:        "xyz:cms",
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	})
 	require.NotNil(t, svc)
 	require.NoError(t, err)
 
-	_, data, err := svc.getCMSData(&oauth2.Token{}, "", "")
+	_, data, err := svc.getCMSData(&oauth2.Token{}, "", "", "")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unsupported protocol scheme")
 	require.Nil(t, data)
 }
 
+func TestOperation_GetCMSData_SubjectDataSources(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "users.json"),
+		[]byte(`[{"userid":"100","email":"foo@bar.com"}]`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "studentcards.csv"),
+		[]byte("userid,university\n100,Faber College\n"), 0o600))
+
+	sourcesPath := filepath.Join(t.TempDir(), "sources.json")
+	require.NoError(t, os.WriteFile(sourcesPath,
+		[]byte(`{"sources":{"files":{"static":{"dir":"`+dir+`"}}},"scopes":{"StudentCard":"files"}}`), 0o600))
+
+	t.Run("test success", func(t *testing.T) {
+		svc, err := New(&Config{
+			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
+			CMSURL:                 "xyz:cms",
+			StoreProvider:          memstore.NewProvider(),
+			SubjectDataSourcesPath: sourcesPath,
+		})
+		require.NoError(t, err)
+
+		userID, data, err := svc.getCMSData(&oauth2.Token{}, "email", "foo@bar.com", "StudentCard")
+		require.NoError(t, err)
+		require.Equal(t, "100", userID)
+		require.Equal(t, "Faber College", data["university"])
+
+		_, _, err = svc.getCMSData(&oauth2.Token{}, "email", "foo@bar.com", "PermanentResidentCard")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported protocol scheme")
+	})
+	t.Run("invalid subject data sources", func(t *testing.T) {
+		svc, err := New(&Config{
+			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
+			StoreProvider:          memstore.NewProvider(),
+			SubjectDataSourcesPath: filepath.Join(dir, "missing.json"),
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "issuer subject data sources")
+		require.Nil(t, svc)
+	})
+}
+
 func TestOperation_GetCMSData_InvalidHTTPRequest(t *testing.T) {
 	svc, err := New(&Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 		CMSURL:        "http://cms\\",
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	})
 	require.NotNil(t, svc)
 	require.NoError(t, err)
 
-	userID, data, err := svc.getCMSData(&oauth2.Token{}, "", "")
+	userID, data, err := svc.getCMSData(&oauth2.Token{}, "", "", "")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "invalid character")
 	require.Nil(t, data)
@@ -2399,7 +4472,7 @@ func TestOperation_GetCMSData_InvalidHTTPRequest(t *testing.T) {
 func TestOperation_CreateCredential_Errors(t *testing.T) {
 	cfg := &Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
-		StoreProvider:  &mockstorage.Provider{},
+		StoreProvider:  memstore.NewProvider(),
 		DocumentLoader: createTestDocumentLoader(t),
 	}
 
@@ -2412,6 +4485,8 @@ func TestOperation_CreateCredential_Errors(t *testing.T) {
 		require.NotNil(t, svc)
 		require.NoError(t, err)
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		data, err := svc.createCredential(testCredentialRequest, authResp, holder, domain, challenge, "")
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "unsupported protocol scheme")
@@ -2423,6 +4498,8 @@ func TestOperation_CreateCredential_Errors(t *testing.T) {
 		require.NotNil(t, svc)
 		require.NoError(t, err)
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		data, err := svc.createCredential(testCredentialRequest, authResp, holder, domain, challenge, "")
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "invalid character")
@@ -2433,6 +4510,8 @@ func TestOperation_CreateCredential_Errors(t *testing.T) {
 		require.NotNil(t, svc)
 		require.NoError(t, err)
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		data, err := svc.createCredential(testCredentialRequest+",", authResp, holder, domain, challenge, "")
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "invalid character")
@@ -2443,7 +4522,7 @@ func TestOperation_CreateCredential_Errors(t *testing.T) {
 func TestOperation_GetCMSUser(t *testing.T) {
 	cfg := &Config{
 		TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
-		StoreProvider: &mockstorage.Provider{},
+		StoreProvider: memstore.NewProvider(),
 	}
 
 	t.Run("test success", func(t *testing.T) {
@@ -2458,7 +4537,7 @@ func TestOperation_GetCMSUser(t *testing.T) {
 		require.NotNil(t, svc)
 		require.NoError(t, err)
 
-		userID, data, err := svc.getCMSData(&oauth2.Token{}, "", "")
+		userID, data, err := svc.getCMSData(&oauth2.Token{}, "", "", "")
 		require.NoError(t, err)
 		require.Equal(t, data["email"], "foo@bar.com")
 		require.NotEmpty(t, userID)
@@ -2475,7 +4554,7 @@ func TestOperation_GetCMSUser(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, svc)
 
-		userID, data, err := svc.getCMSData(&oauth2.Token{}, "", "")
+		userID, data, err := svc.getCMSData(&oauth2.Token{}, "", "", "")
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "user not found")
 		require.Nil(t, data)
@@ -2483,58 +4562,6 @@ func TestOperation_GetCMSUser(t *testing.T) {
 	})
 }
 
-func TestOperation_UnmarshalUser(t *testing.T) {
-	t.Run("test success", func(t *testing.T) {
-		user, err := unmarshalUser([]byte(fmt.Sprintf("[%s]", foo)))
-		require.NoError(t, err)
-		require.Equal(t, user.Email, "foo@bar.com")
-	})
-	t.Run("json unmarshal error", func(t *testing.T) {
-		data, err := unmarshalUser([]byte("invalid"))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "invalid character")
-		require.Nil(t, data)
-	})
-	t.Run("user not found", func(t *testing.T) {
-		data, err := unmarshalUser([]byte("[]"))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "user not found")
-		require.Nil(t, data)
-	})
-	t.Run("multiple users error", func(t *testing.T) {
-		data, err := unmarshalUser([]byte("[{},{}]"))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "multiple users found")
-		require.Nil(t, data)
-	})
-}
-
-func TestOperation_UnmarshalSubject(t *testing.T) {
-	t.Run("test success", func(t *testing.T) {
-		data, err := unmarshalSubject([]byte(`[{"email":"foo@bar.com"}]`))
-		require.NoError(t, err)
-		require.Equal(t, data["email"], "foo@bar.com")
-	})
-	t.Run("json unmarshal error", func(t *testing.T) {
-		data, err := unmarshalSubject([]byte("invalid"))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "invalid character")
-		require.Nil(t, data)
-	})
-	t.Run("record not found", func(t *testing.T) {
-		data, err := unmarshalSubject([]byte("[]"))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "record not found")
-		require.Nil(t, data)
-	})
-	t.Run("multiple records error", func(t *testing.T) {
-		data, err := unmarshalSubject([]byte("[{},{}]"))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "multiple records found")
-		require.Nil(t, data)
-	})
-}
-
 func TestOperation_SendHTTPRequest_WrongStatus(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintln(w, "{}")
@@ -2771,7 +4798,7 @@ func TestHandleOIDCCallback(t *testing.T) {
 
 func TestRevokeVC(t *testing.T) {
 	t.Run("test error from parse form", func(t *testing.T) {
-		svc, err := New(&Config{StoreProvider: &mockstorage.Provider{}})
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
 		require.NoError(t, err)
 
 		rr := httptest.NewRecorder()
@@ -2783,7 +4810,7 @@ func TestRevokeVC(t *testing.T) {
 	t.Run("test error from parse presentation", func(t *testing.T) {
 		svc, err := New(&Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
-			StoreProvider: &mockstorage.Provider{},
+			StoreProvider: memstore.NewProvider(),
 		})
 		require.NotNil(t, svc)
 		require.NoError(t, err)
@@ -2800,7 +4827,7 @@ func TestRevokeVC(t *testing.T) {
 		svc, err := New(&Config{
 			TokenIssuer: &mockTokenIssuer{}, TokenResolver: &mockTokenResolver{},
 			VCSURL:         "http://vcs\\",
-			StoreProvider:  &mockstorage.Provider{},
+			StoreProvider:  memstore.NewProvider(),
 			DocumentLoader: createTestDocumentLoader(t),
 		})
 		require.NotNil(t, svc)
@@ -2811,59 +4838,490 @@ func TestRevokeVC(t *testing.T) {
 		m["vcDataInput"] = []string{validVP}
 		svc.revokeVC(rr, &http.Request{Form: m})
 		require.Equal(t, http.StatusInternalServerError, rr.Code)
-		require.Contains(t, rr.Body.String(), "failed to create new http request")
+		require.Contains(t, rr.Body.String(), "failed to create new http request")
+	})
+
+	t.Run("test error from http post", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider(), DocumentLoader: createTestDocumentLoader(t)})
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		m := make(map[string][]string)
+		m["vcDataInput"] = []string{validVP}
+		svc.revokeVC(rr, &http.Request{Form: m})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to update vc status")
+	})
+
+	t.Run("test vc html not exist", func(t *testing.T) {
+		serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer serv.Close()
+
+		svc, err := New(&Config{VCHTML: "", VCSURL: serv.URL, StoreProvider: memstore.NewProvider(),
+			DocumentLoader: createTestDocumentLoader(t)})
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		m := make(map[string][]string)
+		m["vcDataInput"] = []string{validVP}
+		svc.revokeVC(rr, &http.Request{Form: m})
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "unable to load html")
+	})
+
+	t.Run("test success", func(t *testing.T) {
+		file, err := os.CreateTemp("", "*.html")
+		require.NoError(t, err)
+
+		defer func() { require.NoError(t, os.Remove(file.Name())) }()
+		serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer serv.Close()
+
+		svc, err := New(&Config{VCHTML: file.Name(), VCSURL: serv.URL, StoreProvider: memstore.NewProvider(),
+			DocumentLoader: createTestDocumentLoader(t)})
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		m := make(map[string][]string)
+		m["vcDataInput"] = []string{validVP}
+
+		svc.revokeVC(rr, &http.Request{Form: m})
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestRevokeVCIssuerName(t *testing.T) {
+	svc, err := New(&Config{StoreProvider: memstore.NewProvider(), DocumentLoader: createTestDocumentLoader(t)})
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	m := make(map[string][]string)
+	m["vcDataInput"] = []string{strings.Replace(validVP, `"name": "Example University"`, `"name": ""`, 1)}
+	svc.revokeVC(rr, &http.Request{Form: m})
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "failed to update vc status: issuer name is missing")
+}
+
+func TestCredentialStatus(t *testing.T) { //nolint: gocognit
+	const issuerID = "mockIssuer"
+
+	svc, err := New(&Config{
+		StoreProvider:  memstore.NewProvider(),
+		DocumentLoader: createTestDocumentLoader(t),
+		StatusListSize: 16,
+	})
+	require.NoError(t, err)
+
+	key, err := svc.keyManager.Create(issuerID, kms.Ed25519)
+	require.NoError(t, err)
+
+	issuerConf, err := json.Marshal(&issuerConfiguration{Issuer: "https://issuer/" + issuerID})
+	require.NoError(t, err)
+	require.NoError(t, svc.store.Put(issuerID, issuerConf))
+
+	issue := func(t *testing.T) (*verifiable.Credential, *statuslist.Entry) {
+		t.Helper()
+
+		vc, e := verifiable.ParseCredential([]byte(testCredentialRequest),
+			verifiable.WithJSONLDDocumentLoader(svc.documentLoader))
+		require.NoError(t, e)
+
+		entry, e := svc.assignCredentialStatus(issuerID, vc)
+		require.NoError(t, e)
+
+		return vc, entry
+	}
+
+	t.Run("assign credential status", func(t *testing.T) {
+		vc, entry := issue(t)
+		require.Equal(t, statuslist.StatusList2021Entry, vc.Status.Type)
+		require.Equal(t, "revocation", vc.Status.CustomFields["statusPurpose"])
+		require.Equal(t, fmt.Sprintf("https://issuer/%s/status/%s", issuerID, entry.ListID),
+			vc.Status.CustomFields["statusListCredential"])
+		require.Contains(t, vc.Context, statuslist.StatusList2021Context)
+
+		_, next := issue(t)
+		require.Equal(t, entry.ListID, next.ListID)
+		require.Equal(t, entry.Index+1, next.Index)
+	})
+
+	t.Run("assign credential status - missing issuer configuration", func(t *testing.T) {
+		vc, err := verifiable.ParseCredential([]byte(testCredentialRequest),
+			verifiable.WithJSONLDDocumentLoader(svc.documentLoader))
+		require.NoError(t, err)
+
+		_, err = svc.assignCredentialStatus("unknown", vc)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to read issuer configuration")
+	})
+
+	t.Run("publish status list credential", func(t *testing.T) {
+		_, entry := issue(t)
+
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, statusListPath, nil),
+			map[string]string{"id": issuerID, "listID": entry.ListID})
+
+		w := httptest.NewRecorder()
+		svc.statusListCredential(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var listVC struct {
+			ID      string                 `json:"id"`
+			Types   []string               `json:"type"`
+			Issuer  string                 `json:"issuer"`
+			Subject map[string]interface{} `json:"credentialSubject"`
+			Proof   map[string]interface{} `json:"proof"`
+		}
+
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listVC))
+		require.Equal(t, entry.ListURL, listVC.ID)
+		require.Contains(t, listVC.Types, "StatusList2021Credential")
+		require.Equal(t, key.DID, listVC.Issuer)
+		require.Equal(t, "revocation", listVC.Subject["statusPurpose"])
+		require.NotEmpty(t, listVC.Subject["encodedList"])
+		require.Equal(t, key.ID, listVC.Proof["verificationMethod"])
+
+		for _, vars := range []map[string]string{
+			{"id": issuerID, "listID": "unknown"},
+			{"id": "otherIssuer", "listID": entry.ListID},
+		} {
+			w = httptest.NewRecorder()
+			svc.statusListCredential(w, mux.SetURLVars(httptest.NewRequest(http.MethodGet, statusListPath, nil), vars))
+			require.Equal(t, http.StatusNotFound, w.Code)
+			require.Contains(t, w.Body.String(), "status list not found")
+		}
+	})
+
+	t.Run("revoke presentation", func(t *testing.T) {
+		file, err := os.CreateTemp("", "*.html")
+		require.NoError(t, err)
+
+		defer func() { require.NoError(t, os.Remove(file.Name())) }()
+
+		svc.vcHTML = file.Name()
+
+		vc, entry := issue(t)
+
+		vp, err := verifiable.NewPresentation(verifiable.WithCredentials(vc))
+		require.NoError(t, err)
+
+		vpBytes, err := vp.MarshalJSON()
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		svc.revokeVC(rr, &http.Request{Form: map[string][]string{"vcDataInput": {string(vpBytes)}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		revoked, err := svc.statusLists.Status(entry.ListID, entry.Index)
+		require.NoError(t, err)
+		require.True(t, revoked)
+	})
+}
+
+func TestCredentialLifecycle(t *testing.T) { //nolint: gocognit
+	const issuerID = "mockIssuer"
+
+	svc, err := New(&Config{StoreProvider: memstore.NewProvider(), StatusListPurpose: "suspension"})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.store.Put(getIssuerProfileKeyPrefix(issuerID), []byte("profile1")))
+	require.NoError(t, svc.store.Put(getAccessTokenKeyPrefix("testToken"), []byte(issuerID)))
+	require.NoError(t, svc.store.Put(getCredStoreKeyPrefix(issuerID), []byte(testCredentialRequest)))
+
+	_, err = svc.keyManager.Create(issuerID, kms.Ed25519)
+	require.NoError(t, err)
+
+	holder, proofJSON := createCredentialProof(t, svc, issuerID, "testToken")
+
+	req, err := http.NewRequest(http.MethodPost, oidcIssuanceCredential, nil)
+	require.NoError(t, err)
+
+	req.Form = url.Values{"format": {jwtVCJSONFormat}, "proof": {proofJSON}}
+	req.Header.Set("Authorization", "Bearer testToken")
+	req = mux.SetURLVars(req, map[string]string{"id": issuerID})
+
+	w := httptest.NewRecorder()
+	svc.oidcCredentialEndpoint(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	get := func(t *testing.T, handler http.HandlerFunc, target string, v interface{}) {
+		t.Helper()
+
+		rr := httptest.NewRecorder()
+		handler(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), v))
+	}
+
+	change := func(t *testing.T, handler http.HandlerFunc, body string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		rr := httptest.NewRecorder()
+		handler(rr, httptest.NewRequest(http.MethodPost, credentialsPath, strings.NewReader(body)))
+
+		return rr
+	}
+
+	var credentials []*lifecycle.Credential
+
+	get(t, svc.listCredentials, credentialsPath+"?profile=profile1", &credentials)
+	require.Len(t, credentials, 1)
+
+	credential := credentials[0]
+	require.True(t, strings.HasPrefix(credential.ID, "urn:uuid:"))
+	require.Equal(t, lifecycle.Active, credential.Status)
+	require.Equal(t, holder.DID, credential.Subject)
+	require.Equal(t, jwtVCJSONFormat, credential.Format)
+	require.Contains(t, credential.Types, "UniversityDegreeCredential")
+	require.Equal(t, statuslist.Suspension, credential.StatusEntry.Purpose)
+
+	get(t, svc.listCredentials, credentialsPath+"?profile=other", &credentials)
+	require.Empty(t, credentials)
+
+	body := `{"credentialID":"` + credential.ID + `","actor":"mallory","reason":"test"}`
+
+	t.Run("suspend, reinstate and revoke", func(t *testing.T) {
+		for _, tc := range []struct {
+			handler http.HandlerFunc
+			status  lifecycle.Status
+			bit     bool
+		}{
+			{handler: svc.suspendCredential, status: lifecycle.Suspended, bit: true},
+			{handler: svc.reinstateCredential, status: lifecycle.Active, bit: false},
+			{handler: svc.revokeCredential, status: lifecycle.Revoked, bit: true},
+		} {
+			rr := change(t, tc.handler, body)
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			updated := &lifecycle.Credential{}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), updated))
+			require.Equal(t, tc.status, updated.Status)
+			require.Equal(t, adminActor, updated.UpdatedBy)
+			require.Equal(t, "test", updated.Reason)
+
+			status, e := svc.statusLists.Status(credential.StatusEntry.ListID, credential.StatusEntry.Index)
+			require.NoError(t, e)
+			require.Equal(t, tc.bit, status)
+		}
+
+		rr := change(t, svc.reinstateCredential, body)
+		require.Equal(t, http.StatusConflict, rr.Code)
+		require.Contains(t, rr.Body.String(), "invalid status transition")
+
+		get(t, svc.listCredentials, credentialsPath, &credentials)
+		require.Len(t, credentials, 1)
+		require.Equal(t, lifecycle.Revoked, credentials[0].Status)
+		require.Equal(t, adminActor, credentials[0].UpdatedBy)
+
+		var records []*lifecycle.AuditRecord
+
+		get(t, svc.credentialAuditLog, credentialAuditPath+"?credentialID="+url.QueryEscape(credential.ID), &records)
+		require.Len(t, records, 3)
+		require.Equal(t, lifecycle.SuspendAction, records[0].Action)
+		require.Equal(t, adminActor, records[0].Actor)
+		require.Equal(t, lifecycle.RevokeAction, records[2].Action)
+		require.Equal(t, lifecycle.Suspended, records[0].Status)
+
+		get(t, svc.credentialAuditLog, credentialAuditPath+"?profile=profile1", &records)
+		require.Len(t, records, 3)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		rr := change(t, svc.revokeCredential, `{`)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "invalid request")
+
+		rr = change(t, svc.revokeCredential, `{"reason":"test"}`)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "credentialID is required")
+
+		rr = change(t, svc.suspendCredential, `{"credentialID":"urn:uuid:unknown"}`)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "credential not found")
+	})
+
+	t.Run("admin token", func(t *testing.T) {
+		requireAdminHandler(t, credentialsPath, http.MethodGet, credentialsPath)
+		requireAdminHandler(t, credentialRevokePath, http.MethodPost, credentialRevokePath)
+		requireAdminHandler(t, credentialSuspendPath, http.MethodPost, credentialSuspendPath)
+		requireAdminHandler(t, credentialReinstatePath, http.MethodPost, credentialReinstatePath)
+		requireAdminHandler(t, credentialAuditPath, http.MethodGet, credentialAuditPath)
+	})
+}
+
+func TestCredentialTemplates(t *testing.T) { //nolint: gocognit
+	const templateJSON = `{
+		"id": "DriversLicense",
+		"type": "mDL",
+		"contexts": ["https://w3id.org/vdl/v1"],
+		"mapping": {"givenName": "first_name", "familyName": "last_name"},
+		"display": [{"name": "Driver's License", "locale": "en-US"}],
+		"expiry": {"validFor": "24h"},
+		"schema": {"type": "object", "required": ["familyName"]}
+	}`
+
+	svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
+	require.NoError(t, err)
+
+	send := func(handler http.HandlerFunc, method, target, body string,
+		vars map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req = mux.SetURLVars(req, vars)
+
+		rr := httptest.NewRecorder()
+		handler(rr, req)
+
+		return rr
+	}
+
+	t.Run("register, get, list and delete template", func(t *testing.T) {
+		rr := send(svc.putCredentialTemplate, http.MethodPost, credentialTemplatesPath, templateJSON, nil)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		rr = send(svc.getCredentialTemplate, http.MethodGet, credentialTemplatesPath+"/DriversLicense", "",
+			map[string]string{"templateID": "DriversLicense"})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		template := &credtemplate.Template{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), template))
+		require.Equal(t, "mDL", template.Type)
+
+		rr = send(svc.listCredentialTemplates, http.MethodGet, credentialTemplatesPath, "", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var templates []*credtemplate.Template
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &templates))
+		require.Len(t, templates, 3)
+
+		rr = send(svc.deleteCredentialTemplate, http.MethodDelete, credentialTemplatesPath+"/DriversLicense", "",
+			map[string]string{"templateID": "DriversLicense"})
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = send(svc.getCredentialTemplate, http.MethodGet, credentialTemplatesPath+"/DriversLicense", "",
+			map[string]string{"templateID": "DriversLicense"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = send(svc.deleteCredentialTemplate, http.MethodDelete, credentialTemplatesPath+"/DriversLicense", "",
+			map[string]string{"templateID": "DriversLicense"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = send(svc.deleteCredentialTemplate, http.MethodDelete, credentialTemplatesPath+"/default", "",
+			map[string]string{"templateID": credtemplate.DefaultTemplateID})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		rr := send(svc.putCredentialTemplate, http.MethodPost, credentialTemplatesPath, "{", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to decode request")
+
+		rr = send(svc.putCredentialTemplate, http.MethodPost, credentialTemplatesPath, `{"type":"mDL"}`, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "missing id")
+	})
+
+	t.Run("template store errors", func(t *testing.T) {
+		s, e := New(&Config{StoreProvider: &mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
+			ErrGet:   errors.New("get error"),
+			ErrPut:   errors.New("put error"),
+			ErrQuery: errors.New("query error"),
+		}}})
+		require.NoError(t, e)
+
+		rr := send(s.putCredentialTemplate, http.MethodPost, credentialTemplatesPath, templateJSON, nil)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		rr = send(s.listCredentialTemplates, http.MethodGet, credentialTemplatesPath, "", nil)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		rr = send(s.getCredentialTemplate, http.MethodGet, credentialTemplatesPath+"/t", "",
+			map[string]string{"templateID": "t"})
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		rr = send(s.deleteCredentialTemplate, http.MethodDelete, credentialTemplatesPath+"/t", "",
+			map[string]string{"templateID": "t"})
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
 	})
 
-	t.Run("test error from http post", func(t *testing.T) {
-		svc, err := New(&Config{StoreProvider: &mockstorage.Provider{}, DocumentLoader: createTestDocumentLoader(t)})
+	t.Run("oidc issuance using template", func(t *testing.T) {
+		rr := send(svc.putCredentialTemplate, http.MethodPost, credentialTemplatesPath, templateJSON, nil)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		rr = send(svc.initiateIssuance, http.MethodPost, oidcIssuerIssuance, `{
+			"walletInitIssuanceURL": "https://testingwallet/oidc/share",
+			"issuerURL": "https://issuer/oidc/share",
+			"templateID": "DriversLicense",
+			"claimData": {"first_name": "Foo", "last_name": "Bar", "email": "foo@bar.com"}
+		}`, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		u, err := url.Parse(rr.Body.String())
 		require.NoError(t, err)
 
-		rr := httptest.NewRecorder()
-		m := make(map[string][]string)
-		m["vcDataInput"] = []string{validVP}
-		svc.revokeVC(rr, &http.Request{Form: m})
-		require.Equal(t, http.StatusBadRequest, rr.Code)
-		require.Contains(t, rr.Body.String(), "failed to update vc status")
-	})
+		issuer := u.Query().Get("issuer")
+		issuerID := issuer[strings.LastIndex(issuer, "/")+1:]
 
-	t.Run("test vc html not exist", func(t *testing.T) {
-		serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusOK)
-		}))
-		defer serv.Close()
+		configBytes, err := svc.store.Get(issuerID)
+		require.NoError(t, err)
 
-		svc, err := New(&Config{VCHTML: "", VCSURL: serv.URL, StoreProvider: &mockstorage.Provider{},
-			DocumentLoader: createTestDocumentLoader(t)})
+		config := &issuerConfiguration{}
+		require.NoError(t, json.Unmarshal(configBytes, config))
+		require.NotEmpty(t, config.CredentialsSupported)
+		require.Equal(t, []string{verifiableCredentialType, "mDL"}, config.CredentialsSupported[0].Types)
+		require.Equal(t, "Driver's License", config.CredentialsSupported[0].Display[0].Name)
+
+		credentialBytes, err := svc.store.Get(getCredStoreKeyPrefix(issuerID))
 		require.NoError(t, err)
 
-		rr := httptest.NewRecorder()
-		m := make(map[string][]string)
-		m["vcDataInput"] = []string{validVP}
-		svc.revokeVC(rr, &http.Request{Form: m})
-		require.Equal(t, http.StatusInternalServerError, rr.Code)
-		require.Contains(t, rr.Body.String(), "unable to load html")
+		credential := &struct {
+			Context        []string               `json:"@context"`
+			Types          []string               `json:"type"`
+			Issuer         string                 `json:"issuer"`
+			Subject        map[string]interface{} `json:"credentialSubject"`
+			ExpirationDate string                 `json:"expirationDate"`
+		}{}
+		require.NoError(t, json.Unmarshal(credentialBytes, credential))
+		require.Equal(t, []string{credtemplate.CredentialsContext, "https://w3id.org/vdl/v1"}, credential.Context)
+		require.Equal(t, []string{verifiableCredentialType, "mDL"}, credential.Types)
+		require.Equal(t, issuer, credential.Issuer)
+		require.Equal(t, map[string]interface{}{"givenName": "Foo", "familyName": "Bar"}, credential.Subject)
+		require.NotEmpty(t, credential.ExpirationDate)
 	})
 
-	t.Run("test success", func(t *testing.T) {
-		file, err := os.CreateTemp("", "*.html")
-		require.NoError(t, err)
+	t.Run("oidc issuance using template - errors", func(t *testing.T) {
+		rr := send(svc.initiateIssuance, http.MethodPost, oidcIssuerIssuance,
+			`{"issuerURL": "https://issuer/oidc/share", "templateID": "unknown"}`, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "credential template not found")
 
-		defer func() { require.NoError(t, os.Remove(file.Name())) }()
-		serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(http.StatusOK)
-		}))
-		defer serv.Close()
+		rr = send(svc.initiateIssuance, http.MethodPost, oidcIssuerIssuance,
+			`{"issuerURL": "https://issuer/oidc/share", "templateID": "DriversLicense",
+			"claimData": {"first_name": "Foo"}}`, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "familyName is required")
+	})
 
-		svc, err := New(&Config{VCHTML: file.Name(), VCSURL: serv.URL, StoreProvider: &mockstorage.Provider{},
-			DocumentLoader: createTestDocumentLoader(t)})
-		require.NoError(t, err)
+	t.Run("create credential using unknown template", func(t *testing.T) {
+		cms := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "[%s]", foo)
+		}))
+		defer cms.Close()
 
-		rr := httptest.NewRecorder()
-		m := make(map[string][]string)
-		m["vcDataInput"] = []string{validVP}
+		s, e := New(&Config{StoreProvider: memstore.NewProvider(), CMSURL: cms.URL})
+		require.NoError(t, e)
 
-		svc.revokeVC(rr, &http.Request{Form: m})
-		require.Equal(t, http.StatusOK, rr.Code)
+		rr := send(s.createCredentialHandler, http.MethodPost, createCredentialPath,
+			`{"holder":"did:example:holder","scope":"CreditCard","template":"unknown","userID":"100"}`, nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "credential template not found")
+	})
+	t.Run("admin token", func(t *testing.T) {
+		requireAdminHandler(t, credentialTemplatesPath, http.MethodPost, credentialTemplatesPath)
+		requireAdminHandler(t, credentialTemplatePath, http.MethodDelete, credentialTemplatesPath+"/DriversLicense")
 	})
 }
 
@@ -2918,10 +5376,13 @@ func TestDIDCommTokenHandler(t *testing.T) {
 
 		state := uuid.New().String()
 
-		ops.store = &mockstorage.Store{
+		var err error
+
+		ops.store, err = txnstore.New(&mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{
 			GetReturn: []byte(testCredentialRequest),
 			ErrPut:    errors.New("error inserting data"),
-		}
+		}}, txnStoreName)
+		require.NoError(t, err)
 
 		req := &adapterTokenReq{
 			State: state,
@@ -3177,6 +5638,8 @@ func TestVerifyDIDAuthHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		reqBytes, err := json.Marshal(&verifyDIDAuthReq{
 			Holder:      holder,
 			Domain:      domain,
@@ -3217,6 +5680,8 @@ func TestVerifyDIDAuthHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		holder, authResp, challenge := newDIDAuth(t, svc)
+
 		reqBytes, err := json.Marshal(&verifyDIDAuthReq{
 			Holder:      holder,
 			Domain:      uuid.New().String(),
@@ -3236,6 +5701,65 @@ func TestVerifyDIDAuthHandler(t *testing.T) {
 	})
 }
 
+func TestDIDAuthChallengeHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:  memstore.NewProvider(),
+			DocumentLoader: createTestDocumentLoader(t),
+		})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, "https://"+domain+":8080"+didAuthChallengePath, nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.didAuthChallengeHandler(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp := &didAuthChallengeResp{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+		require.Equal(t, domain, resp.Domain)
+		require.NotEmpty(t, resp.Challenge)
+
+		holder, authResp := signDIDAuth(t, resp.Challenge, resp.Domain)
+		require.NoError(t, svc.validateAuthResp([]byte(authResp), holder, resp.Domain, resp.Challenge))
+	})
+
+	t.Run("domain of the query", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, didAuthChallengePath+"?domain=wallet.example.com", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.didAuthChallengeHandler(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp := &didAuthChallengeResp{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+		require.Equal(t, "wallet.example.com", resp.Domain)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider: &mockstorage.Provider{OpenStoreReturn: &mockstorage.Store{ErrPut: errors.New("put error")}},
+		})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, didAuthChallengePath, nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.didAuthChallengeHandler(rr, req)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to issue did auth challenge")
+	})
+}
+
 func TestCreateCredentialHandler(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		cms := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3292,6 +5816,63 @@ func TestCreateCredentialHandler(t *testing.T) {
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
 
+	t.Run("search handle", func(t *testing.T) {
+		vcsRouter := mux.NewRouter()
+		vcsRouter.HandleFunc("/profile/{id}", func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusOK)
+			_, err := writer.Write([]byte(profileData))
+			if err != nil {
+				panic(err)
+			}
+		})
+		vcsRouter.HandleFunc("/{id}/credentials/issue", func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusCreated)
+			_, err := writer.Write([]byte(testCredentialRequest))
+			if err != nil {
+				panic(err)
+			}
+		})
+
+		vcs := httptest.NewServer(vcsRouter)
+
+		defer vcs.Close()
+
+		svc, err := New(&Config{
+			StoreProvider:  memstore.NewProvider(),
+			DocumentLoader: createTestDocumentLoader(t),
+			CMSURL:         "xyz:cms",
+			VCSURL:         vcs.URL,
+		})
+		require.NoError(t, err)
+
+		searchID, err := svc.putSearchData(credtemplate.PermanentResidentCardTemplateID,
+			map[string]interface{}{"userid": "1", "subjectData": map[string]interface{}{"givenName": "Foo"}})
+		require.NoError(t, err)
+
+		for _, tc := range []struct {
+			searchID string
+			status   int
+		}{
+			{searchID: searchID, status: http.StatusOK},
+			{searchID: uuid.NewString(), status: http.StatusBadRequest},
+		} {
+			reqBytes, err := json.Marshal(&createCredentialReq{
+				Holder:     uuid.NewString(),
+				VCSProfile: uuid.NewString(),
+				SearchID:   tc.searchID,
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, createCredentialPath, bytes.NewReader(reqBytes))
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+
+			svc.createCredentialHandler(rr, req)
+			require.Equal(t, tc.status, rr.Code, rr.Body.String())
+		}
+	})
+
 	t.Run("bad request", func(t *testing.T) {
 		cms := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprintf(w, "[%s]", assuranceData)
@@ -3861,6 +6442,239 @@ func TestPreAuthorizeHandler(t *testing.T) {
 		svc.preAuthorize(rr, req)
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
+
+	t.Run("form options", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:    memstore.NewProvider(),
+			PreAuthorizeHTML: template.Name(),
+			VcsDemoIssuer:    "demo-issuer",
+		})
+		require.NoError(t, err)
+
+		var initiateReq *initiateOIDC4CIRequest
+
+		svc.httpClient = newInitiateOIDC4CIClient(t, "profile1", func(req *initiateOIDC4CIRequest) {
+			initiateReq = req
+		})
+
+		req, err := http.NewRequest(http.MethodPost, preAuthorizePath, strings.NewReader(url.Values{
+			"template":       {"permanentResidentCard"},
+			"issuer_profile": {"profile1"},
+			"require_pin":    {"false"},
+			"claims":         {`{"givenName":"Alice"}`},
+		}.Encode()))
+		require.NoError(t, err)
+
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		rr := httptest.NewRecorder()
+
+		svc.preAuthorize(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		require.NotNil(t, initiateReq)
+		require.Equal(t, "permanentResidentCard", initiateReq.CredentialTemplateID)
+		require.Equal(t, preAuthorizedCodeGrantType, initiateReq.GrantType)
+		require.False(t, initiateReq.UserPinRequired)
+		require.Equal(t, map[string]interface{}{"givenName": "Alice"}, *initiateReq.ClaimData)
+	})
+
+	t.Run("claims from subject data source", func(t *testing.T) {
+		cms := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/EmployeeCard", r.URL.Path)
+			require.Equal(t, "100", r.URL.Query().Get("userid"))
+
+			fmt.Fprint(w, `[{"userid":"100","givenName":"Bob"}]`)
+		}))
+		defer cms.Close()
+
+		svc, err := New(&Config{
+			StoreProvider:    memstore.NewProvider(),
+			PreAuthorizeHTML: template.Name(),
+			VcsDemoIssuer:    "demo-issuer",
+			CMSURL:           cms.URL,
+		})
+		require.NoError(t, err)
+
+		var initiateReq *initiateOIDC4CIRequest
+
+		svc.httpClient = newInitiateOIDC4CIClient(t, "demo-issuer", func(req *initiateOIDC4CIRequest) {
+			initiateReq = req
+		})
+
+		req, err := http.NewRequest(http.MethodGet, preAuthorizePath+"?scope=EmployeeCard&user_id=100", http.NoBody)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.preAuthorize(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		require.NotNil(t, initiateReq)
+		require.Equal(t, defaultVCSCredentialTemplateID, initiateReq.CredentialTemplateID)
+		require.True(t, initiateReq.UserPinRequired)
+		require.Equal(t, "Bob", (*initiateReq.ClaimData)["givenName"])
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		svc, err := New(&Config{
+			StoreProvider:    memstore.NewProvider(),
+			PreAuthorizeHTML: template.Name(),
+		})
+		require.NoError(t, err)
+
+		for query, errMsg := range map[string]string{
+			"claims=invalid":           "invalid claims",
+			"grant_type=implicit":      "unsupported grant type implicit",
+			"user_id=100":              "scope is required",
+			"scope=Unknown&user_id=10": "failed to get claims of user 10",
+		} {
+			req, err := http.NewRequest(http.MethodGet, preAuthorizePath+"?"+query, http.NoBody)
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+
+			svc.preAuthorize(rr, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+			require.Contains(t, rr.Body.String(), errMsg)
+		}
+	})
+}
+
+func TestInitiateVCSIssuance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
+		require.NoError(t, err)
+
+		var initiateReq *initiateOIDC4CIRequest
+
+		svc.httpClient = newInitiateOIDC4CIClient(t, "profile1", func(req *initiateOIDC4CIRequest) {
+			initiateReq = req
+		})
+
+		reqBytes, err := json.Marshal(&initiateIssuanceReq{
+			CredentialTemplateID: "universityDegree",
+			IssuerProfile:        "profile1",
+			GrantType:            authorizationCodeGrantType,
+		})
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.initiateVCSIssuance(rr, httptest.NewRequest(http.MethodPost, initiateIssuancePath, bytes.NewReader(reqBytes)))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resp := &initiateIssuanceResp{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+		require.Equal(t, "openid-credential-offer://offer", resp.OfferCredentialURL)
+		require.Equal(t, "tx1", resp.TxID)
+		require.Equal(t, "123456", resp.Pin)
+
+		require.Equal(t, "universityDegree", initiateReq.CredentialTemplateID)
+		require.Equal(t, authorizationCodeGrantType, initiateReq.GrantType)
+		require.Equal(t, "code", initiateReq.ResponseType)
+		require.NotEmpty(t, initiateReq.OpState)
+		require.Nil(t, initiateReq.ClaimData)
+	})
+
+	t.Run("pre-authorized by default", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider(), VcsDemoIssuer: "demo-issuer"})
+		require.NoError(t, err)
+
+		var initiateReq *initiateOIDC4CIRequest
+
+		svc.httpClient = newInitiateOIDC4CIClient(t, "demo-issuer", func(req *initiateOIDC4CIRequest) {
+			initiateReq = req
+		})
+
+		rr := httptest.NewRecorder()
+
+		svc.initiateVCSIssuance(rr, httptest.NewRequest(http.MethodPost, initiateIssuancePath,
+			strings.NewReader(`{"claimData":{"name":"Alice"},"userPinRequired":false}`)))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		require.Equal(t, preAuthorizedCodeGrantType, initiateReq.GrantType)
+		require.False(t, initiateReq.UserPinRequired)
+		require.Equal(t, map[string]interface{}{"name": "Alice"}, *initiateReq.ClaimData)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+
+		svc.initiateVCSIssuance(rr, httptest.NewRequest(http.MethodPost, initiateIssuancePath,
+			strings.NewReader("invalid")))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to decode request")
+
+		rr = httptest.NewRecorder()
+
+		svc.initiateVCSIssuance(rr, httptest.NewRequest(http.MethodPost, initiateIssuancePath,
+			strings.NewReader(`{"grantType":"implicit"}`)))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "unsupported grant type")
+	})
+
+	t.Run("initiate error", func(t *testing.T) {
+		svc, err := New(&Config{StoreProvider: memstore.NewProvider()})
+		require.NoError(t, err)
+
+		svc.httpClient = &http.Client{
+			Transport: &mockTransport{
+				roundTrip: func(req *http.Request) (*http.Response, error) {
+					return nil, errors.New("token error")
+				},
+			},
+		}
+
+		rr := httptest.NewRecorder()
+
+		svc.initiateVCSIssuance(rr, httptest.NewRequest(http.MethodPost, initiateIssuancePath, strings.NewReader("{}")))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "unable to get access token")
+	})
+}
+
+// newInitiateOIDC4CIClient returns the HTTP client of the VCS token and initiate endpoints, which passes the
+// initiate requests of the profile to the callback.
+func newInitiateOIDC4CIClient(t *testing.T, profile string, callback func(req *initiateOIDC4CIRequest)) *http.Client {
+	t.Helper()
+
+	return &http.Client{
+		Transport: &mockTransport{
+			roundTrip: func(req *http.Request) (*http.Response, error) {
+				if req.URL.Path == oauth2TokenPath {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"access_token":"token"}`)),
+					}, nil
+				}
+
+				require.Equal(t, "/issuer/profiles/"+profile+"/interactions/initiate-oidc", req.URL.Path)
+
+				initiateReq := &initiateOIDC4CIRequest{}
+				require.NoError(t, json.NewDecoder(req.Body).Decode(initiateReq))
+
+				callback(initiateReq)
+
+				pin := "123456"
+
+				b, err := json.Marshal(initiateOIDC4CIResponse{
+					OfferCredentialURL: "openid-credential-offer://offer",
+					TxID:               "tx1",
+					UserPin:            &pin,
+				})
+				require.NoError(t, err)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewReader(b)),
+				}, nil
+			},
+		},
+	}
 }
 
 type mockTransport struct {
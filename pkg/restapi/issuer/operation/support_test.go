@@ -9,15 +9,21 @@ package operation
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
+	memstore "github.com/hyperledger/aries-framework-go/component/storageutil/mem"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 
+	"github.com/trustbloc/sandbox/pkg/kms"
+	"github.com/trustbloc/sandbox/pkg/proof"
 	"github.com/trustbloc/sandbox/pkg/token"
 )
 
@@ -104,6 +110,47 @@ func handlerLookup(t *testing.T, op *Operation, lookup string) Handler {
 	return nil
 }
 
+func methodHandlerLookup(t *testing.T, op *Operation, lookup, method string) Handler {
+	t.Helper()
+
+	for _, h := range op.GetRESTHandlers() {
+		if h.Path() == lookup && h.Method() == method {
+			return h
+		}
+	}
+
+	require.Fail(t, "unable to find handler")
+
+	return nil
+}
+
+// requireAdminHandler checks the handler of the path and method is authorized with the admin token, requesting the
+// url matching the path.
+func requireAdminHandler(t *testing.T, lookup, method, url string) {
+	t.Helper()
+
+	op, err := New(&Config{
+		StoreProvider: memstore.NewProvider(),
+		AdminToken:    "admin-token",
+	})
+	require.NoError(t, err)
+
+	handler := methodHandlerLookup(t, op, lookup, method)
+
+	_, status, err := handleRequest(handler, nil, url, false)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, status)
+
+	_, status, err = handleRequest(handler, map[string]string{"Authorization": "Bearer other-token"}, url, false)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, status)
+
+	_, status, err = handleRequest(handler, map[string]string{"Authorization": "Bearer admin-token"}, url, false)
+	require.NoError(t, err)
+	require.NotEqual(t, http.StatusUnauthorized, status)
+	require.NotEqual(t, http.StatusForbidden, status)
+}
+
 func serveHTTP(t *testing.T, handler http.HandlerFunc, method, path string, req []byte) *httptest.ResponseRecorder { // nolint: unparam,lll
 	t.Helper()
 
@@ -176,3 +223,47 @@ func newOIDCCallback(state, code string) *http.Request {
 	return httptest.NewRequest(http.MethodGet,
 		fmt.Sprintf("http://example.com/oauth2/callback?state=%s&code=%s", state, code), nil)
 }
+
+// createCredentialProof saves issuer configuration, issues c_nonce for the access token and returns
+// the holder key along with JSON encoded JWT proof signed by it.
+func createCredentialProof(t *testing.T, svc *Operation, issuerID, accessToken string) (*kms.Key, string) {
+	t.Helper()
+
+	holderKMS, err := kms.New(memstore.NewProvider())
+	require.NoError(t, err)
+
+	holder, err := holderKMS.Create("holder", kms.Ed25519)
+	require.NoError(t, err)
+
+	issuerConf, err := json.Marshal(&issuerConfiguration{Issuer: "https://issuer/" + issuerID})
+	require.NoError(t, err)
+
+	err = svc.store.Put(issuerID, issuerConf)
+	require.NoError(t, err)
+
+	nonce, err := svc.issueCNonce(accessToken)
+	require.NoError(t, err)
+
+	header, err := json.Marshal(map[string]interface{}{"alg": holder.Alg(), "typ": proof.JWTType, "kid": holder.ID})
+	require.NoError(t, err)
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud":   "https://issuer/" + issuerID,
+		"iat":   time.Now().Unix(),
+		"nonce": nonce,
+	})
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	sig, err := holder.Signer().Sign([]byte(signingInput))
+	require.NoError(t, err)
+
+	proofBytes, err := json.Marshal(&proof.Proof{
+		ProofType: proof.TypeJWT,
+		JWT:       signingInput + "." + base64.RawURLEncoding.EncodeToString(sig),
+	})
+	require.NoError(t, err)
+
+	return holder, string(proofBytes)
+}
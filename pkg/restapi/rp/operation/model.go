@@ -17,16 +17,22 @@ type verifyPresentationRequest struct {
 	Domain    string          `json:"domain"`
 	Challenge string          `json:"challenge"`
 	VP        json.RawMessage `json:"vp"`
+	// Profile is the verifier profile, the default one if not set.
+	Profile string `json:"profile,omitempty"`
 }
 
 type verifyCredentialRequest struct {
 	Checks []string        `json:"checks"`
 	VC     json.RawMessage `json:"vc"`
+	// Profile is the verifier profile, the default one if not set.
+	Profile string `json:"profile,omitempty"`
 }
 
 type oidcVpRequest struct {
 	WalletAuthURL          string          `json:"walletAuthURL"`
 	PresentationDefinition json.RawMessage `json:"pEx"`
+	// Profile is the verifier profile, its presentation definition is requested if the request has none.
+	Profile string `json:"profile,omitempty"`
 }
 
 type oidcAuthClaims struct {
@@ -37,10 +43,15 @@ type vpToken struct {
 	PresDef *presexch.PresentationDefinition `json:"presentation_definition"`
 }
 
-type oidcTokenClaims struct {
-	VPToken *vpTokenClaim `json:"_vp_token"`
+// oidcShareState is kept under the state of the OIDC share request until the wallet responds.
+type oidcShareState struct {
+	PresentationDefinition *presexch.PresentationDefinition `json:"presentationDefinition"`
+	Nonce                  string                           `json:"nonce"`
+	// ProfileID is the verifier profile verifying the shared presentation, the default one if not set.
+	ProfileID string `json:"profileID,omitempty"`
 }
 
-type vpTokenClaim struct {
-	PresSub *presexch.PresentationSubmission `json:"presentation_submission"`
+// idTokenVPToken is the _vp_token claim of the id_token of the OIDC share response.
+type idTokenVPToken struct {
+	PresentationSubmission *presexch.PresentationSubmission `json:"presentation_submission"`
 }
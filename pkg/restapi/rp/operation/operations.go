@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,21 +19,33 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	vdrapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdr"
+	vdrpkg "github.com/hyperledger/aries-framework-go/pkg/vdr"
+	vdrkey "github.com/hyperledger/aries-framework-go/pkg/vdr/key"
 	"github.com/hyperledger/aries-framework-go/spi/storage"
 	"github.com/piprate/json-gold/ld"
-	"github.com/square/go-jose/jwt"
 	"github.com/trustbloc/edge-core/pkg/log"
 	edgesvcops "github.com/trustbloc/vcs/pkg/restapi/verifier/operation"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 
+	"github.com/trustbloc/sandbox/pkg/eventstore"
 	"github.com/trustbloc/sandbox/pkg/internal/common/support"
+	"github.com/trustbloc/sandbox/pkg/pex"
 	oidcclient "github.com/trustbloc/sandbox/pkg/restapi/internal/common/oidc"
+	"github.com/trustbloc/sandbox/pkg/restapi/internal/common/webhook"
+	"github.com/trustbloc/sandbox/pkg/siop"
+	"github.com/trustbloc/sandbox/pkg/trustregistry"
+	"github.com/trustbloc/sandbox/pkg/txnstore"
+	"github.com/trustbloc/sandbox/pkg/verifier"
+	"github.com/trustbloc/sandbox/pkg/verifierprofile"
 )
 
 const (
@@ -51,11 +64,19 @@ const (
 	openID4VPRetrieveClaimsQRPath = "/verify/openid4vp/retrieve"
 	openID4VPWebhookPath          = "/verify/openid4vp/webhook"
 	openID4VPWebhookCheckPath     = "/verify/openid4vp/webhook/check"
+	openID4VPWebhookEventsPath    = "/verify/openid4vp/webhook/events"
+	openID4VPWebhookRejectedPath  = "/verify/openid4vp/webhook/rejected"
+	openID4VPWebhookStreamPath    = "/verify/openid4vp/webhook/stream"
+	trustedIssuersPath            = "/trust/issuers"
+	trustedIssuerPath             = trustedIssuersPath + "/{did}"
+	verifierProfilesPath          = "/verifier/profiles"
+	verifierProfilePath           = verifierProfilesPath + "/{profileID}"
 
 	// api path params
 	scopeQueryParam    = "scope"
 	flowQueryParam     = "flow"
 	demoTypeQueryParam = "demoType"
+	profileQueryParam  = "profile"
 
 	// edge-service verifier endpoints
 	verifyPresentationURLFormat = "/%s" + "/verifier/presentations/verify"
@@ -66,16 +87,20 @@ const (
 	initiateOidcInteractionURLFormat   = "/verifier/profiles/%s/interactions/initiate-oidc"
 	retrieveInteractionsClaimURLFormat = "/verifier/interactions/%s/claim"
 
-	// TODO https://github.com/trustbloc/sandbox/issues/352 Configure verifier profiles in Verifier page
-	verifierProfileID = "trustbloc-verifier"
+	vcsVerifierRequestTokenName = "vcs_verifier" //nolint: gosec
 
-	verifierJWTProfileID = "jwt-web-ED25519-JsonWebSignature2020"
+	// oidcShareClientID is the client ID of the RP in the OIDC share requests, the audience of the id_token and
+	// the domain of the presentation proofs
+	oidcShareClientID = "demo-verifier"
 
-	vcsVerifierRequestTokenName = "vcs_verifier" //nolint: gosec
+	// oidcShareStateTTL is the time the wallet has to respond to the OIDC share request.
+	oidcShareStateTTL = 30 * time.Minute
 
-	transientStoreName = "rp-rest-transient"
-	flowTypeCookie     = "flowType"
-	waciDemoType       = "waci"
+	transientStoreName      = "rp-rest-transient"
+	webhookEventStoreName   = "rp-rest-webhook-events"
+	oidcShareStateStoreName = "rp-rest-oidc-share-states"
+	flowTypeCookie          = "flowType"
+	waciDemoType            = "waci"
 )
 
 var logger = log.New("sandbox-rp-restapi")
@@ -96,6 +121,11 @@ type oidcClient interface {
 	HandleOIDCCallback(reqContext context.Context, code string) ([]byte, error)
 }
 
+type initiateOIDC4VPRequest struct {
+	PresentationDefinitionID string `json:"presentationDefinitionId,omitempty"`
+	Purpose                  string `json:"purpose,omitempty"`
+}
+
 type initiateOIDC4VPResponse struct {
 	AuthorizationRequest string `json:"authorizationRequest"`
 	TxID                 string `json:"txID"`
@@ -112,14 +142,27 @@ type Operation struct {
 	client          httpClient
 	requestTokens   map[string]string
 	transientStore  storage.Store
+	// oidcShareStates holds the state of the pending OIDC share requests until they're consumed or expire.
+	oidcShareStates *txnstore.Store
 	tlsConfig       *tls.Config
 	oidcClient      oidcClient
 	waciOIDCClient  oidcClient
 	walletAuthURL   string
 	accessTokenURL  string
 	apiGatewayURL   string
-	eventsTopic     *EventsTopic
-	didConfig       []byte
+	webhook         *webhook.Handler
+	// didConfigs caches the DID configurations by the VCS profile publishing them.
+	didConfigs      map[string][]byte
+	didConfigsMutex sync.RWMutex
+	idTokenVerifier *siop.Verifier
+	documentLoader  ld.DocumentLoader
+	pexEvaluator    *pex.Evaluator
+	localVerifier   *verifier.Verifier
+	// localVerification is set if the local verifier replaces the VCS, otherwise it only runs the trust check.
+	localVerification bool
+	trustRegistry     *trustregistry.Registry
+	verifierProfiles  *verifierprofile.Registry
+	adminToken        string
 }
 
 // Config defines configuration for rp operations
@@ -143,6 +186,44 @@ type Config struct {
 	WalletAuthURL          string
 	AccessTokenURL         string
 	APIGatewayURL          string
+	// WebhookEventTTL is the time the webhook events of a transaction are kept after its last update,
+	// eventstore.DefaultTTL by default.
+	WebhookEventTTL time.Duration
+	// WebhookEventSweepInterval is the interval of purging expired webhook events and OIDC share states, sweeper
+	// is disabled if not set.
+	WebhookEventSweepInterval time.Duration
+	// WebhookSecrets are the shared secrets the webhook requests are signed with, any of them is accepted.
+	WebhookSecrets []string
+	// WebhookSecretsFile holds the webhook secrets one per line, it's reloaded when modified.
+	WebhookSecretsFile string
+	// WebhookClientCAs verify the TLS client certificates of the webhook requests.
+	WebhookClientCAs *x509.CertPool
+	// WebhookClientNames restricts the names of the webhook client certificates.
+	WebhookClientNames []string
+	// VDRegistry resolves the wallet DIDs of the OIDC share responses, only did:key is resolved if not set.
+	VDRegistry vdrapi.Registry
+	// DocumentLoader loads the JSON-LD contexts of the shared presentations, they're fetched if not set.
+	DocumentLoader ld.DocumentLoader
+	// LocalVerification verifies the presentations and the credentials with the embedded verifier instead of the
+	// VCS, the issuer and holder DIDs are resolved with the VDRegistry.
+	LocalVerification bool
+	// TrustRegistryEnabled enables the trust check of the credential issuers, the trusted issuers are managed
+	// through the admin API authorized with the AdminToken and loaded from the trust list file and the remote trust
+	// list if set.
+	TrustRegistryEnabled bool
+	// TrustRegistryFile is the trust list file, it's reloaded when modified.
+	TrustRegistryFile string
+	// TrustRegistryURL is the URL of the remote trust list.
+	TrustRegistryURL string
+	// TrustRegistryRefreshInterval is the interval the remote trust list is fetched again,
+	// trustregistry.DefaultRefreshInterval by default.
+	TrustRegistryRefreshInterval time.Duration
+	// TrustMinAssuranceLevel is the assurance level the issuers must have at least to be trusted.
+	TrustMinAssuranceLevel string
+	// VerifierProfilesPath is the verifier profile file, or directory of them, loaded on start.
+	VerifierProfilesPath string
+	// AdminToken is the bearer token authorizing the admin API requests, the admin API is disabled if not set.
+	AdminToken string
 }
 
 // vc struct used to return vc data to html
@@ -150,6 +231,9 @@ type vc struct {
 	Data     string `json:"data"`
 	Msg      string `json:"msg"`
 	FlowType string `json:"flowType"`
+	Failed   bool   `json:"failed"`
+	// Submission is the evaluation of the presentation submission of the OIDC share response.
+	Submission *pex.Result `json:"submission,omitempty"`
 }
 
 type createOIDCRequestResponse struct {
@@ -176,7 +260,8 @@ func New(config *Config) (*Operation, error) {
 		walletAuthURL:   config.WalletAuthURL,
 		accessTokenURL:  config.AccessTokenURL,
 		apiGatewayURL:   config.APIGatewayURL,
-		eventsTopic:     NewEventsTopic(),
+		didConfigs:      map[string][]byte{},
+		adminToken:      config.AdminToken,
 	}
 
 	var err error
@@ -204,11 +289,138 @@ func New(config *Config) (*Operation, error) {
 		return nil, fmt.Errorf("failed to create store : %w", err)
 	}
 
+	svc.oidcShareStates, err = createOIDCShareStateStore(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oidc share state store : %w", err)
+	}
+
+	svc.webhook, err = createWebhookHandler(config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.initVerification(config)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.verifierProfiles, err = createVerifierProfiles(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create verifier profiles : %w", err)
+	}
+
 	svc.registerHandler()
 
 	return svc, nil
 }
 
+func createOIDCShareStateStore(config *Config) (*txnstore.Store, error) {
+	store, err := txnstore.New(config.TransientStoreProvider, oidcShareStateStoreName)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.WebhookEventSweepInterval > 0 {
+		store.StartSweeper(config.WebhookEventSweepInterval)
+	}
+
+	return store, nil
+}
+
+func createWebhookHandler(config *Config) (*webhook.Handler, error) {
+	webhookEvents, err := createWebhookEventStore(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook event store : %w", err)
+	}
+
+	if config.WebhookEventSweepInterval > 0 {
+		webhookEvents.StartSweeper(config.WebhookEventSweepInterval)
+	}
+
+	return webhook.New(webhookEvents, webhook.WithAuth(&webhook.AuthConfig{
+		Secrets:     config.WebhookSecrets,
+		SecretsFile: config.WebhookSecretsFile,
+		ClientCAs:   config.WebhookClientCAs,
+		ClientNames: config.WebhookClientNames,
+	})), nil
+}
+
+// initVerification sets up the verification of the OIDC share responses, and of the presentations and the
+// credentials if they're verified locally or their issuers are checked in the trust registry.
+func (c *Operation) initVerification(config *Config) error {
+	vdr := config.VDRegistry
+	if vdr == nil {
+		vdr = vdrpkg.New(vdrpkg.WithVDR(vdrkey.New()))
+	}
+
+	c.idTokenVerifier = siop.New(vdr)
+
+	c.documentLoader = config.DocumentLoader
+	if c.documentLoader == nil {
+		c.documentLoader = ld.NewDefaultDocumentLoader(nil)
+	}
+
+	c.pexEvaluator = pex.New(c.documentLoader)
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config.TLSConfig}}
+	opts := []verifier.Opt{verifier.WithHTTPClient(client)}
+
+	if config.TrustRegistryEnabled {
+		registry, err := createTrustRegistry(config, client)
+		if err != nil {
+			return fmt.Errorf("failed to create trust registry : %w", err)
+		}
+
+		c.trustRegistry = registry
+		opts = append(opts, verifier.WithTrustRegistry(registry))
+	}
+
+	c.localVerification = config.LocalVerification
+
+	if c.localVerification || c.trustRegistry != nil {
+		c.localVerifier = verifier.New(vdr, c.documentLoader, opts...)
+	}
+
+	return nil
+}
+
+func createTrustRegistry(config *Config, client *http.Client) (*trustregistry.Registry, error) {
+	opts := []trustregistry.Opt{
+		trustregistry.WithHTTPClient(client),
+		trustregistry.WithMinAssuranceLevel(trustregistry.AssuranceLevel(config.TrustMinAssuranceLevel)),
+	}
+
+	if config.TrustRegistryFile != "" {
+		opts = append(opts, trustregistry.WithFile(config.TrustRegistryFile))
+	}
+
+	if config.TrustRegistryURL != "" {
+		opts = append(opts, trustregistry.WithRemoteList(config.TrustRegistryURL))
+	}
+
+	if config.TrustRegistryRefreshInterval > 0 {
+		opts = append(opts, trustregistry.WithRefreshInterval(config.TrustRegistryRefreshInterval))
+	}
+
+	return trustregistry.New(config.TransientStoreProvider, opts...)
+}
+
+func createVerifierProfiles(config *Config) (*verifierprofile.Registry, error) {
+	profiles, err := verifierprofile.New(config.TransientStoreProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.VerifierProfilesPath != "" {
+		err = profiles.Load(config.VerifierProfilesPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return profiles, nil
+}
+
 // registerHandler register handlers to be exposed from this service as REST API endpoints
 func (c *Operation) registerHandler() {
 	// Add more protocol endpoints here to expose them as controller API endpoints
@@ -227,8 +439,25 @@ func (c *Operation) registerHandler() {
 		support.NewHTTPHandler(openID4VPGetQRPath, http.MethodGet, c.openID4VPGetQR),
 		support.NewHTTPHandler(openID4VPRetrieveClaimsQRPath, http.MethodGet, c.retrieveInteractionsClaim),
 
-		support.NewHTTPHandler(openID4VPWebhookPath, http.MethodPost, c.eventsTopic.receiveTopics),
-		support.NewHTTPHandler(openID4VPWebhookCheckPath, http.MethodGet, c.eventsTopic.checkTopics),
+		support.NewHTTPHandler(openID4VPWebhookPath, http.MethodPost, c.webhook.Receive),
+		support.NewHTTPHandler(openID4VPWebhookCheckPath, http.MethodGet, c.webhook.Check),
+		support.NewHTTPHandler(openID4VPWebhookEventsPath, http.MethodGet, c.webhook.Events),
+		support.NewHTTPHandler(openID4VPWebhookStreamPath, http.MethodGet, c.webhook.Stream),
+		support.NewHTTPHandler(openID4VPWebhookRejectedPath, http.MethodGet, c.webhook.Rejected),
+
+		support.NewAdminHTTPHandler(verifierProfilesPath, http.MethodPost, c.adminToken, c.putVerifierProfile),
+		support.NewHTTPHandler(verifierProfilesPath, http.MethodGet, c.listVerifierProfiles),
+		support.NewHTTPHandler(verifierProfilePath, http.MethodGet, c.getVerifierProfile),
+		support.NewAdminHTTPHandler(verifierProfilePath, http.MethodDelete, c.adminToken, c.deleteVerifierProfile),
+	}
+
+	if c.trustRegistry != nil {
+		c.handlers = append(c.handlers,
+			support.NewAdminHTTPHandler(trustedIssuersPath, http.MethodPost, c.adminToken, c.putTrustedIssuer),
+			support.NewHTTPHandler(trustedIssuersPath, http.MethodGet, c.listTrustedIssuers),
+			support.NewHTTPHandler(trustedIssuerPath, http.MethodGet, c.getTrustedIssuer),
+			support.NewAdminHTTPHandler(trustedIssuerPath, http.MethodDelete, c.adminToken, c.deleteTrustedIssuer),
+		)
 	}
 }
 
@@ -237,7 +466,7 @@ func (c *Operation) GetRESTHandlers() []Handler {
 	return c.handlers
 }
 
-func (c *Operation) verifyPresentation(w http.ResponseWriter, r *http.Request) {
+func (c *Operation) verifyPresentation(w http.ResponseWriter, r *http.Request) { // nolint: funlen
 	req := &verifyPresentationRequest{}
 
 	err := json.NewDecoder(r.Body).Decode(req)
@@ -248,16 +477,32 @@ func (c *Operation) verifyPresentation(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	profile, ok := c.requestedProfile(w, req.Profile)
+	if !ok {
+		return
+	}
+
+	checks := profileChecks(profile, req.Checks)
+
+	if c.localVerifier != nil {
+		report, e := c.localVerifier.VerifyPresentation(req.VP, c.localChecks(checks), req.Challenge, req.Domain)
+		if c.localVerification || e != nil || !report.Verified {
+			c.writeVerificationReport(w, report, e)
+
+			return
+		}
+	}
+
 	vpReq := edgesvcops.VerifyPresentationRequest{
 		Presentation: req.VP,
 		Opts: &edgesvcops.VerifyPresentationOptions{
-			Checks:    req.Checks,
+			Checks:    vcsChecks(checks),
 			Challenge: req.Challenge,
 			Domain:    req.Domain,
 		},
 	}
 
-	resp, err := c.callVerifyPresentation(vpReq)
+	resp, err := c.callVerifyPresentation(profile.VCSProfileID, vpReq)
 	if err != nil {
 		c.writeErrorResponse(w, http.StatusBadRequest,
 			fmt.Sprintf("failed to verify vp: %s", err.Error()))
@@ -289,7 +534,7 @@ func (c *Operation) verifyPresentation(w http.ResponseWriter, r *http.Request) {
 	c.writeResponse(w, http.StatusOK, []byte(""))
 }
 
-func (c *Operation) verifyCredential(w http.ResponseWriter, r *http.Request) {
+func (c *Operation) verifyCredential(w http.ResponseWriter, r *http.Request) { // nolint: funlen
 	req := &verifyCredentialRequest{}
 
 	err := json.NewDecoder(r.Body).Decode(req)
@@ -300,14 +545,30 @@ func (c *Operation) verifyCredential(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	profile, ok := c.requestedProfile(w, req.Profile)
+	if !ok {
+		return
+	}
+
+	checks := profileChecks(profile, req.Checks)
+
+	if c.localVerifier != nil {
+		report, e := c.localVerifier.VerifyCredential(req.VC, c.localChecks(checks))
+		if c.localVerification || e != nil || !report.Verified {
+			c.writeVerificationReport(w, report, e)
+
+			return
+		}
+	}
+
 	vcReq := edgesvcops.CredentialsVerificationRequest{
 		Credential: req.VC,
 		Opts: &edgesvcops.CredentialsVerificationOptions{
-			Checks: req.Checks,
+			Checks: vcsChecks(checks),
 		},
 	}
 
-	resp, err := c.callVerifyCredential(vcReq)
+	resp, err := c.callVerifyCredential(profile.VCSProfileID, vcReq)
 	if err != nil {
 		c.writeErrorResponse(w, http.StatusBadRequest,
 			fmt.Sprintf("failed to verify vc: %s", err.Error()))
@@ -348,21 +609,30 @@ func (c *Operation) verifyVP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	profile, ok := c.requestedProfile(w, r.Form.Get(profileQueryParam))
+	if !ok {
+		return
+	}
+
 	inputData := "vpDataInput"
-	checks := strings.Split(r.Form.Get("checks"), ",")
+	checks := profileChecks(profile, strings.Split(r.Form.Get("checks"), ","))
 	domain := r.Form.Get("domain")
 	challenge := r.Form.Get("challenge")
 
+	if c.localVerifier != nil && c.verifyLocally(inputData, c.localChecks(checks), challenge, domain, w, r) {
+		return
+	}
+
 	req := edgesvcops.VerifyPresentationRequest{
 		Presentation: []byte(r.Form.Get(inputData)),
 		Opts: &edgesvcops.VerifyPresentationOptions{
-			Checks:    checks,
+			Checks:    vcsChecks(checks),
 			Challenge: challenge,
 			Domain:    domain,
 		},
 	}
 
-	c.verify(req, inputData, c.vpHTML, w, r)
+	c.verify(profile.VCSProfileID, req, inputData, c.vpHTML, w, r)
 }
 
 func (c *Operation) createOIDCShareRequest(w http.ResponseWriter, r *http.Request) { // nolint: funlen
@@ -381,11 +651,14 @@ func (c *Operation) createOIDCShareRequest(w http.ResponseWriter, r *http.Reques
 		walletAuthURL = c.walletAuthURL
 	}
 
-	var pd *presexch.PresentationDefinition
+	profile, ok := c.requestedProfile(w, oidcVpReq.Profile)
+	if !ok {
+		return
+	}
 
-	err = json.Unmarshal(oidcVpReq.PresentationDefinition, &pd)
+	pd, err := requestedDefinition(profile, oidcVpReq.PresentationDefinition)
 	if err != nil {
-		c.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to unmarshal presentation definition : %s", err))
+		c.writeErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -403,6 +676,7 @@ func (c *Operation) createOIDCShareRequest(w http.ResponseWriter, r *http.Reques
 	}
 
 	state := uuid.NewString()
+	nonce := uuid.NewString()
 
 	// TODO: use OIDC client library
 	// construct wallet auth req with PEx
@@ -420,20 +694,21 @@ func (c *Operation) createOIDCShareRequest(w http.ResponseWriter, r *http.Reques
 	}
 
 	q := walletReq.URL.Query()
-	q.Add("client_id", "demo-verifier")
+	q.Add("client_id", oidcShareClientID)
 	q.Add("redirect_uri", redirectURI.String())
 	q.Add("scope", "openid")
 	q.Add("state", state)
+	q.Add("nonce", nonce)
 	q.Add("claims", string(claimsBytes))
 
 	walletReq.URL.RawQuery = q.Encode()
 
 	redirectURL := walletReq.URL.String()
 
-	err = c.savePresentationDefinition(pd, state)
+	err = c.saveOIDCShareState(state, &oidcShareState{PresentationDefinition: pd, Nonce: nonce, ProfileID: profile.ID})
 	if err != nil {
 		c.writeErrorResponse(w,
-			http.StatusInternalServerError, fmt.Sprintf("failed save presentation definition : %s", err))
+			http.StatusInternalServerError, fmt.Sprintf("failed save oidc share state : %s", err))
 
 		return
 	}
@@ -441,24 +716,69 @@ func (c *Operation) createOIDCShareRequest(w http.ResponseWriter, r *http.Reques
 	c.writeResponse(w, http.StatusOK, []byte(redirectURL))
 }
 
-func (c *Operation) savePresentationDefinition(pd *presexch.PresentationDefinition, state string) error {
-	pdBytes, err := json.Marshal(pd)
+// requestedDefinition returns the presentation definition of the OIDC share request, the one of the profile if the
+// request has none.
+func requestedDefinition(profile *verifierprofile.Profile,
+	pdBytes json.RawMessage) (*presexch.PresentationDefinition, error) {
+	var pd *presexch.PresentationDefinition
+
+	if len(pdBytes) > 0 {
+		err := json.Unmarshal(pdBytes, &pd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal presentation definition : %w", err)
+		}
+	}
+
+	if pd != nil {
+		return pd, nil
+	}
+
+	pd, err := profile.Definition()
 	if err != nil {
-		return fmt.Errorf("failed marshal presentation definition : %w", err)
+		return nil, err
 	}
 
-	err = c.transientStore.Put(state, pdBytes)
+	if pd == nil {
+		return nil, fmt.Errorf("missing presentation definition, verifier profile %s has none", profile.ID)
+	}
+
+	return pd, nil
+}
+
+func (c *Operation) saveOIDCShareState(stateID string, state *oidcShareState) error {
+	stateBytes, err := json.Marshal(state)
 	if err != nil {
-		return fmt.Errorf("failed store presentation definition : %w", err)
+		return fmt.Errorf("failed marshal oidc share state : %w", err)
+	}
+
+	err = c.oidcShareStates.PutWithTTL(stateID, stateBytes, oidcShareStateTTL)
+	if err != nil {
+		return fmt.Errorf("failed store oidc share state : %w", err)
 	}
 
 	return nil
 }
 
-func (c *Operation) handleOIDCShareCallback(w http.ResponseWriter, r *http.Request) {
-	state := r.URL.Query().Get("state")
+// consumeOIDCShareState returns the state of the OIDC share request and removes it, so that the response can't
+// be replayed.
+func (c *Operation) consumeOIDCShareState(stateID string) (*oidcShareState, error) {
+	stateBytes, err := c.oidcShareStates.Consume(stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &oidcShareState{}
+
+	err = json.Unmarshal(stateBytes, state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal oidc share state : %w", err)
+	}
+
+	return state, nil
+}
 
-	pdBytes, err := c.transientStore.Get(state)
+func (c *Operation) handleOIDCShareCallback(w http.ResponseWriter, r *http.Request) {
+	state, err := c.consumeOIDCShareState(r.URL.Query().Get("state"))
 	if err != nil {
 		c.writeErrorResponse(w,
 			http.StatusInternalServerError, fmt.Sprintf("failed to get oidc state data : %s", err))
@@ -466,61 +786,150 @@ func (c *Operation) handleOIDCShareCallback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	var pd *presexch.PresentationDefinition
+	idToken := r.URL.Query().Get("id_token")
+	vpToken := r.URL.Query().Get("vp_token")
+	logger.Debugf("oidc share callback : state=%s", r.URL.Query().Get("state"))
 
-	err = json.Unmarshal(pdBytes, &pd)
+	submission, err := c.verifyOIDCShareResponse(state, idToken, vpToken)
 	if err != nil {
-		c.writeErrorResponse(w,
-			http.StatusInternalServerError, fmt.Sprintf("failed to unmarshal presentation definition : %s", err))
+		logger.Errorf("failed to handle oidc share callback : %s", err)
+		c.oidcShareVpResult(w, http.StatusBadRequest,
+			&vc{Msg: fmt.Sprintf("failed to verify presentation: %s", err), Failed: true})
 
 		return
 	}
 
-	idToken := r.URL.Query().Get("id_token")
-	vpToken := r.URL.Query().Get("vp_token")
-	logger.Infof("oidc share callback : id_token=%s vp_token=%s",
-		idToken, vpToken)
+	c.oidcShareVpResult(w, http.StatusOK,
+		&vc{Msg: "Successfully Received OIDC verifiable Presentation", Submission: submission})
+}
 
-	var claims *oidcTokenClaims
+// verifyOIDCShareResponse verifies the self-issued id_token for the nonce of the request, the binding of the
+// presentation to the id_token subject and the presentation itself, and evaluates the presentation submission
+// against the presentation definition of the request.
+func (c *Operation) verifyOIDCShareResponse(state *oidcShareState, idToken, vpToken string) (*pex.Result, error) {
+	claims, err := c.idTokenVerifier.VerifyIDToken(idToken, oidcShareClientID, state.Nonce)
+	if err != nil {
+		return nil, err
+	}
 
-	token, err := jwt.ParseSigned(idToken)
+	vp, err := verifiable.ParsePresentation([]byte(vpToken),
+		verifiable.WithPresJSONLDDocumentLoader(c.documentLoader),
+		verifiable.WithPresDisabledProofCheck())
 	if err != nil {
-		c.writeErrorResponse(w, http.StatusInternalServerError,
-			fmt.Sprintf("failed to parsed token : %s", err))
+		return nil, fmt.Errorf("failed to parse presentation: %w", err)
+	}
 
-		return
+	if vp.Holder != claims.Subject {
+		return nil, fmt.Errorf("presentation holder %s doesn't match the id_token subject %s",
+			vp.Holder, claims.Subject)
 	}
 
-	err = token.UnsafeClaimsWithoutVerification(&claims)
+	var vpTokenClaim idTokenVPToken
+
+	if len(claims.VPToken) > 0 {
+		if e := json.Unmarshal(claims.VPToken, &vpTokenClaim); e != nil {
+			return nil, fmt.Errorf("failed to unmarshal _vp_token claim : %w", e)
+		}
+	}
+
+	// the submission of the id_token takes precedence over the one embedded in the presentation
+	submission, err := c.pexEvaluator.Evaluate(state.PresentationDefinition, vp, vpTokenClaim.PresentationSubmission)
 	if err != nil {
-		c.writeErrorResponse(w, http.StatusInternalServerError,
-			fmt.Sprintf("failed to convert to claim object : %s", err))
+		return nil, err
+	}
 
-		return
+	profile, err := c.verifierProfiles.Get(state.ProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verifier profile %s : %w", state.ProfileID, err)
 	}
 
-	logger.Infof("oidc share callback : vp_token=%s", vpToken)
+	err = c.verifyOIDCSharePresentation(profile.VCSProfileID, vpToken, state.Nonce)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err = verifiable.ParsePresentation([]byte(vpToken),
-		verifiable.WithPresJSONLDDocumentLoader(ld.NewDefaultDocumentLoader(nil)),
-		verifiable.WithPresDisabledProofCheck())
+	return submission, nil
+}
+
+// verifyOIDCSharePresentation verifies the presentation and credential proofs and the credential status through
+// the VCS profile or locally, the presentation proofs must be created for the nonce and the client ID. The issuers
+// are checked in the trust registry if it's configured.
+func (c *Operation) verifyOIDCSharePresentation(vcsProfileID, vpToken, nonce string) error {
+	checks := []string{verifier.CheckProof, verifier.CheckCredentialStatus}
+
+	if c.localVerifier != nil {
+		report, err := c.localVerifier.VerifyPresentation([]byte(vpToken), c.localChecks(checks), nonce,
+			oidcShareClientID)
+		if err != nil {
+			return fmt.Errorf("failed to verify presentation : %w", err)
+		}
+
+		if !report.Verified {
+			return fmt.Errorf("presentation verification failed : %s", report.Err())
+		}
+
+		if c.localVerification {
+			return nil
+		}
+	}
+
+	presentation := json.RawMessage(vpToken)
+
+	// JWT presentations are sent as JSON strings
+	if !json.Valid(presentation) {
+		var err error
 
+		presentation, err = json.Marshal(vpToken)
+		if err != nil {
+			return fmt.Errorf("failed to marshal presentation : %w", err)
+		}
+	}
+
+	resp, err := c.callVerifyPresentation(vcsProfileID, edgesvcops.VerifyPresentationRequest{
+		Presentation: presentation,
+		Opts: &edgesvcops.VerifyPresentationOptions{
+			Checks:    checks,
+			Challenge: nonce,
+			Domain:    oidcShareClientID,
+		},
+	})
 	if err != nil {
-		logger.Errorf("failed to handle oidc share callback : %s vptoken %s", err, vpToken)
-		c.oidcShareVpResult(w, fmt.Sprintf("failed to parse presentation: %s", err))
+		return fmt.Errorf("failed to verify presentation : %w", err)
+	}
 
-		return
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Errorf("closing response body failed: %v", e)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		respBytes, e := io.ReadAll(resp.Body)
+		if e != nil {
+			return fmt.Errorf("failed to read verify presentation resp : %w", e)
+		}
+
+		return fmt.Errorf("presentation verification failed : %s", string(respBytes))
 	}
 
-	c.oidcShareVpResult(w, "Successfully Received OIDC verifiable Presentation")
+	return nil
 }
 
+// wellKnownConfig returns the DID configuration of the OIDC4VP VCS profile of the requested verifier profile.
 func (c *Operation) wellKnownConfig(w http.ResponseWriter, r *http.Request) {
-	if len(c.didConfig) == 0 {
-		// TODO make profile id configurable
+	oidc4vp, ok := c.requestedOIDC4VP(w, r.FormValue(profileQueryParam))
+	if !ok {
+		return
+	}
+
+	c.didConfigsMutex.RLock()
+	didConfig, ok := c.didConfigs[oidc4vp.VCSProfileID]
+	c.didConfigsMutex.RUnlock()
+
+	if !ok {
 		resp, err := c.sendHTTPRequest(http.MethodGet,
 			fmt.Sprintf("%s/verifier/profiles/%s/well-known/did-config",
-				c.vcsV1URL, verifierJWTProfileID), nil, httpContentTypeJSON, "")
+				c.vcsV1URL, oidc4vp.VCSProfileID), nil, httpContentTypeJSON, "")
 		if err != nil {
 			c.writeErrorResponse(w, http.StatusInternalServerError,
 				fmt.Sprintf("failed to get did config: %s", err.Error()))
@@ -550,18 +959,28 @@ func (c *Operation) wellKnownConfig(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		c.didConfig = respBytes
+		didConfig = respBytes
+
+		c.didConfigsMutex.Lock()
+		c.didConfigs[oidc4vp.VCSProfileID] = didConfig
+		c.didConfigsMutex.Unlock()
 	}
 
 	w.Header().Set("content-type", httpContentTypeJSON)
 
-	_, err := w.Write(c.didConfig)
+	_, err := w.Write(didConfig)
 	if err != nil {
 		logger.Errorf("failed to write response : %s", err)
 	}
 }
 
+// openID4VPGetQR initiates the OIDC4VP interaction of the OIDC4VP VCS profile of the requested verifier profile.
 func (c *Operation) openID4VPGetQR(w http.ResponseWriter, r *http.Request) { //nolint: funlen
+	oidc4vp, ok := c.requestedOIDC4VP(w, r.FormValue(profileQueryParam))
+	if !ok {
+		return
+	}
+
 	// TODO make username and secret configurable
 	token, err := c.issueAccessToken(c.accessTokenURL, "test-org", "test-org-secret", []string{"org_admin"})
 	if err != nil {
@@ -570,10 +989,20 @@ func (c *Operation) openID4VPGetQR(w http.ResponseWriter, r *http.Request) { //n
 		return
 	}
 
-	endpoint := fmt.Sprintf(initiateOidcInteractionURLFormat, verifierJWTProfileID)
+	endpoint := fmt.Sprintf(initiateOidcInteractionURLFormat, oidc4vp.VCSProfileID)
+
+	reqBytes, err := json.Marshal(&initiateOIDC4VPRequest{
+		PresentationDefinitionID: oidc4vp.PresentationDefinitionID,
+		Purpose:                  oidc4vp.Purpose,
+	})
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to marshal request : %s", err))
+
+		return
+	}
 
 	resp, err := c.sendHTTPRequest(http.MethodPost,
-		c.apiGatewayURL+endpoint, nil, httpContentTypeJSON, token)
+		c.apiGatewayURL+endpoint, reqBytes, httpContentTypeJSON, token)
 	if err != nil {
 		c.writeErrorResponse(w, http.StatusInternalServerError,
 			fmt.Sprintf("failed to initiate oidc: %s", err.Error()))
@@ -802,7 +1231,7 @@ func (c *Operation) handleOIDCCallback(w http.ResponseWriter, r *http.Request) {
 	c.didcommDemoResult(w, string(data), flowTypeCookie.Value)
 }
 
-func (c *Operation) oidcShareVpResult(w http.ResponseWriter, msg string) {
+func (c *Operation) oidcShareVpResult(w http.ResponseWriter, status int, result *vc) {
 	t, err := template.ParseFiles(c.oidcShareVpHTML)
 	if err != nil {
 		c.writeErrorResponse(w, http.StatusInternalServerError,
@@ -812,8 +1241,9 @@ func (c *Operation) oidcShareVpResult(w http.ResponseWriter, msg string) {
 	}
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
 
-	if err := t.Execute(w, vc{Msg: msg}); err != nil {
+	if err := t.Execute(w, result); err != nil {
 		logger.Errorf(fmt.Sprintf("failed execute html template: %s", err.Error()))
 	}
 }
@@ -835,7 +1265,7 @@ func (c *Operation) didcommDemoResult(w http.ResponseWriter, data, flowType stri
 }
 
 // verify function verifies the input data and parse the response to provided template
-func (c *Operation) verify(verifyReq interface{}, inputData, htmlTemplate string,
+func (c *Operation) verify(vcsProfileID string, verifyReq interface{}, inputData, htmlTemplate string,
 	w http.ResponseWriter, r *http.Request) {
 	t, err := template.ParseFiles(htmlTemplate)
 	if err != nil {
@@ -845,7 +1275,7 @@ func (c *Operation) verify(verifyReq interface{}, inputData, htmlTemplate string
 		return
 	}
 
-	resp, httpErr := c.callVerifyPresentation(verifyReq)
+	resp, httpErr := c.callVerifyPresentation(vcsProfileID, verifyReq)
 	if httpErr != nil {
 		c.writeErrorResponse(w, http.StatusBadRequest,
 			fmt.Sprintf("failed to verify: %s", httpErr.Error()))
@@ -890,8 +1320,312 @@ func (c *Operation) verify(verifyReq interface{}, inputData, htmlTemplate string
 	}
 }
 
-func (c *Operation) callVerifyPresentation(verifyReq interface{}) (*http.Response, error) {
-	endpoint := fmt.Sprintf(verifyPresentationURLFormat, verifierProfileID)
+// verifyLocally verifies the presentation of the form with the embedded verifier and parses the report to the
+// vp template on failure. It returns false, without writing the response, if the checks passed and the
+// presentation is to be verified by the VCS.
+func (c *Operation) verifyLocally(inputData string, checks []string, challenge, domain string,
+	w http.ResponseWriter, r *http.Request) bool {
+	t, err := template.ParseFiles(c.vpHTML)
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusInternalServerError,
+			fmt.Sprintf("unable to load html: %s", err.Error()))
+
+		return true
+	}
+
+	report, err := c.localVerifier.VerifyPresentation([]byte(r.Form.Get(inputData)), checks, challenge, domain)
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to verify: %s", err.Error()))
+
+		return true
+	}
+
+	result := vc{Msg: "Successfully verified", Data: r.Form.Get(inputData)}
+
+	switch {
+	case !report.Verified:
+		reportBytes, e := json.Marshal(report)
+		if e != nil {
+			c.writeErrorResponse(w, http.StatusInternalServerError,
+				fmt.Sprintf("failed to marshal verification report: %s", e.Error()))
+
+			return true
+		}
+
+		result = vc{Msg: string(reportBytes), Failed: true}
+	case !c.localVerification:
+		return false
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	if err := t.Execute(w, result); err != nil {
+		logger.Errorf(fmt.Sprintf("failed execute html template: %s", err.Error()))
+	}
+
+	return true
+}
+
+// writeVerificationReport writes the report of the embedded verifier, with bad request status if the verification
+// failed.
+func (c *Operation) writeVerificationReport(w http.ResponseWriter, report *verifier.Report, err error) {
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to verify: %s", err.Error()))
+
+		return
+	}
+
+	reportBytes, err := json.Marshal(report)
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusInternalServerError,
+			fmt.Sprintf("failed to marshal verification report: %s", err.Error()))
+
+		return
+	}
+
+	status := http.StatusOK
+	if !report.Verified {
+		status = http.StatusBadRequest
+	}
+
+	w.Header().Set("Content-Type", httpContentTypeJSON)
+	c.writeResponse(w, status, reportBytes)
+}
+
+// localChecks returns the checks of the local verifier, the requested ones if it replaces the VCS and only the
+// trust check otherwise. The trust check is added to the requested checks if the trust registry is configured.
+func (c *Operation) localChecks(checks []string) []string {
+	if !c.localVerification {
+		return []string{verifier.CheckTrust}
+	}
+
+	if c.trustRegistry == nil {
+		return checks
+	}
+
+	requested := false
+
+	for _, check := range checks {
+		if check == verifier.CheckTrust {
+			return checks
+		}
+
+		requested = requested || check != ""
+	}
+
+	// all of the checks, including the trust check, are run if none is requested
+	if !requested {
+		return checks
+	}
+
+	return append(append([]string{}, checks...), verifier.CheckTrust)
+}
+
+// vcsChecks returns the checks of the VCS, without the trust check run by the RP.
+func vcsChecks(checks []string) []string {
+	var result []string
+
+	for _, check := range checks {
+		if check != verifier.CheckTrust {
+			result = append(result, check)
+		}
+	}
+
+	return result
+}
+
+// putTrustedIssuer adds the trusted issuer, replacing an existing one with the same DID.
+func (c *Operation) putTrustedIssuer(w http.ResponseWriter, r *http.Request) {
+	issuer := &trustregistry.Issuer{}
+
+	err := json.NewDecoder(r.Body).Decode(issuer)
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to decode request: %s", err.Error()))
+
+		return
+	}
+
+	saved, err := c.trustRegistry.Put(issuer)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, trustregistry.ErrInvalidIssuer) {
+			status = http.StatusBadRequest
+		}
+
+		c.writeErrorResponse(w, status, fmt.Sprintf("failed to save trusted issuer : %s", err))
+
+		return
+	}
+
+	c.writeJSONResponse(w, http.StatusCreated, saved)
+}
+
+// listTrustedIssuers returns the trusted issuers of the admin API, the trust list file and the remote trust list.
+func (c *Operation) listTrustedIssuers(w http.ResponseWriter, _ *http.Request) {
+	issuers, err := c.trustRegistry.List()
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to list trusted issuers : %s", err))
+
+		return
+	}
+
+	c.writeJSONResponse(w, http.StatusOK, issuers)
+}
+
+func (c *Operation) getTrustedIssuer(w http.ResponseWriter, r *http.Request) {
+	issuer, err := c.trustRegistry.Get(mux.Vars(r)["did"])
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, trustregistry.ErrIssuerNotFound) {
+			status = http.StatusNotFound
+		}
+
+		c.writeErrorResponse(w, status, fmt.Sprintf("failed to get trusted issuer : %s", err))
+
+		return
+	}
+
+	c.writeJSONResponse(w, http.StatusOK, issuer)
+}
+
+// deleteTrustedIssuer removes the trusted issuer added through the admin API.
+func (c *Operation) deleteTrustedIssuer(w http.ResponseWriter, r *http.Request) {
+	err := c.trustRegistry.Delete(mux.Vars(r)["did"])
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, trustregistry.ErrIssuerNotFound) {
+			status = http.StatusNotFound
+		}
+
+		c.writeErrorResponse(w, status, fmt.Sprintf("failed to delete trusted issuer : %s", err))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requestedProfile returns the verifier profile of the request, the default one if none is requested. The error
+// response is written if the profile can't be found.
+func (c *Operation) requestedProfile(w http.ResponseWriter, profileID string) (*verifierprofile.Profile, bool) {
+	profile, err := c.verifierProfiles.Get(profileID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, verifierprofile.ErrProfileNotFound) {
+			status = http.StatusNotFound
+		}
+
+		c.writeErrorResponse(w, status, fmt.Sprintf("failed to get verifier profile %s : %s", profileID, err))
+
+		return nil, false
+	}
+
+	return profile, true
+}
+
+// requestedOIDC4VP returns the OIDC4VP settings of the verifier profile of the request. The error response is
+// written if the profile can't be found or has OIDC4VP disabled.
+func (c *Operation) requestedOIDC4VP(w http.ResponseWriter, profileID string) (*verifierprofile.OIDC4VP, bool) {
+	profile, ok := c.requestedProfile(w, profileID)
+	if !ok {
+		return nil, false
+	}
+
+	if profile.OIDC4VP == nil {
+		c.writeErrorResponse(w, http.StatusNotFound,
+			fmt.Sprintf("verifier profile %s doesn't support oidc4vp", profile.ID))
+
+		return nil, false
+	}
+
+	return profile.OIDC4VP, true
+}
+
+// profileChecks returns the requested checks, the default checks of the profile if none is requested.
+func profileChecks(profile *verifierprofile.Profile, checks []string) []string {
+	for _, check := range checks {
+		if check != "" {
+			return checks
+		}
+	}
+
+	if len(profile.Checks) > 0 {
+		return profile.Checks
+	}
+
+	return checks
+}
+
+// putVerifierProfile registers the verifier profile, replacing an existing one with the same ID.
+func (c *Operation) putVerifierProfile(w http.ResponseWriter, r *http.Request) {
+	profile := &verifierprofile.Profile{}
+
+	err := json.NewDecoder(r.Body).Decode(profile)
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to decode request: %s", err.Error()))
+
+		return
+	}
+
+	err = c.verifierProfiles.Put(profile)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, verifierprofile.ErrInvalidProfile) {
+			status = http.StatusBadRequest
+		}
+
+		c.writeErrorResponse(w, status, fmt.Sprintf("failed to save verifier profile : %s", err))
+
+		return
+	}
+
+	c.writeJSONResponse(w, http.StatusCreated, profile)
+}
+
+// listVerifierProfiles returns the registered and the built-in verifier profiles.
+func (c *Operation) listVerifierProfiles(w http.ResponseWriter, _ *http.Request) {
+	profiles, err := c.verifierProfiles.List()
+	if err != nil {
+		c.writeErrorResponse(w, http.StatusInternalServerError,
+			fmt.Sprintf("failed to list verifier profiles : %s", err))
+
+		return
+	}
+
+	c.writeJSONResponse(w, http.StatusOK, profiles)
+}
+
+func (c *Operation) getVerifierProfile(w http.ResponseWriter, r *http.Request) {
+	profile, ok := c.requestedProfile(w, mux.Vars(r)["profileID"])
+	if !ok {
+		return
+	}
+
+	c.writeJSONResponse(w, http.StatusOK, profile)
+}
+
+func (c *Operation) deleteVerifierProfile(w http.ResponseWriter, r *http.Request) {
+	err := c.verifierProfiles.Delete(mux.Vars(r)["profileID"])
+	if err != nil {
+		status := http.StatusInternalServerError
+
+		switch {
+		case errors.Is(err, verifierprofile.ErrProfileNotFound):
+			status = http.StatusNotFound
+		case errors.Is(err, verifierprofile.ErrBuiltInProfile):
+			status = http.StatusBadRequest
+		}
+
+		c.writeErrorResponse(w, status, fmt.Sprintf("failed to delete verifier profile : %s", err))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Operation) callVerifyPresentation(vcsProfileID string, verifyReq interface{}) (*http.Response, error) {
+	endpoint := fmt.Sprintf(verifyPresentationURLFormat, vcsProfileID)
 
 	reqBytes, err := json.Marshal(verifyReq)
 	if err != nil {
@@ -902,8 +1636,8 @@ func (c *Operation) callVerifyPresentation(verifyReq interface{}) (*http.Respons
 		c.requestTokens[vcsVerifierRequestTokenName])
 }
 
-func (c *Operation) callVerifyCredential(verifyReq interface{}) (*http.Response, error) {
-	endpoint := fmt.Sprintf(verifyCredentialURLFormat, verifierProfileID)
+func (c *Operation) callVerifyCredential(vcsProfileID string, verifyReq interface{}) (*http.Response, error) {
+	endpoint := fmt.Sprintf(verifyCredentialURLFormat, vcsProfileID)
 
 	reqBytes, err := json.Marshal(verifyReq)
 	if err != nil {
@@ -976,6 +1710,16 @@ func createStore(p storage.Provider) (storage.Store, error) {
 	return p.OpenStore(transientStoreName)
 }
 
+func createWebhookEventStore(config *Config) (*eventstore.Store, error) {
+	var opts []eventstore.Option
+
+	if config.WebhookEventTTL > 0 {
+		opts = append(opts, eventstore.WithTTL(config.WebhookEventTTL))
+	}
+
+	return eventstore.New(config.TransientStoreProvider, webhookEventStoreName, opts...)
+}
+
 // writeResponse writes interface value to response
 func (c *Operation) writeResponse(rw http.ResponseWriter, status int, data []byte) {
 	rw.WriteHeader(status)
@@ -985,6 +1729,15 @@ func (c *Operation) writeResponse(rw http.ResponseWriter, status int, data []byt
 	}
 }
 
+func (c *Operation) writeJSONResponse(rw http.ResponseWriter, status int, v interface{}) {
+	rw.Header().Set("Content-Type", httpContentTypeJSON)
+	rw.WriteHeader(status)
+
+	if err := json.NewEncoder(rw).Encode(v); err != nil {
+		logger.Errorf("Unable to send response, %s", err)
+	}
+}
+
 // issueAccessToken issue token.
 func (c *Operation) issueAccessToken(oidcProviderURL, clientID, secret string, scopes []string) (string, error) {
 	conf := clientcredentials.Config{
@@ -9,6 +9,7 @@ package operation
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,14 +18,28 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	memstore "github.com/hyperledger/aries-framework-go/component/storageutil/mem"
 	mockstore "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/ld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	mockldstore "github.com/hyperledger/aries-framework-go/pkg/mock/ld"
+	ldstore "github.com/hyperledger/aries-framework-go/pkg/store/ld"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
 	"github.com/stretchr/testify/require"
 	edgesvcops "github.com/trustbloc/vcs/pkg/restapi/verifier/operation"
+
+	"github.com/trustbloc/sandbox/pkg/kms"
+	"github.com/trustbloc/sandbox/pkg/pex"
+	"github.com/trustbloc/sandbox/pkg/trustregistry"
+	"github.com/trustbloc/sandbox/pkg/verifier"
+	"github.com/trustbloc/sandbox/pkg/verifierprofile"
 )
 
 const (
@@ -111,6 +126,40 @@ const presDefQuery = `{
             ]
         }`
 
+// shareDefinition is the presentation definition of the OIDC share requests of the tests.
+const shareDefinition = `{
+	"id": "share-definition",
+	"input_descriptors": [{
+		"id": "credential",
+		"schema": [{"uri": "https://www.w3.org/2018/credentials#VerifiableCredential"}],
+		"constraints": {
+			"fields": [{
+				"id": "subject",
+				"path": ["$.credentialSubject.id"],
+				"filter": {"type": "string", "pattern": "^did:key:"}
+			}]
+		}
+	}]
+}`
+
+// unsignedVC and unsignedVP have no proofs, they're verified locally.
+const (
+	unsignedVC = `{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"id": "http://example.com/credentials/1",
+		"type": "VerifiableCredential",
+		"issuer": "did:example:issuer",
+		"issuanceDate": "2022-01-01T00:00:00Z",
+		"credentialSubject": {"id": "did:example:holder"}
+	}`
+	unsignedVP = `{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": "VerifiablePresentation",
+		"holder": "did:example:holder",
+		"verifiableCredential": [` + unsignedVC + `]
+	}`
+)
+
 const OAuth2TokenPath = "/oauth2/token" //nolint:gosec
 
 func TestNew(t *testing.T) {
@@ -120,7 +169,62 @@ func TestNew(t *testing.T) {
 		svc, err := New(config)
 		require.NoError(t, err)
 		require.NotNil(t, svc)
-		require.Equal(t, 12, len(svc.GetRESTHandlers()))
+		require.Equal(t, 19, len(svc.GetRESTHandlers()))
+	})
+
+	t.Run("success - verifier profiles", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		profile, err := svc.verifierProfiles.Get("prc")
+		require.NoError(t, err)
+		require.Equal(t, "prc-verifier", profile.VCSProfileID)
+	})
+
+	t.Run("error if verifier profiles are invalid", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VerifierProfilesPath = filepath.Join(t.TempDir(), "missing.json")
+
+		_, err := New(config)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to create verifier profiles : read verifier profiles")
+	})
+
+	t.Run("success - trust registry", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.TrustRegistryEnabled = true
+		config.TrustRegistryFile = trustListFile(t)
+		config.TrustRegistryURL = "https://example.com/trust-list"
+		config.TrustRegistryRefreshInterval = time.Minute
+		config.TrustMinAssuranceLevel = "substantial"
+
+		svc, err := New(config)
+		require.NoError(t, err)
+		require.NotNil(t, svc.trustRegistry)
+		require.NotNil(t, svc.localVerifier)
+		require.False(t, svc.localVerification)
+		require.Equal(t, 23, len(svc.GetRESTHandlers()))
+	})
+
+	t.Run("error if trust registry is invalid", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.TrustRegistryEnabled = true
+		config.TrustMinAssuranceLevel = "medium"
+
+		_, err := New(config)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to create trust registry : unsupported assurance level medium")
 	})
 
 	t.Run("error if oidc provider is invalid", func(t *testing.T) {
@@ -185,6 +289,52 @@ func TestWellKnownConfig(t *testing.T) {
 		require.Equal(t, http.StatusOK, rr.Code)
 		require.Contains(t, rr.Body.String(), "{}")
 	})
+
+	t.Run("verifier profile", func(t *testing.T) {
+		vcs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"path": %q}`, r.URL.Path)
+		}))
+
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VCSV1URL = vcs.URL
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		for profile, vcsProfile := range map[string]string{
+			"prc": "prc-oidc4vp",
+			"":    verifierprofile.DefaultOIDC4VPProfileID,
+		} {
+			rr := httptest.NewRecorder()
+			svc.wellKnownConfig(rr, httptest.NewRequest(http.MethodGet,
+				wellKnownConfigGetRequestPath+"?profile="+profile, nil))
+			require.Equal(t, http.StatusOK, rr.Code)
+			require.JSONEq(t, fmt.Sprintf(`{"path": "/verifier/profiles/%s/well-known/did-config"}`, vcsProfile),
+				rr.Body.String())
+		}
+
+		// the DID configurations are cached
+		vcs.Close()
+
+		rr := httptest.NewRecorder()
+		svc.wellKnownConfig(rr, httptest.NewRequest(http.MethodGet, wellKnownConfigGetRequestPath+"?profile=prc", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), "prc-oidc4vp")
+
+		for profile, errMsg := range map[string]string{
+			"degree":  "verifier profile degree doesn't support oidc4vp",
+			"unknown": "failed to get verifier profile unknown : verifier profile not found",
+		} {
+			rr = httptest.NewRecorder()
+			svc.wellKnownConfig(rr, httptest.NewRequest(http.MethodGet,
+				wellKnownConfigGetRequestPath+"?profile="+profile, nil))
+			require.Equal(t, http.StatusNotFound, rr.Code)
+			require.Contains(t, rr.Body.String(), errMsg)
+		}
+	})
 }
 
 func TestOpenID4VPGetQR(t *testing.T) {
@@ -262,6 +412,56 @@ func TestOpenID4VPGetQR(t *testing.T) {
 		svc.openID4VPGetQR(rr, &http.Request{Method: http.MethodGet})
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
+
+	t.Run("verifier profile", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-Type", "application/json")
+
+			if req.RequestURI == OAuth2TokenPath {
+				_, err := io.WriteString(res, `{"access_token": "ACCESS_TOKEN", "token_type": "bearer"}`)
+
+				require.NoError(t, err)
+
+				return
+			}
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, fmt.Sprintf(initiateOidcInteractionURLFormat, "prc-oidc4vp"), req.URL.Path)
+			require.JSONEq(t, `{"presentationDefinitionId": "prc-definition", "purpose": "background check"}`,
+				string(body))
+
+			_, err = io.WriteString(res, `{"authorizationRequest": "data", "txID": "tx"}`)
+
+			require.NoError(t, err)
+		}))
+		defer testServer.Close()
+
+		config.AccessTokenURL = testServer.URL
+		config.APIGatewayURL = testServer.URL
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		svc.openID4VPGetQR(rr, httptest.NewRequest(http.MethodGet, openID4VPGetQRPath+"?profile=prc", nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.JSONEq(t, `{"qrText": "data", "txID": "tx"}`, rr.Body.String())
+
+		for profile, errMsg := range map[string]string{
+			"degree":  "verifier profile degree doesn't support oidc4vp",
+			"unknown": "verifier profile not found",
+		} {
+			rr = httptest.NewRecorder()
+			svc.openID4VPGetQR(rr, httptest.NewRequest(http.MethodGet, openID4VPGetQRPath+"?profile="+profile, nil))
+			require.Equal(t, http.StatusNotFound, rr.Code)
+			require.Contains(t, rr.Body.String(), errMsg)
+		}
+	})
 }
 
 func TestRetrieveInteractionsClaim(t *testing.T) {
@@ -467,6 +667,114 @@ func TestVerifyVP(t *testing.T) {
 		svc.verifyVP(rr, &http.Request{Form: m})
 		require.Equal(t, http.StatusOK, rr.Code)
 	})
+
+	t.Run("test local verification", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.LocalVerification = true
+		config.VPHTML = msgTemplate(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}, "checks": {"dates"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "Successfully verified", rr.Body.String())
+
+		rr = httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}, "checks": {"proof"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), "presentation has no proof")
+
+		rr = httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {"invalid"}}})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to verify: invalid verification input")
+
+		svc.vpHTML = ""
+
+		rr = httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}}})
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "unable to load html")
+	})
+
+	t.Run("test verifier profile", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VCSURL = newProfileVerifierVCS(t, fmt.Sprintf(verifyPresentationURLFormat, "prc-verifier"),
+			[]string{"proof"}).URL
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		// the checks of the profile apply if none is requested
+		rr := httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {validVP}, "checks": {""}, "profile": {"prc"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {validVP}, "profile": {"unknown"}}})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "verifier profile not found")
+	})
+}
+
+func TestVerifyVPTrust(t *testing.T) {
+	t.Run("vcs verification", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.TrustRegistryEnabled = true
+		config.VPHTML = msgTemplate(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		svc.client = &mockHTTPClient{postValue: &http.Response{StatusCode: http.StatusOK, Body: nil}}
+
+		rr := httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}, "checks": {"proof,trust"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), "issuer did:example:issuer isn&#39;t in the trust registry")
+
+		_, err = svc.trustRegistry.Put(&trustregistry.Issuer{DID: "did:example:issuer"})
+		require.NoError(t, err)
+
+		rr = httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}, "checks": {"proof,trust"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "Successfully verified", rr.Body.String())
+	})
+
+	t.Run("local verification", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.LocalVerification = true
+		config.TrustRegistryEnabled = true
+		config.VPHTML = msgTemplate(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}, "checks": {"dates"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), "isn&#39;t in the trust registry")
+
+		_, err = svc.trustRegistry.Put(&trustregistry.Issuer{DID: "did:example:issuer"})
+		require.NoError(t, err)
+
+		rr = httptest.NewRecorder()
+		svc.verifyVP(rr, &http.Request{Form: url.Values{"vpDataInput": {unsignedVP}, "checks": {"dates"}}})
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "Successfully verified", rr.Body.String())
+	})
 }
 
 func TestCreateOIDCRequest(t *testing.T) {
@@ -710,6 +1018,7 @@ func TestCreateOIDCShareRequest(t *testing.T) {
 		svc.createOIDCShareRequest(w, newCreateOIDCShareHTTPRequest(vpRequestBytes))
 		require.Equal(t, http.StatusOK, w.Code)
 		require.Contains(t, w.Body.String(), "https://testingwallet/oidc/share")
+		require.Contains(t, w.Body.String(), "nonce=")
 	})
 
 	t.Run("bad request if incorrect vp request", func(t *testing.T) {
@@ -724,6 +1033,33 @@ func TestCreateOIDCShareRequest(t *testing.T) {
 		require.Contains(t, w.Body.String(), "failed to decode request")
 	})
 
+	t.Run("presentation definition of the verifier profile", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		for profile, expected := range map[string]struct {
+			status int
+			msg    string
+		}{
+			"prc":     {http.StatusOK, "3bc5ac72-bdd7-42de-aeba-45816cc4f776"},
+			"degree":  {http.StatusBadRequest, "missing presentation definition, verifier profile degree has none"},
+			"unknown": {http.StatusNotFound, "verifier profile not found"},
+		} {
+			vpRequestBytes, err := json.Marshal(oidcVpRequest{Profile: profile})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			svc.createOIDCShareRequest(w, newCreateOIDCShareHTTPRequest(vpRequestBytes))
+			require.Equal(t, expected.status, w.Code)
+			require.Contains(t, w.Body.String(), expected.msg)
+		}
+	})
+
 	t.Run("internal server error if transient store fails", func(t *testing.T) {
 		vpRequest := oidcVpRequest{
 			PresentationDefinition: json.RawMessage(presDefQuery),
@@ -735,6 +1071,8 @@ func TestCreateOIDCShareRequest(t *testing.T) {
 		config.TransientStoreProvider = &mockstore.Provider{
 			OpenStoreReturn: &mockstore.Store{
 				ErrPut: errors.New("test"),
+				// the built-in verifier profile applies
+				ErrGet: storage.ErrDataNotFound,
 			},
 		}
 
@@ -744,34 +1082,88 @@ func TestCreateOIDCShareRequest(t *testing.T) {
 		w := httptest.NewRecorder()
 		svc.createOIDCShareRequest(w, newCreateOIDCShareHTTPRequest(vpRequestBytes))
 		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "failed save oidc share state")
 	})
 }
 
 func TestHandleOIDCShareCallback(t *testing.T) {
-	idToken := "eyJhbGciOiJub25lIn0.eyJpc3MiOiJkZW1vLXZlcmlmaWVyIiwic3ViIjoiZGVtby12ZXJpZmllciIsImF1ZCI6ImRlbW8tdmVyaWZpZXIiLCJpYXQiOjE2NDk1NzQwODg0NzAsImV4cCI6MTY0OTU3NDA4ODQ3MH0.e30"                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                                   //nolint:gosec,lll
-	vpToken := "%7B%22@context%22:%5B%22https://www.w3.org/2018/credentials/v1%22%5D,%22holder%22:%22did:orb:uEiD_Qb302UEyoxn5yQD0ppr-ZNMWowmbvby4VnzTqNosDQ:EiCr2dgSRgrXUT-e5DZIlBdeUsXoFrKrVZ8ZQmYymCERKg%22,%22proof%22:%7B%22created%22:%222022-04-10T03:01:28.662-04:00%22,%22jws%22:%22eyJhbGciOiJFZERTQSIsImI2NCI6ZmFsc2UsImNyaXQiOlsiYjY0Il19..Tpg1a6Tj-i4SeljvZ7SixPkdzxe49HNDppDp-97hf-hQN-m4Bt_dRp8aWEWpo0XZCOk1hMiMXK3R71KAP6WlDw%22,%22proofPurpose%22:%22authentication%22,%22type%22:%22Ed25519Signature2018%22,%22verificationMethod%22:%22did:orb:uEiD_Qb302UEyoxn5yQD0ppr-ZNMWowmbvby4VnzTqNosDQ:EiCr2dgSRgrXUT-e5DZIlBdeUsXoFrKrVZ8ZQmYymCERKg#Y8US6IeIb7AIX4K1S2BjMfbk_ZuYRO_w9qN-aAJR2Ng%22%7D,%22type%22:%22VerifiablePresentation%22,%22verifiableCredential%22:%5B%7B%22@context%22:%5B%22https://www.w3.org/2018/credentials/v1%22,%22https://w3id.org/citizenship/v1%22%5D,%22credentialSubject%22:%7B%22birthCountry%22:%22Bahamas%22,%22birthDate%22:%221958-07-17%22,%22familyName%22:%22Pasteur%22,%22gender%22:%22Male%22,%22givenName%22:%22Louis%22,%22id%22:%22did:trustbloc:orb-1.local.trustbloc.dev:EiD6cBirl2gND93LLKQzDMX4XjR3F7W2v4dPJzd8bQpPYQ%22,%22lprCategory%22:%22C09%22,%22lprNumber%22:%22999-999-999%22,%22residentSince%22:%222015-01-01%22%7D,%22description%22:%22Permanent%20Resident%20Card%20of%20Mr.Louis%20Pasteur%22,%22id%22:%22urn:uuid:df436131-dda9-408b-8d8b-c999d742ba83%22,%22issuanceDate%22:%222022-04-10T06:27:26.004244495Z%22,%22issuer%22:%7B%22id%22:%22https://demo-issuer.local.trustbloc.dev/didcomm%22,%22name%22:%22TrustBloc%20-%20Permanent%20Resident%20Card%20Issuer%22%7D,%22name%22:%22Permanent%20Resident%20Card%22,%22type%22:%5B%22VerifiableCredential%22,%22PermanentResidentCard%22%5D%7D%5D%7D" //nolint:gosec,lll
+	walletKey := createWalletKey(t)
+	nonce := uuid.NewString()
+	vp := fmt.Sprintf(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": "VerifiablePresentation",
+		"holder": %q,
+		"verifiableCredential": [{
+			"@context": ["https://www.w3.org/2018/credentials/v1"],
+			"id": "http://example.com/credentials/1",
+			"type": "VerifiableCredential",
+			"issuer": "did:example:issuer",
+			"issuanceDate": "2022-01-01T00:00:00Z",
+			"credentialSubject": {"id": %q}
+		}]
+	}`, walletKey.DID, walletKey.DID)
 
 	t.Run("success handle call back", func(t *testing.T) {
-		state := uuid.New().String()
+		vcs := newVerifierVCS(t, nonce, http.StatusOK)
+
 		config, configCleanup := config(t)
 		defer configCleanup()
 
-		storeToReturnFromMockProvider, err := memstore.NewProvider().OpenStore("mockstoretoreturn")
-		require.NoError(t, err)
+		config.VCSURL = vcs.URL
+		config.OIDCShareVPHTML = msgTemplate(t)
 
-		err = storeToReturnFromMockProvider.Put(state, []byte(presDefQuery))
+		o, err := New(config)
 		require.NoError(t, err)
 
-		config.TransientStoreProvider = &mockstore.Provider{
-			OpenStoreReturn: storeToReturnFromMockProvider,
-		}
+		state := saveShareState(t, o, nonce)
+		idToken := createIDToken(t, walletKey, idTokenClaims(walletKey, nonce))
 
-		o, err := New(config)
+		// the state expires
+		tags, err := o.oidcShareStates.GetTags(state)
 		require.NoError(t, err)
+		require.Len(t, tags, 1)
 
 		result := httptest.NewRecorder()
-		o.handleOIDCShareCallback(result, newOIDCShareCallback(state, idToken, vpToken))
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(state, idToken, vp))
 		require.Equal(t, http.StatusOK, result.Code)
+		require.Contains(t, result.Body.String(), "Successfully Received OIDC verifiable Presentation")
+
+		// the state is single use
+		result = httptest.NewRecorder()
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(state, idToken, vp))
+		require.Equal(t, http.StatusInternalServerError, result.Code)
+		require.Contains(t, result.Body.String(), "failed to get oidc state data")
+	})
+
+	t.Run("presentation submission evaluated", func(t *testing.T) {
+		vcs := newVerifierVCS(t, nonce, http.StatusOK)
+
+		config, configCleanup := config(t)
+		defer configCleanup()
+
+		config.VCSURL = vcs.URL
+
+		o, err := New(config)
+		require.NoError(t, err)
+
+		state, err := o.consumeOIDCShareState(saveShareState(t, o, nonce))
+		require.NoError(t, err)
+
+		submission, err := o.verifyOIDCShareResponse(state,
+			createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp)
+		require.NoError(t, err)
+		require.Equal(t, "share-definition", submission.DefinitionID)
+		require.Equal(t, "submission", submission.SubmissionID)
+		require.Len(t, submission.Descriptors, 1)
+		require.Equal(t, "credential", submission.Descriptors[0].ID)
+		require.Equal(t, &pex.Credential{
+			ID:     "http://example.com/credentials/1",
+			Types:  []string{"VerifiableCredential"},
+			Issuer: "did:example:issuer",
+		}, submission.Descriptors[0].Credential)
+		require.Equal(t, []*pex.FieldValue{
+			{ID: "subject", Path: "$.credentialSubject.id", Value: walletKey.DID},
+		}, submission.Descriptors[0].Fields)
 	})
 
 	t.Run("error missing state", func(t *testing.T) {
@@ -803,45 +1195,236 @@ func TestHandleOIDCShareCallback(t *testing.T) {
 		require.Equal(t, http.StatusInternalServerError, result.Code)
 	})
 
-	t.Run("test oidc vp html not exist", func(t *testing.T) {
-		state := uuid.New().String()
+	t.Run("invalid state data", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		require.NoError(t, svc.oidcShareStates.PutWithTTL("state", []byte("invalid"), time.Minute))
+
+		result := httptest.NewRecorder()
+		svc.handleOIDCShareCallback(result, newOIDCShareCallback("state", "", ""))
+		require.Equal(t, http.StatusInternalServerError, result.Code)
+		require.Contains(t, result.Body.String(), "failed to unmarshal oidc share state")
+	})
+
+	t.Run("verification failures", func(t *testing.T) {
+		vcs := newVerifierVCS(t, nonce, http.StatusBadRequest)
+
 		config, configCleanup := config(t)
 		defer configCleanup()
-		config.OIDCShareVPHTML = ""
 
-		storeToReturnFromMockProvider, err := memstore.NewProvider().OpenStore("mockstoretoreturn")
-		require.NoError(t, err)
+		config.VCSURL = vcs.URL
+		config.OIDCShareVPHTML = msgTemplate(t)
 
-		err = storeToReturnFromMockProvider.Put(state, []byte(presDefQuery))
+		o, err := New(config)
 		require.NoError(t, err)
 
-		config.TransientStoreProvider = &mockstore.Provider{
-			OpenStoreReturn: storeToReturnFromMockProvider,
+		otherKey := createWalletKey(t)
+
+		noSubmission := idTokenClaims(walletKey, nonce)
+		delete(noSubmission, "_vp_token")
+
+		invalidSubmission := idTokenClaims(walletKey, nonce)
+		invalidSubmission["_vp_token"] = "invalid"
+
+		otherSubject := strings.Replace(vp, fmt.Sprintf(`"credentialSubject": {"id": %q}`, walletKey.DID),
+			`"credentialSubject": {"id": "did:example:subject"}`, 1)
+
+		tests := []struct {
+			name    string
+			idToken string
+			vp      string
+			err     string
+		}{
+			{
+				name:    "unsigned id_token",
+				idToken: "eyJhbGciOiJub25lIn0.eyJpc3MiOiJkZW1vLXZlcmlmaWVyIn0.",
+				vp:      vp,
+				err:     "invalid id_token",
+			},
+			{
+				name:    "id_token for another nonce",
+				idToken: createIDToken(t, walletKey, idTokenClaims(walletKey, "other")),
+				vp:      vp,
+				err:     "unexpected nonce",
+			},
+			{
+				name:    "invalid presentation",
+				idToken: createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)),
+				vp:      "invalid",
+				err:     "failed to parse presentation",
+			},
+			{
+				name:    "presentation of another holder",
+				idToken: createIDToken(t, otherKey, idTokenClaims(otherKey, nonce)),
+				vp:      vp,
+				err:     "doesn&#39;t match the id_token subject",
+			},
+			{
+				name:    "missing presentation submission",
+				idToken: createIDToken(t, walletKey, noSubmission),
+				vp:      vp,
+				err:     "missing presentation submission",
+			},
+			{
+				name:    "invalid presentation submission",
+				idToken: createIDToken(t, walletKey, invalidSubmission),
+				vp:      vp,
+				err:     "failed to unmarshal _vp_token claim",
+			},
+			{
+				name:    "presentation definition not satisfied",
+				idToken: createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)),
+				vp:      otherSubject,
+				err:     "presentation definition not satisfied",
+			},
+			{
+				name:    "presentation verification failed",
+				idToken: createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)),
+				vp:      vp,
+				err:     "presentation verification failed : revoked",
+			},
 		}
 
+		for _, tc := range tests {
+			state := saveShareState(t, o, nonce)
+
+			result := httptest.NewRecorder()
+			o.handleOIDCShareCallback(result, newOIDCShareCallback(state, tc.idToken, tc.vp))
+			require.Equal(t, http.StatusBadRequest, result.Code, tc.name)
+			require.Contains(t, result.Body.String(), tc.err, tc.name)
+		}
+	})
+
+	t.Run("verifier error", func(t *testing.T) {
+		config, configCleanup := config(t)
+		defer configCleanup()
+
 		o, err := New(config)
 		require.NoError(t, err)
 
-		o.oidcClient = &mockOIDCClient{}
+		o.client = &mockHTTPClient{postErr: errors.New("post error")}
+
+		state := saveShareState(t, o, nonce)
 
 		result := httptest.NewRecorder()
-		o.handleOIDCShareCallback(result, newOIDCShareCallback(state, idToken, vpToken))
-		require.Equal(t, http.StatusInternalServerError, result.Code)
-		require.Contains(t, result.Body.String(), "unable to load html")
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(state,
+			createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp))
+		require.Equal(t, http.StatusBadRequest, result.Code)
 	})
-}
 
-func TestVerifyDIDAuthHandler(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
-		config, cleanup := config(t)
-		defer cleanup()
+	t.Run("verifier profile", func(t *testing.T) {
+		config, configCleanup := config(t)
+		defer configCleanup()
 
-		svc, err := New(config)
+		config.VCSURL = newProfileVerifierVCS(t, fmt.Sprintf(verifyPresentationURLFormat, "degree-verifier"),
+			[]string{"proof", "credentialStatus"}).URL
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+		config.OIDCShareVPHTML = msgTemplate(t)
+
+		o, err := New(config)
 		require.NoError(t, err)
 
-		svc.client = &mockHTTPClient{postValue: &http.Response{
-			StatusCode: http.StatusOK, Body: nil,
-		}}
+		pd := &presexch.PresentationDefinition{}
+		require.NoError(t, json.Unmarshal([]byte(shareDefinition), pd))
+
+		for profile, status := range map[string]int{"degree": http.StatusOK, "unknown": http.StatusBadRequest} {
+			state := uuid.NewString()
+			require.NoError(t, o.saveOIDCShareState(state,
+				&oidcShareState{PresentationDefinition: pd, Nonce: nonce, ProfileID: profile}))
+
+			result := httptest.NewRecorder()
+			o.handleOIDCShareCallback(result, newOIDCShareCallback(state,
+				createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp))
+			require.Equal(t, status, result.Code)
+		}
+	})
+
+	t.Run("local verification", func(t *testing.T) {
+		config, configCleanup := config(t)
+		defer configCleanup()
+
+		config.LocalVerification = true
+		config.OIDCShareVPHTML = msgTemplate(t)
+
+		o, err := New(config)
+		require.NoError(t, err)
+
+		state := saveShareState(t, o, nonce)
+
+		result := httptest.NewRecorder()
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(state,
+			createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp))
+		require.Equal(t, http.StatusBadRequest, result.Code)
+		require.Contains(t, result.Body.String(),
+			"presentation verification failed : proof check of presentation : presentation has no proof")
+	})
+
+	t.Run("trust registry", func(t *testing.T) {
+		vcs := newVerifierVCS(t, nonce, http.StatusOK)
+
+		config, configCleanup := config(t)
+		defer configCleanup()
+
+		config.VCSURL = vcs.URL
+		config.TrustRegistryEnabled = true
+		config.OIDCShareVPHTML = msgTemplate(t)
+
+		o, err := New(config)
+		require.NoError(t, err)
+
+		result := httptest.NewRecorder()
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(saveShareState(t, o, nonce),
+			createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp))
+		require.Equal(t, http.StatusBadRequest, result.Code)
+		require.Contains(t, result.Body.String(), "presentation verification failed : trust check of "+
+			"http://example.com/credentials/1 : issuer not trusted : issuer did:example:issuer isn&#39;t in the trust registry")
+
+		_, err = o.trustRegistry.Put(&trustregistry.Issuer{DID: "did:example:issuer"})
+		require.NoError(t, err)
+
+		result = httptest.NewRecorder()
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(saveShareState(t, o, nonce),
+			createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp))
+		require.Equal(t, http.StatusOK, result.Code)
+	})
+
+	t.Run("test oidc vp html not exist", func(t *testing.T) {
+		vcs := newVerifierVCS(t, nonce, http.StatusOK)
+
+		config, configCleanup := config(t)
+		defer configCleanup()
+
+		config.VCSURL = vcs.URL
+		config.OIDCShareVPHTML = ""
+
+		o, err := New(config)
+		require.NoError(t, err)
+
+		state := saveShareState(t, o, nonce)
+
+		result := httptest.NewRecorder()
+		o.handleOIDCShareCallback(result, newOIDCShareCallback(state,
+			createIDToken(t, walletKey, idTokenClaims(walletKey, nonce)), vp))
+		require.Equal(t, http.StatusInternalServerError, result.Code)
+		require.Contains(t, result.Body.String(), "unable to load html")
+	})
+}
+
+func TestVerifyDIDAuthHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		svc.client = &mockHTTPClient{postValue: &http.Response{
+			StatusCode: http.StatusOK, Body: nil,
+		}}
 
 		reqBytes, err := json.Marshal(&verifyPresentationRequest{
 			Checks:    []string{},
@@ -926,6 +1509,79 @@ func TestVerifyDIDAuthHandler(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, rr.Code)
 		require.Contains(t, rr.Body.String(), "failed to verify presentation")
 	})
+
+	t.Run("local verification", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.LocalVerification = true
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		verify := func(checks ...string) *httptest.ResponseRecorder {
+			reqBytes, e := json.Marshal(&verifyPresentationRequest{Checks: checks, VP: []byte(unsignedVP)})
+			require.NoError(t, e)
+
+			rr := httptest.NewRecorder()
+			svc.verifyPresentation(rr, httptest.NewRequest(http.MethodPost, verifyPresentationPath,
+				bytes.NewReader(reqBytes)))
+
+			return rr
+		}
+
+		rr := verify("dates")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		report := &verifier.Report{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), report))
+		require.True(t, report.Verified)
+		require.Equal(t, []*verifier.CheckResult{
+			{Check: verifier.CheckDates, Target: "http://example.com/credentials/1"},
+		}, report.Checks)
+
+		rr = verify("proof")
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		report = &verifier.Report{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), report))
+		require.False(t, report.Verified)
+		require.Equal(t, &verifier.CheckResult{
+			Check: verifier.CheckProof, Target: "presentation", Error: "presentation has no proof",
+		}, report.Checks[0])
+
+		rr = verify("trust")
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(),
+			"failed to verify: invalid verification input : trust registry isn't configured")
+	})
+
+	t.Run("verifier profile", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VCSURL = newProfileVerifierVCS(t, fmt.Sprintf(verifyPresentationURLFormat, "prc-verifier"),
+			[]string{"credentialStatus"}).URL
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		for profile, status := range map[string]int{"prc": http.StatusOK, "unknown": http.StatusNotFound} {
+			reqBytes, err := json.Marshal(&verifyPresentationRequest{
+				Checks:  []string{"credentialStatus"},
+				VP:      []byte(validVP),
+				Profile: profile,
+			})
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+
+			svc.verifyPresentation(rr, httptest.NewRequest(http.MethodPost, verifyPresentationPath,
+				bytes.NewReader(reqBytes)))
+			require.Equal(t, status, rr.Code)
+		}
+	})
 }
 
 func TestVerifyCredential(t *testing.T) {
@@ -1019,6 +1675,415 @@ func TestVerifyCredential(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, rr.Code)
 		require.Contains(t, rr.Body.String(), "failed to verify credential")
 	})
+
+	t.Run("local verification", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.LocalVerification = true
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		verify := func(vc string, checks ...string) *httptest.ResponseRecorder {
+			reqBytes, e := json.Marshal(&verifyCredentialRequest{Checks: checks, VC: []byte(vc)})
+			require.NoError(t, e)
+
+			rr := httptest.NewRecorder()
+			svc.verifyCredential(rr, httptest.NewRequest(http.MethodPost, verifyCredentialPath,
+				bytes.NewReader(reqBytes)))
+
+			return rr
+		}
+
+		rr := verify(unsignedVC, "dates", "credentialStatus")
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, httpContentTypeJSON, rr.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"verified": true, "checks": [
+			{"check": "dates", "target": "http://example.com/credentials/1"},
+			{"check": "credentialStatus", "target": "http://example.com/credentials/1"}
+		]}`, rr.Body.String())
+
+		rr = verify(unsignedVC)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "credential has no proof")
+
+		rr = verify(`"invalid"`)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to verify: invalid verification input : parse credential")
+	})
+
+	t.Run("verifier profile", func(t *testing.T) {
+		config, cleanup := config(t)
+		defer cleanup()
+
+		config.VCSURL = newProfileVerifierVCS(t, fmt.Sprintf(verifyCredentialURLFormat, "prc-verifier"),
+			[]string{"proof"}).URL
+		config.VerifierProfilesPath = verifierProfilesFile(t)
+
+		svc, err := New(config)
+		require.NoError(t, err)
+
+		for profile, status := range map[string]int{"prc": http.StatusOK, "unknown": http.StatusNotFound} {
+			reqBytes, err := json.Marshal(&verifyCredentialRequest{VC: []byte(validVC), Profile: profile})
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+
+			svc.verifyCredential(rr, httptest.NewRequest(http.MethodPost, verifyCredentialPath,
+				bytes.NewReader(reqBytes)))
+			require.Equal(t, status, rr.Code)
+		}
+	})
+}
+
+func TestVerifyCredentialTrust(t *testing.T) {
+	config, cleanup := config(t)
+	defer cleanup()
+
+	config.TrustRegistryEnabled = true
+
+	svc, err := New(config)
+	require.NoError(t, err)
+
+	svc.client = &mockHTTPClient{postValue: &http.Response{StatusCode: http.StatusOK, Body: nil}}
+
+	verify := func() *httptest.ResponseRecorder {
+		reqBytes, e := json.Marshal(&verifyCredentialRequest{Checks: []string{"proof"}, VC: []byte(unsignedVC)})
+		require.NoError(t, e)
+
+		rr := httptest.NewRecorder()
+		svc.verifyCredential(rr, httptest.NewRequest(http.MethodPost, verifyCredentialPath, bytes.NewReader(reqBytes)))
+
+		return rr
+	}
+
+	rr := verify()
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.JSONEq(t, `{"verified": false, "checks": [{
+		"check": "trust", "target": "http://example.com/credentials/1",
+		"error": "issuer not trusted : issuer did:example:issuer isn't in the trust registry"
+	}]}`, rr.Body.String())
+
+	_, err = svc.trustRegistry.Put(&trustregistry.Issuer{
+		DID: "did:example:issuer", CredentialTypes: []string{"UniversityDegreeCredential"},
+	})
+	require.NoError(t, err)
+
+	rr = verify()
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), "isn't trusted for the credential types VerifiableCredential")
+
+	_, err = svc.trustRegistry.Put(&trustregistry.Issuer{DID: "did:example:issuer"})
+	require.NoError(t, err)
+
+	rr = verify()
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Empty(t, rr.Body.String())
+}
+
+func TestTrustedIssuers(t *testing.T) {
+	config, cleanup := config(t)
+	defer cleanup()
+
+	config.TrustRegistryFile = trustListFile(t)
+	config.TrustRegistryEnabled = true
+	config.AdminToken = "admin-token"
+
+	svc, err := New(config)
+	require.NoError(t, err)
+
+	withDID := func(r *http.Request, did string) *http.Request {
+		return mux.SetURLVars(r, map[string]string{"did": did})
+	}
+
+	t.Run("admin token", func(t *testing.T) {
+		put := handler(t, svc, trustedIssuersPath, http.MethodPost)
+
+		rr := httptest.NewRecorder()
+		put(rr, httptest.NewRequest(http.MethodPost, trustedIssuersPath, strings.NewReader(`{"did": "did:example:bank"}`)))
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		rr = httptest.NewRecorder()
+		req := withDID(httptest.NewRequest(http.MethodDelete, trustedIssuersPath, nil), "did:example:bank")
+		req.Header.Set("Authorization", "Bearer invalid")
+		handler(t, svc, trustedIssuerPath, http.MethodDelete)(rr, req)
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		req = httptest.NewRequest(http.MethodPost, trustedIssuersPath, strings.NewReader(`{"did": "did:example:bank"}`))
+		req.Header.Set("Authorization", "Bearer admin-token")
+
+		rr = httptest.NewRecorder()
+		put(rr, req)
+		require.Equal(t, http.StatusCreated, rr.Code)
+
+		config.AdminToken = ""
+
+		disabled, err := New(config)
+		require.NoError(t, err)
+
+		rr = httptest.NewRecorder()
+		handler(t, disabled, trustedIssuersPath, http.MethodPost)(rr, req)
+		require.Equal(t, http.StatusForbidden, rr.Code)
+		require.Contains(t, rr.Body.String(), "admin API is disabled")
+	})
+
+	t.Run("put", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		svc.putTrustedIssuer(rr, httptest.NewRequest(http.MethodPost, trustedIssuersPath,
+			strings.NewReader(`{"did": "did:example:bank", "credentialTypes": ["BankCard"], "source": "file"}`)))
+		require.Equal(t, http.StatusCreated, rr.Code)
+		require.Equal(t, httpContentTypeJSON, rr.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"did": "did:example:bank", "credentialTypes": ["BankCard"], "source": "admin"}`,
+			rr.Body.String())
+
+		rr = httptest.NewRecorder()
+		svc.putTrustedIssuer(rr, httptest.NewRequest(http.MethodPost, trustedIssuersPath,
+			strings.NewReader(`{"did": "bank"}`)))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to save trusted issuer : invalid trusted issuer")
+
+		rr = httptest.NewRecorder()
+		svc.putTrustedIssuer(rr, httptest.NewRequest(http.MethodPost, trustedIssuersPath,
+			strings.NewReader("invalid-json")))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to decode request")
+	})
+
+	t.Run("list and get", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		svc.listTrustedIssuers(rr, httptest.NewRequest(http.MethodGet, trustedIssuersPath, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var issuers []*trustregistry.Issuer
+
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &issuers))
+		require.Len(t, issuers, 2)
+		require.Equal(t, trustregistry.SourceAdmin, issuers[0].Source)
+		require.Equal(t, trustregistry.SourceFile, issuers[1].Source)
+
+		rr = httptest.NewRecorder()
+		svc.getTrustedIssuer(rr, withDID(httptest.NewRequest(http.MethodGet, trustedIssuersPath, nil),
+			"did:example:university"))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), `"source":"file"`)
+
+		rr = httptest.NewRecorder()
+		svc.getTrustedIssuer(rr, withDID(httptest.NewRequest(http.MethodGet, trustedIssuersPath, nil),
+			"did:example:unknown"))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "trusted issuer not found")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		for did, status := range map[string]int{
+			"did:example:bank":       http.StatusNoContent,
+			"did:example:university": http.StatusNotFound,
+			"did:example:unknown":    http.StatusNotFound,
+		} {
+			rr := httptest.NewRecorder()
+			svc.deleteTrustedIssuer(rr, withDID(httptest.NewRequest(http.MethodDelete, trustedIssuersPath, nil), did))
+			require.Equal(t, status, rr.Code)
+		}
+	})
+
+	t.Run("registry errors", func(t *testing.T) {
+		require.NoError(t, os.Remove(config.TrustRegistryFile))
+
+		rr := httptest.NewRecorder()
+		svc.listTrustedIssuers(rr, httptest.NewRequest(http.MethodGet, trustedIssuersPath, nil))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to list trusted issuers : stat trust list file")
+
+		rr = httptest.NewRecorder()
+		svc.getTrustedIssuer(rr, withDID(httptest.NewRequest(http.MethodGet, trustedIssuersPath, nil),
+			"did:example:university"))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		registry, err := trustregistry.New(&mockstore.Provider{OpenStoreReturn: &mockstore.Store{
+			ErrPut: errors.New("put error"), ErrGet: errors.New("get error"),
+		}})
+		require.NoError(t, err)
+
+		faulty := &Operation{trustRegistry: registry}
+
+		rr = httptest.NewRecorder()
+		faulty.putTrustedIssuer(rr, httptest.NewRequest(http.MethodPost, trustedIssuersPath,
+			strings.NewReader(`{"did": "did:example:bank"}`)))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to save trusted issuer : save trusted issuer : put error")
+
+		rr = httptest.NewRecorder()
+		faulty.deleteTrustedIssuer(rr, withDID(httptest.NewRequest(http.MethodDelete, trustedIssuersPath, nil),
+			"did:example:bank"))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to delete trusted issuer : get trusted issuer : get error")
+	})
+}
+
+func handler(t *testing.T, svc *Operation, path, method string) http.HandlerFunc {
+	t.Helper()
+
+	for _, h := range svc.GetRESTHandlers() {
+		if h.Path() == path && h.Method() == method {
+			return h.Handle()
+		}
+	}
+
+	require.Failf(t, "handler not found", "%s %s", method, path)
+
+	return nil
+}
+
+func TestLocalChecks(t *testing.T) {
+	svc := &Operation{}
+	require.Equal(t, []string{"trust"}, svc.localChecks([]string{"proof"}))
+
+	svc.localVerification = true
+	require.Equal(t, []string{"proof"}, svc.localChecks([]string{"proof"}))
+
+	svc.trustRegistry = &trustregistry.Registry{}
+	require.Equal(t, []string{"proof", "trust"}, svc.localChecks([]string{"proof"}))
+	require.Equal(t, []string{"trust", "proof"}, svc.localChecks([]string{"trust", "proof"}))
+	require.Equal(t, []string{""}, svc.localChecks([]string{""}))
+	require.Nil(t, svc.localChecks(nil))
+
+	require.Equal(t, []string{"proof", "credentialStatus"}, vcsChecks([]string{"proof", "trust", "credentialStatus"}))
+	require.Nil(t, vcsChecks([]string{"trust"}))
+}
+
+func TestVerifierProfiles(t *testing.T) {
+	config, cleanup := config(t)
+	defer cleanup()
+
+	config.AdminToken = "admin-token"
+
+	svc, err := New(config)
+	require.NoError(t, err)
+
+	withID := func(r *http.Request, id string) *http.Request {
+		return mux.SetURLVars(r, map[string]string{"profileID": id})
+	}
+
+	t.Run("admin token", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler(t, svc, verifierProfilesPath, http.MethodPost)(rr, httptest.NewRequest(http.MethodPost,
+			verifierProfilesPath, strings.NewReader(`{"id": "degree", "vcsProfileID": "degree-verifier"}`)))
+		require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+		req := withID(httptest.NewRequest(http.MethodDelete, verifierProfilesPath, nil), "degree")
+		req.Header.Set("Authorization", "Bearer admin-token")
+
+		rr = httptest.NewRecorder()
+		handler(t, svc, verifierProfilePath, http.MethodDelete)(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		config.AdminToken = ""
+
+		disabled, err := New(config)
+		require.NoError(t, err)
+
+		rr = httptest.NewRecorder()
+		handler(t, disabled, verifierProfilePath, http.MethodDelete)(rr, req)
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("put", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		svc.putVerifierProfile(rr, httptest.NewRequest(http.MethodPost, verifierProfilesPath,
+			strings.NewReader(`{"id": "degree", "vcsProfileID": "degree-verifier", "labels": {"name": "Degree"}}`)))
+		require.Equal(t, http.StatusCreated, rr.Code)
+		require.Equal(t, httpContentTypeJSON, rr.Header().Get("Content-Type"))
+		require.JSONEq(t, `{"id": "degree", "vcsProfileID": "degree-verifier", "labels": {"name": "Degree"}}`,
+			rr.Body.String())
+
+		rr = httptest.NewRecorder()
+		svc.putVerifierProfile(rr, httptest.NewRequest(http.MethodPost, verifierProfilesPath,
+			strings.NewReader(`{"id": "degree"}`)))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to save verifier profile : invalid verifier profile")
+
+		rr = httptest.NewRecorder()
+		svc.putVerifierProfile(rr, httptest.NewRequest(http.MethodPost, verifierProfilesPath,
+			strings.NewReader("invalid-json")))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to decode request")
+	})
+
+	t.Run("list and get", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		svc.listVerifierProfiles(rr, httptest.NewRequest(http.MethodGet, verifierProfilesPath, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var profiles []*verifierprofile.Profile
+
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &profiles))
+		require.Len(t, profiles, 2)
+		require.Equal(t, verifierprofile.DefaultProfileID, profiles[0].ID)
+		require.Equal(t, "degree", profiles[1].ID)
+
+		rr = httptest.NewRecorder()
+		svc.getVerifierProfile(rr, withID(httptest.NewRequest(http.MethodGet, verifierProfilesPath, nil), "degree"))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Contains(t, rr.Body.String(), `"vcsProfileID":"degree-verifier"`)
+
+		rr = httptest.NewRecorder()
+		svc.getVerifierProfile(rr, withID(httptest.NewRequest(http.MethodGet, verifierProfilesPath, nil), "unknown"))
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "verifier profile not found")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		for id, status := range map[string]int{
+			"degree":                         http.StatusNoContent,
+			verifierprofile.DefaultProfileID: http.StatusBadRequest,
+			"unknown":                        http.StatusNotFound,
+		} {
+			rr := httptest.NewRecorder()
+			svc.deleteVerifierProfile(rr, withID(httptest.NewRequest(http.MethodDelete, verifierProfilesPath, nil), id))
+			require.Equal(t, status, rr.Code)
+		}
+	})
+
+	t.Run("registry errors", func(t *testing.T) {
+		profiles, err := verifierprofile.New(&mockstore.Provider{OpenStoreReturn: &mockstore.Store{
+			ErrPut: errors.New("put error"), ErrGet: errors.New("get error"), ErrQuery: errors.New("query error"),
+		}})
+		require.NoError(t, err)
+
+		faulty := &Operation{verifierProfiles: profiles}
+
+		rr := httptest.NewRecorder()
+		faulty.putVerifierProfile(rr, httptest.NewRequest(http.MethodPost, verifierProfilesPath,
+			strings.NewReader(`{"id": "degree", "vcsProfileID": "degree-verifier"}`)))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to save verifier profile : save verifier profile : put error")
+
+		rr = httptest.NewRecorder()
+		faulty.listVerifierProfiles(rr, httptest.NewRequest(http.MethodGet, verifierProfilesPath, nil))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to list verifier profiles : query verifier profiles : query error")
+
+		rr = httptest.NewRecorder()
+		faulty.getVerifierProfile(rr, withID(httptest.NewRequest(http.MethodGet, verifierProfilesPath, nil), "degree"))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "get verifier profile : get error")
+
+		rr = httptest.NewRecorder()
+		faulty.deleteVerifierProfile(rr, withID(httptest.NewRequest(http.MethodDelete, verifierProfilesPath, nil),
+			"degree"))
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Contains(t, rr.Body.String(), "failed to delete verifier profile : get verifier profile : get error")
+	})
+}
+
+func TestProfileChecks(t *testing.T) {
+	profile := &verifierprofile.Profile{Checks: []string{"proof"}}
+
+	require.Equal(t, []string{"proof"}, profileChecks(profile, nil))
+	require.Equal(t, []string{"proof"}, profileChecks(profile, []string{""}))
+	require.Equal(t, []string{"credentialStatus"}, profileChecks(profile, []string{"credentialStatus"}))
+	require.Equal(t, []string{""}, profileChecks(&verifierprofile.Profile{}, []string{""}))
 }
 
 func newCreateOIDCHTTPRequest(scope, flowType string) *http.Request {
@@ -1043,11 +2108,108 @@ func newOIDCCallback(state, code string) (req *http.Request) {
 func newOIDCShareCallback(state, idToken, vpToken string) (req *http.Request) {
 	req = httptest.NewRequest(http.MethodGet,
 		fmt.Sprintf("http://example.com/oidc/share/cb?state=%s&id_token=%s&vp_token=%s",
-			state, idToken, vpToken), nil)
+			state, url.QueryEscape(idToken), url.QueryEscape(vpToken)), nil)
 
 	return req
 }
 
+func saveShareState(t *testing.T, o *Operation, nonce string) string {
+	t.Helper()
+
+	pd := &presexch.PresentationDefinition{}
+	require.NoError(t, json.Unmarshal([]byte(shareDefinition), pd))
+
+	state := uuid.NewString()
+	require.NoError(t, o.saveOIDCShareState(state, &oidcShareState{PresentationDefinition: pd, Nonce: nonce}))
+
+	return state
+}
+
+// newVerifierVCS returns the VCS verifying the presentations for the nonce with the given status.
+func newVerifierVCS(t *testing.T, nonce string, status int) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &edgesvcops.VerifyPresentationRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+
+		require.Equal(t, fmt.Sprintf(verifyPresentationURLFormat, verifierprofile.DefaultVCSProfileID), r.URL.Path)
+		require.Equal(t, []string{"proof", "credentialStatus"}, req.Opts.Checks)
+		require.Equal(t, nonce, req.Opts.Challenge)
+		require.Equal(t, oidcShareClientID, req.Opts.Domain)
+
+		w.WriteHeader(status)
+
+		if status != http.StatusOK {
+			fmt.Fprint(w, "revoked")
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// msgTemplate returns the html template of the result message.
+func msgTemplate(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "msg.html")
+	require.NoError(t, os.WriteFile(file, []byte("{{.Msg}}"), 0o600))
+
+	return file
+}
+
+func createWalletKey(t *testing.T) *kms.Key {
+	t.Helper()
+
+	k, err := kms.New(memstore.NewProvider())
+	require.NoError(t, err)
+
+	key, err := k.Create("wallet", kms.Ed25519)
+	require.NoError(t, err)
+
+	return key
+}
+
+func idTokenClaims(key *kms.Key, nonce string) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":   key.DID,
+		"sub":   key.DID,
+		"aud":   oidcShareClientID,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": nonce,
+		"_vp_token": map[string]interface{}{
+			"presentation_submission": map[string]interface{}{
+				"id":            "submission",
+				"definition_id": "share-definition",
+				"descriptor_map": []interface{}{
+					map[string]interface{}{"id": "credential", "format": "ldp_vc", "path": "$.verifiableCredential[0]"},
+				},
+			},
+		},
+	}
+}
+
+// createIDToken returns the self-issued id_token signed by the wallet key.
+func createIDToken(t *testing.T, key *kms.Key, claims map[string]interface{}) string {
+	t.Helper()
+
+	headerBytes, err := json.Marshal(map[string]interface{}{"alg": key.Alg(), "kid": key.ID})
+	require.NoError(t, err)
+
+	claimsBytes, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsBytes)
+
+	sig, err := key.Signer().Sign([]byte(signingInput))
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
 func tmpFile(t *testing.T) (string, func()) {
 	t.Helper()
 
@@ -1096,12 +2258,87 @@ func config(t *testing.T) (*Config, func()) {
 			VPHTML:                 file,
 			DIDCOMMVPHTML:          file,
 			OIDCShareVPHTML:        file,
+			DocumentLoader:         createTestDocumentLoader(t),
 		}, func() {
 			oidcCleanup()
 			fileCleanup()
 		}
 }
 
+// trustListFile returns the trust list file of the tests.
+func trustListFile(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "trust-list.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"issuers": [{"did": "did:example:university"}]}`), 0o600))
+
+	return file
+}
+
+// verifierProfilesFile returns the verifier profile file of the tests.
+func verifierProfilesFile(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "verifier-profiles.json")
+	require.NoError(t, os.WriteFile(file, []byte(fmt.Sprintf(`[{
+		"id": "prc",
+		"vcsProfileID": "prc-verifier",
+		"checks": ["proof"],
+		"presentationDefinition": %s,
+		"oidc4vp": {
+			"vcsProfileID": "prc-oidc4vp",
+			"presentationDefinitionID": "prc-definition",
+			"purpose": "background check"
+		}
+	}, {
+		"id": "degree",
+		"vcsProfileID": "degree-verifier"
+	}]`, presDefQuery)), 0o600))
+
+	return file
+}
+
+// newProfileVerifierVCS returns the VCS accepting the verification requests of the path with the given checks.
+func newProfileVerifierVCS(t *testing.T, path string, checks []string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &edgesvcops.VerifyPresentationRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(req))
+
+		require.Equal(t, path, r.URL.Path)
+		require.Equal(t, checks, req.Opts.Checks)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+type mockLDStoreProvider struct {
+	ContextStore        ldstore.ContextStore
+	RemoteProviderStore ldstore.RemoteProviderStore
+}
+
+func (p *mockLDStoreProvider) JSONLDContextStore() ldstore.ContextStore {
+	return p.ContextStore
+}
+
+func (p *mockLDStoreProvider) JSONLDRemoteProviderStore() ldstore.RemoteProviderStore {
+	return p.RemoteProviderStore
+}
+
+func createTestDocumentLoader(t *testing.T) *ld.DocumentLoader {
+	t.Helper()
+
+	loader, err := ld.NewDocumentLoader(&mockLDStoreProvider{
+		ContextStore:        mockldstore.NewMockContextStore(),
+		RemoteProviderStore: mockldstore.NewMockRemoteProviderStore(),
+	})
+	require.NoError(t, err)
+
+	return loader
+}
+
 type mockHTTPClient struct {
 	postValue *http.Response
 	postErr   error
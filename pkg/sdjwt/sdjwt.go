@@ -0,0 +1,222 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sdjwt
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// SDAlgorithm is the hash algorithm of the disclosure digests.
+	SDAlgorithm = "sha-256"
+	// SDJWTType is the "typ" header of the SD-JWT VC.
+	SDJWTType = "vc+sd-jwt"
+	// Separator separates the issuer-signed JWT and the disclosures in the combined format.
+	Separator = "~"
+
+	sdKey    = "_sd"
+	sdAlgKey = "_sd_alg"
+
+	saltSize = 16
+)
+
+// Signer signs the SD-JWT.
+type Signer interface {
+	Sign(data []byte) ([]byte, error)
+	Alg() string
+}
+
+// Disclosure of a single claim, encoded as base64url of the JSON array [salt, claim name, claim value].
+type Disclosure struct {
+	Salt    string
+	Name    string
+	Value   interface{}
+	Encoded string
+}
+
+// NewDisclosure creates disclosure of the claim with a random salt.
+func NewDisclosure(name string, value interface{}) (*Disclosure, error) {
+	salt := make([]byte, saltSize)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, fmt.Errorf("generate salt : %w", err)
+	}
+
+	d := &Disclosure{
+		Salt:  base64.RawURLEncoding.EncodeToString(salt),
+		Name:  name,
+		Value: value,
+	}
+
+	disclosureBytes, err := json.Marshal([]interface{}{d.Salt, d.Name, d.Value})
+	if err != nil {
+		return nil, fmt.Errorf("marshal disclosure : %w", err)
+	}
+
+	d.Encoded = base64.RawURLEncoding.EncodeToString(disclosureBytes)
+
+	return d, nil
+}
+
+// DecodeDisclosure decodes disclosure from its encoded form.
+func DecodeDisclosure(encoded string) (*Disclosure, error) {
+	disclosureBytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode disclosure : %w", err)
+	}
+
+	var values []interface{}
+
+	err = json.Unmarshal(disclosureBytes, &values)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal disclosure : %w", err)
+	}
+
+	if len(values) != 3 { //nolint: gomnd
+		return nil, errors.New("disclosure must be an array of salt, claim name and claim value")
+	}
+
+	salt, ok := values[0].(string)
+	if !ok {
+		return nil, errors.New("disclosure salt must be a string")
+	}
+
+	name, ok := values[1].(string)
+	if !ok {
+		return nil, errors.New("disclosure claim name must be a string")
+	}
+
+	return &Disclosure{Salt: salt, Name: name, Value: values[2], Encoded: encoded}, nil
+}
+
+// Digest returns base64url encoded SHA-256 digest of the disclosure.
+func (d *Disclosure) Digest() string {
+	h := sha256.Sum256([]byte(d.Encoded))
+
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// SelectivelyDisclosable replaces the claims of the object, except for the always visible ones, with sorted
+// digests of their disclosures in the "_sd" array. The object itself is not modified.
+func SelectivelyDisclosable(claims map[string]interface{},
+	alwaysVisible ...string) (map[string]interface{}, []*Disclosure, error) {
+	visible := make(map[string]struct{}, len(alwaysVisible))
+	for _, name := range alwaysVisible {
+		visible[name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(claims))
+	for name := range claims {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	result := make(map[string]interface{})
+
+	var (
+		disclosures []*Disclosure
+		digests     []string
+	)
+
+	for _, name := range names {
+		if _, ok := visible[name]; ok {
+			result[name] = claims[name]
+
+			continue
+		}
+
+		d, err := NewDisclosure(name, claims[name])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		disclosures = append(disclosures, d)
+		digests = append(digests, d.Digest())
+	}
+
+	if len(digests) > 0 {
+		sort.Strings(digests)
+
+		result[sdKey] = digests
+	}
+
+	return result, disclosures, nil
+}
+
+// Issue signs the claims and returns SD-JWT along with the disclosures in the combined format for issuance
+// <JWT>~<disclosure 1>~...~<disclosure N>~.
+func Issue(claims map[string]interface{}, disclosures []*Disclosure, signer Signer, keyID string) (string, error) {
+	payload := make(map[string]interface{}, len(claims)+1)
+	for k, v := range claims {
+		payload[k] = v
+	}
+
+	payload[sdAlgKey] = SDAlgorithm
+
+	headerBytes, err := json.Marshal(map[string]interface{}{
+		"alg": signer.Alg(),
+		"typ": SDJWTType,
+		"kid": keyID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal header : %w", err)
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("marshal claims : %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." +
+		base64.RawURLEncoding.EncodeToString(payloadBytes)
+
+	signature, err := signer.Sign([]byte(signingInput))
+	if err != nil {
+		return "", fmt.Errorf("sign sd-jwt : %w", err)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(signingInput + "." + base64.RawURLEncoding.EncodeToString(signature) + Separator)
+
+	for _, d := range disclosures {
+		sb.WriteString(d.Encoded + Separator)
+	}
+
+	return sb.String(), nil
+}
+
+// Parse splits SD-JWT in the combined format into the issuer-signed JWT and the decoded disclosures.
+// A key binding JWT following the last separator is ignored.
+func Parse(combined string) (string, []*Disclosure, error) {
+	parts := strings.Split(combined, Separator)
+	if len(parts) < 2 || parts[0] == "" { //nolint: gomnd
+		return "", nil, errors.New("invalid sd-jwt combined format")
+	}
+
+	var disclosures []*Disclosure
+
+	for _, encoded := range parts[1 : len(parts)-1] {
+		d, err := DecodeDisclosure(encoded)
+		if err != nil {
+			return "", nil, err
+		}
+
+		disclosures = append(disclosures, d)
+	}
+
+	return parts[0], disclosures, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sdjwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	"github.com/square/go-jose/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/kms"
+)
+
+func TestDisclosure(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d, err := NewDisclosure("given_name", "John")
+		require.NoError(t, err)
+		require.NotEmpty(t, d.Salt)
+
+		decoded, err := DecodeDisclosure(d.Encoded)
+		require.NoError(t, err)
+		require.Equal(t, d.Salt, decoded.Salt)
+		require.Equal(t, "given_name", decoded.Name)
+		require.Equal(t, "John", decoded.Value)
+		require.Equal(t, d.Digest(), decoded.Digest())
+	})
+
+	t.Run("digest", func(t *testing.T) {
+		// example from the SD-JWT specification
+		d := &Disclosure{Encoded: "WyI2cU1RdlJMNWhhaiIsICJmYW1pbHlfbmFtZSIsICJNw7ZiaXVzIl0"}
+		require.Equal(t, "uutlBuYeMDyjLLTpf6Jxi7yNkEF35jdyWMn9U7b_RYY", d.Digest())
+	})
+
+	t.Run("invalid disclosure", func(t *testing.T) {
+		for encoded, errMsg := range map[string]string{
+			"!":                                 "decode disclosure",
+			encode(t, "abc"):                    "unmarshal disclosure",
+			encode(t, []string{"a"}):            "array of salt, claim name and claim value",
+			encode(t, []int{1, 2, 3}):           "salt must be a string",
+			encode(t, []interface{}{"a", 1, 2}): "claim name must be a string",
+		} {
+			_, err := DecodeDisclosure(encoded)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), errMsg)
+		}
+	})
+}
+
+func TestSelectivelyDisclosable(t *testing.T) {
+	claims := map[string]interface{}{
+		"id":          "did:example:123",
+		"given_name":  "John",
+		"family_name": "Doe",
+		"address":     map[string]interface{}{"country": "CA"},
+	}
+
+	sdClaims, disclosures, err := SelectivelyDisclosable(claims, "id")
+	require.NoError(t, err)
+	require.Len(t, disclosures, 3)
+	require.Len(t, claims, 4)
+	require.Equal(t, "did:example:123", sdClaims["id"])
+	require.NotContains(t, sdClaims, "given_name")
+
+	digests, ok := sdClaims["_sd"].([]string)
+	require.True(t, ok)
+	require.Len(t, digests, 3)
+
+	for _, d := range disclosures {
+		require.Contains(t, digests, d.Digest())
+		require.Equal(t, claims[d.Name], d.Value)
+	}
+
+	sdClaims, disclosures, err = SelectivelyDisclosable(claims, "id", "given_name", "family_name", "address")
+	require.NoError(t, err)
+	require.Empty(t, disclosures)
+	require.NotContains(t, sdClaims, "_sd")
+}
+
+func TestIssue(t *testing.T) {
+	k, err := kms.New(mem.NewProvider())
+	require.NoError(t, err)
+
+	key, err := k.Create("issuer", kms.P256)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		sdClaims, disclosures, err := SelectivelyDisclosable(map[string]interface{}{"given_name": "John"})
+		require.NoError(t, err)
+
+		combined, err := Issue(map[string]interface{}{"iss": key.DID, "credentialSubject": sdClaims},
+			disclosures, key.Signer(), key.ID)
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(combined, Separator))
+
+		jwt, parsed, err := Parse(combined)
+		require.NoError(t, err)
+		require.Len(t, parsed, 1)
+		require.Equal(t, "given_name", parsed[0].Name)
+
+		jws, err := jose.ParseSigned(jwt)
+		require.NoError(t, err)
+		require.Equal(t, key.ID, jws.Signatures[0].Header.KeyID)
+		require.Equal(t, SDJWTType, jws.Signatures[0].Header.ExtraHeaders[jose.HeaderType])
+
+		payload, err := jws.Verify(key.JWK.Public())
+		require.NoError(t, err)
+
+		claims := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(payload, &claims))
+		require.Equal(t, SDAlgorithm, claims["_sd_alg"])
+		require.Equal(t, key.DID, claims["iss"])
+	})
+
+	t.Run("sign error", func(t *testing.T) {
+		_, err := Issue(map[string]interface{}{}, nil, &mockSigner{err: errors.New("sign failed")}, "kid")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "sign failed")
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		_, err := Issue(map[string]interface{}{"invalid": make(chan int)}, nil, key.Signer(), key.ID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "marshal claims")
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("invalid combined format", func(t *testing.T) {
+		for _, combined := range []string{"", "jwt", "~abc~"} {
+			_, _, err := Parse(combined)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "invalid sd-jwt combined format")
+		}
+	})
+
+	t.Run("invalid disclosure", func(t *testing.T) {
+		_, _, err := Parse("jwt~!~")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "decode disclosure")
+	})
+
+	t.Run("key binding jwt is ignored", func(t *testing.T) {
+		d, err := NewDisclosure("name", "value")
+		require.NoError(t, err)
+
+		jwt, disclosures, err := Parse("jwt~" + d.Encoded + "~kb-jwt")
+		require.NoError(t, err)
+		require.Equal(t, "jwt", jwt)
+		require.Len(t, disclosures, 1)
+	})
+}
+
+type mockSigner struct {
+	err error
+}
+
+func (s *mockSigner) Sign([]byte) ([]byte, error) {
+	return nil, s.err
+}
+
+func (s *mockSigner) Alg() string {
+	return "EdDSA"
+}
+
+func encode(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
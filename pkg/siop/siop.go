@@ -0,0 +1,141 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package siop
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdrapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdr"
+	"github.com/square/go-jose/v3/jwt"
+)
+
+const (
+	// SelfIssuedIssuer is the issuer of the self-issued ID tokens which don't use the subject as the issuer.
+	SelfIssuedIssuer = "https://self-issued.me/v2"
+
+	clockSkew = time.Minute
+)
+
+// ErrInvalidIDToken is returned when the self-issued ID token fails validation.
+var ErrInvalidIDToken = errors.New("invalid id_token")
+
+// Claims of the self-issued ID token.
+type Claims struct {
+	jwt.Claims
+	Nonce   string          `json:"nonce"`
+	VPToken json.RawMessage `json:"_vp_token,omitempty"`
+}
+
+// Verifier verifies the self-issued ID tokens of the wallets.
+type Verifier struct {
+	vdr vdrapi.Registry
+	now func() time.Time
+}
+
+// New returns new verifier resolving the subject DIDs of the ID tokens through the VDR registry.
+func New(vdr vdrapi.Registry) *Verifier {
+	return &Verifier{
+		vdr: vdr,
+		now: time.Now,
+	}
+}
+
+// VerifyIDToken verifies the self-issued ID token: it must be signed by an authentication key of the subject DID,
+// issued to the client for the nonce and not expired. The claims of the token are returned.
+func (v *Verifier) VerifyIDToken(idToken, clientID, nonce string) (*Claims, error) { // nolint:gocyclo
+	token, err := jwt.ParseSigned(idToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w : parse jwt : %s", ErrInvalidIDToken, err)
+	}
+
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("%w : single signature expected", ErrInvalidIDToken)
+	}
+
+	kid := token.Headers[0].KeyID
+	if kid == "" {
+		return nil, fmt.Errorf("%w : missing kid header", ErrInvalidIDToken)
+	}
+
+	key, err := v.publicKey(kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w : resolve key %s : %s", ErrInvalidIDToken, kid, err)
+	}
+
+	claims := &Claims{}
+
+	err = token.Claims(key, claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w : verify signature : %s", ErrInvalidIDToken, err)
+	}
+
+	if claims.Subject != strings.Split(kid, "#")[0] {
+		return nil, fmt.Errorf("%w : not signed by the subject %s", ErrInvalidIDToken, claims.Subject)
+	}
+
+	if claims.Issuer != claims.Subject && claims.Issuer != SelfIssuedIssuer {
+		return nil, fmt.Errorf("%w : not self-issued", ErrInvalidIDToken)
+	}
+
+	if clientID == "" || !claims.Audience.Contains(clientID) {
+		return nil, fmt.Errorf("%w : unexpected audience", ErrInvalidIDToken)
+	}
+
+	if nonce == "" || claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w : unexpected nonce", ErrInvalidIDToken)
+	}
+
+	if claims.Expiry == nil {
+		return nil, fmt.Errorf("%w : missing exp", ErrInvalidIDToken)
+	}
+
+	err = claims.ValidateWithLeeway(jwt.Expected{Time: v.now()}, clockSkew)
+	if err != nil {
+		return nil, fmt.Errorf("%w : %s", ErrInvalidIDToken, err)
+	}
+
+	return claims, nil
+}
+
+// publicKey returns the public key of the authentication verification method of the DID referenced by the kid.
+func (v *Verifier) publicKey(kid string) (interface{}, error) {
+	subject := strings.Split(kid, "#")[0]
+
+	docResolution, err := v.vdr.Resolve(subject)
+	if err != nil {
+		return nil, fmt.Errorf("resolve DID %s : %w", subject, err)
+	}
+
+	methods := docResolution.DIDDocument.VerificationMethods(did.Authentication)[did.Authentication]
+
+	for _, method := range methods {
+		vm := method.VerificationMethod
+
+		// the verification method IDs are either absolute or relative to the DID
+		if vm.ID != kid && subject+vm.ID != kid {
+			continue
+		}
+
+		if jwk := vm.JSONWebKey(); jwk != nil {
+			return jwk.Key, nil
+		}
+
+		if strings.HasPrefix(vm.Type, "Ed25519VerificationKey") && len(vm.Value) == ed25519.PublicKeySize {
+			return ed25519.PublicKey(vm.Value), nil
+		}
+
+		return nil, fmt.Errorf("unsupported verification method type %s", vm.Type)
+	}
+
+	return nil, fmt.Errorf("authentication key not found for DID %s", subject)
+}
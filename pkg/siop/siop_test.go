@@ -0,0 +1,189 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package siop
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdrapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdr"
+	mockvdr "github.com/hyperledger/aries-framework-go/pkg/mock/vdr"
+	vdrpkg "github.com/hyperledger/aries-framework-go/pkg/vdr"
+	vdrkey "github.com/hyperledger/aries-framework-go/pkg/vdr/key"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/kms"
+)
+
+const (
+	clientID = "demo-verifier"
+	nonce    = "nonce-123"
+)
+
+func TestVerifier_VerifyIDToken(t *testing.T) {
+	key := createKey(t)
+	v := New(vdrpkg.New(vdrpkg.WithVDR(vdrkey.New())))
+
+	t.Run("success", func(t *testing.T) {
+		claims, err := v.VerifyIDToken(createIDToken(t, key, key.ID, validClaims(key)), clientID, nonce)
+		require.NoError(t, err)
+		require.Equal(t, key.DID, claims.Subject)
+		require.JSONEq(t, `{"presentation_submission":{}}`, string(claims.VPToken))
+	})
+
+	t.Run("success - self-issued issuer", func(t *testing.T) {
+		claims := validClaims(key)
+		claims["iss"] = SelfIssuedIssuer
+
+		_, err := v.VerifyIDToken(createIDToken(t, key, key.ID, claims), clientID, nonce)
+		require.NoError(t, err)
+	})
+
+	t.Run("error - invalid token", func(t *testing.T) {
+		_, err := v.VerifyIDToken("invalid", clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "parse jwt")
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, "", validClaims(key)), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "missing kid header")
+	})
+
+	t.Run("error - key not resolved", func(t *testing.T) {
+		_, err := v.VerifyIDToken(createIDToken(t, key, "did:example:123#key1", validClaims(key)), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "resolve DID did:example:123")
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.DID+"#other", validClaims(key)), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "authentication key not found")
+	})
+
+	t.Run("error - signature mismatch", func(t *testing.T) {
+		other := createKey(t)
+
+		_, err := v.VerifyIDToken(createIDToken(t, key, other.ID, validClaims(other)), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "verify signature")
+	})
+
+	t.Run("error - invalid claims", func(t *testing.T) {
+		claims := validClaims(key)
+		claims["sub"] = "did:example:123"
+
+		_, err := v.VerifyIDToken(createIDToken(t, key, key.ID, claims), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "not signed by the subject")
+
+		claims = validClaims(key)
+		claims["iss"] = "https://wallet.example.com"
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.ID, claims), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "not self-issued")
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.ID, validClaims(key)), "other-verifier", nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "unexpected audience")
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.ID, validClaims(key)), clientID, "other")
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "unexpected nonce")
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.ID, validClaims(key)), clientID, "")
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "unexpected nonce")
+
+		claims = validClaims(key)
+		delete(claims, "exp")
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.ID, claims), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "missing exp")
+
+		claims = validClaims(key)
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+		_, err = v.VerifyIDToken(createIDToken(t, key, key.ID, claims), clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "expired")
+	})
+
+	t.Run("error - unsupported verification method", func(t *testing.T) {
+		vdr := &mockvdr.MockVDRegistry{
+			ResolveFunc: func(didID string, _ ...vdrapi.DIDMethodOption) (*did.DocResolution, error) {
+				vm := did.NewVerificationMethodFromBytes(didID+"#key1", "EcdsaSecp256k1VerificationKey2019",
+					didID, []byte("key"))
+
+				return &did.DocResolution{DIDDocument: &did.Doc{
+					ID:                 didID,
+					VerificationMethod: []did.VerificationMethod{*vm},
+					Authentication: []did.Verification{
+						*did.NewReferencedVerification(vm, did.Authentication),
+					},
+				}}, nil
+			},
+		}
+
+		_, err := New(vdr).VerifyIDToken(createIDToken(t, key, "did:example:123#key1", validClaims(key)),
+			clientID, nonce)
+		require.True(t, errors.Is(err, ErrInvalidIDToken))
+		require.Contains(t, err.Error(), "unsupported verification method type")
+	})
+}
+
+func createKey(t *testing.T) *kms.Key {
+	t.Helper()
+
+	k, err := kms.New(mem.NewProvider())
+	require.NoError(t, err)
+
+	key, err := k.Create("wallet", kms.Ed25519)
+	require.NoError(t, err)
+
+	return key
+}
+
+func validClaims(key *kms.Key) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":       key.DID,
+		"sub":       key.DID,
+		"aud":       clientID,
+		"iat":       time.Now().Unix(),
+		"exp":       time.Now().Add(time.Minute).Unix(),
+		"nonce":     nonce,
+		"_vp_token": map[string]interface{}{"presentation_submission": map[string]interface{}{}},
+	}
+}
+
+func createIDToken(t *testing.T, key *kms.Key, kid string, claims map[string]interface{}) string {
+	t.Helper()
+
+	header := map[string]interface{}{"alg": key.Alg()}
+	if kid != "" {
+		header["kid"] = kid
+	}
+
+	headerBytes, err := json.Marshal(header)
+	require.NoError(t, err)
+
+	claimsBytes, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsBytes)
+
+	sig, err := key.Signer().Sign([]byte(signingInput))
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
@@ -0,0 +1,459 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statuslist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+)
+
+const (
+	// store
+	statusListStoreName = "issuer_status_list"
+	currentListKeyFmt   = "current_list_%s_%s"
+
+	// StatusList2021 is the W3C Status List 2021 credential status type.
+	StatusList2021 Type = "StatusList2021"
+	// BitstringStatusList is the W3C Bitstring Status List credential status type.
+	BitstringStatusList Type = "BitstringStatusList"
+
+	// StatusList2021Entry is the type of the credentialStatus property of StatusList2021.
+	StatusList2021Entry = "StatusList2021Entry"
+	// BitstringStatusListEntry is the type of the credentialStatus property of BitstringStatusList.
+	BitstringStatusListEntry = "BitstringStatusListEntry"
+
+	// Revocation purpose, the status can't be reverted.
+	Revocation Purpose = "revocation"
+	// Suspension purpose, the status can be reverted.
+	Suspension Purpose = "suspension"
+
+	// DefaultSize of the status lists, 131,072 entries (16KB) as recommended for the herd privacy.
+	DefaultSize = 131072
+
+	// StatusList2021Context is the JSON-LD context of the Status List 2021 terms.
+	StatusList2021Context = "https://w3id.org/vc/status-list/2021/v1"
+	// BitstringStatusListContext is the JSON-LD context of the Bitstring Status List terms.
+	BitstringStatusListContext = "https://www.w3.org/ns/credentials/status/v1"
+
+	credentialsContext = "https://www.w3.org/2018/credentials/v1"
+
+	entrySuffix = "Entry"
+	bitsPerByte = 8
+	// multibase prefix of the base64url encoded list of the Bitstring Status List.
+	multibaseBase64URL = "u"
+)
+
+var (
+	// ErrStatusListNotFound is returned when no status list exists for the given ID.
+	ErrStatusListNotFound = errors.New("status list not found")
+	// ErrInvalidIndex is returned when the index is out of the range of the status list.
+	ErrInvalidIndex = errors.New("invalid status list index")
+	// ErrRevoked is returned when the revocation status is reverted.
+	ErrRevoked = errors.New("revocation can't be reverted")
+)
+
+// Type of the credential status.
+type Type string
+
+// Purpose of the status list.
+type Purpose string
+
+// Manager allocates status list entries to the issued credentials and keeps their status.
+type Manager struct {
+	store      storage.Store
+	statusType Type
+	size       int
+	mutex      sync.Mutex
+}
+
+// StatusList of the issuer.
+type StatusList struct {
+	ID       string  `json:"id"`
+	IssuerID string  `json:"issuerID"`
+	Purpose  Purpose `json:"purpose"`
+	Size     int     `json:"size"`
+	// URL the status list credential is published at.
+	URL string `json:"url"`
+	// Next is the next unassigned index.
+	Next int `json:"next"`
+	// EncodedList is the GZIP compressed, base64url encoded bitstring.
+	EncodedList string `json:"encodedList"`
+}
+
+// Entry is the position of the credential status in the status list.
+type Entry struct {
+	ListID  string  `json:"listID"`
+	ListURL string  `json:"listURL"`
+	Index   int     `json:"index"`
+	Purpose Purpose `json:"purpose"`
+}
+
+// Opt configures the manager.
+type Opt func(m *Manager)
+
+// WithType sets type of the credential status, StatusList2021 by default.
+func WithType(statusType Type) Opt {
+	return func(m *Manager) {
+		m.statusType = statusType
+	}
+}
+
+// WithSize sets number of the entries of the new status lists, DefaultSize by default.
+func WithSize(size int) Opt {
+	return func(m *Manager) {
+		m.size = size
+	}
+}
+
+// New returns new status list manager backed by the given storage provider.
+func New(provider storage.Provider, opts ...Opt) (*Manager, error) {
+	store, err := provider.OpenStore(statusListStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open status list store : %w", err)
+	}
+
+	m := &Manager{store: store, statusType: StatusList2021, size: DefaultSize}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.statusType != StatusList2021 && m.statusType != BitstringStatusList {
+		return nil, fmt.Errorf("unsupported credential status type %s", m.statusType)
+	}
+
+	if m.size <= 0 || m.size%bitsPerByte != 0 {
+		return nil, fmt.Errorf("status list size %d must be a positive multiple of %d", m.size, bitsPerByte)
+	}
+
+	return m, nil
+}
+
+// Type returns type of the credential status.
+func (m *Manager) Type() Type {
+	return m.statusType
+}
+
+// Assign allocates the next free entry of the issuer's status list of the given purpose. A new status list,
+// published at the base URL followed by the list ID, is created when the current one is full.
+func (m *Manager) Assign(issuerID, baseURL string, purpose Purpose) (*Entry, error) {
+	if purpose != Revocation && purpose != Suspension {
+		return nil, fmt.Errorf("unsupported status purpose %s", purpose)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	currentKey := fmt.Sprintf(currentListKeyFmt, issuerID, purpose)
+
+	var list *StatusList
+
+	listID, err := m.store.Get(currentKey)
+
+	switch {
+	case err == nil:
+		list, err = m.Get(string(listID))
+		if err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, storage.ErrDataNotFound):
+		return nil, fmt.Errorf("get current status list : %w", err)
+	}
+
+	if list == nil || list.Next >= list.Size {
+		list, err = m.create(issuerID, baseURL, purpose)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.store.Put(currentKey, []byte(list.ID))
+		if err != nil {
+			return nil, fmt.Errorf("save current status list : %w", err)
+		}
+	}
+
+	entry := &Entry{ListID: list.ID, ListURL: list.URL, Index: list.Next, Purpose: purpose}
+
+	list.Next++
+
+	err = m.save(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Get returns the status list.
+func (m *Manager) Get(listID string) (*StatusList, error) {
+	listBytes, err := m.store.Get(listID)
+	if err != nil {
+		if errors.Is(err, storage.ErrDataNotFound) {
+			return nil, ErrStatusListNotFound
+		}
+
+		return nil, fmt.Errorf("get status list : %w", err)
+	}
+
+	list := &StatusList{}
+
+	err = json.Unmarshal(listBytes, list)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal status list : %w", err)
+	}
+
+	return list, nil
+}
+
+// Status returns the status bit of the entry.
+func (m *Manager) Status(listID string, index int) (bool, error) {
+	list, err := m.Get(listID)
+	if err != nil {
+		return false, err
+	}
+
+	bits, err := list.bits(index)
+	if err != nil {
+		return false, err
+	}
+
+	return bitSet(bits, index), nil
+}
+
+// Update sets the status bit of the entry. Revocation status can't be reverted.
+func (m *Manager) Update(listID string, index int, status bool) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	list, err := m.Get(listID)
+	if err != nil {
+		return err
+	}
+
+	bits, err := list.bits(index)
+	if err != nil {
+		return err
+	}
+
+	if bitSet(bits, index) == status {
+		return nil
+	}
+
+	if !status && list.Purpose == Revocation {
+		return ErrRevoked
+	}
+
+	bits[index/bitsPerByte] ^= 1 << (bitsPerByte - 1 - index%bitsPerByte)
+
+	list.EncodedList, err = encode(bits)
+	if err != nil {
+		return err
+	}
+
+	return m.save(list)
+}
+
+// CredentialStatus returns the credentialStatus property of the credential for the entry.
+func (m *Manager) CredentialStatus(entry *Entry) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                   fmt.Sprintf("%s#%d", entry.ListURL, entry.Index),
+		"type":                 string(m.statusType) + entrySuffix,
+		"statusPurpose":        string(entry.Purpose),
+		"statusListIndex":      strconv.Itoa(entry.Index),
+		"statusListCredential": entry.ListURL,
+	}
+}
+
+// Context returns the JSON-LD context defining the credential status terms.
+func (m *Manager) Context() string {
+	if m.statusType == BitstringStatusList {
+		return BitstringStatusListContext
+	}
+
+	return StatusList2021Context
+}
+
+// Credential returns the unsigned status list credential of the issuer.
+func (m *Manager) Credential(listID, issuer string) ([]byte, error) {
+	list, err := m.Get(listID)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedList := list.EncodedList
+	if m.statusType == BitstringStatusList {
+		encodedList = multibaseBase64URL + encodedList
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"@context":     []string{credentialsContext, m.Context()},
+		"id":           list.URL,
+		"type":         []string{"VerifiableCredential", string(m.statusType) + "Credential"},
+		"issuer":       issuer,
+		"issuanceDate": time.Now().UTC().Format(time.RFC3339),
+		"credentialSubject": map[string]interface{}{
+			"id":            list.URL + "#list",
+			"type":          string(m.statusType),
+			"statusPurpose": string(list.Purpose),
+			"encodedList":   encodedList,
+		},
+	})
+}
+
+// ParseEntry parses the entry from the credentialStatus property of the credential.
+func ParseEntry(credentialStatus map[string]interface{}) (*Entry, error) {
+	statusType, _ := credentialStatus["type"].(string) //nolint: errcheck
+	if statusType != StatusList2021Entry && statusType != BitstringStatusListEntry {
+		return nil, fmt.Errorf("unsupported credential status type %s", statusType)
+	}
+
+	listURL, ok := credentialStatus["statusListCredential"].(string)
+	if !ok || listURL == "" {
+		return nil, errors.New("missing statusListCredential")
+	}
+
+	u, err := url.Parse(listURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statusListCredential : %w", err)
+	}
+
+	var index int
+
+	switch i := credentialStatus["statusListIndex"].(type) {
+	case string:
+		index, err = strconv.Atoi(i)
+		if err != nil {
+			return nil, fmt.Errorf("invalid statusListIndex : %w", err)
+		}
+	case float64:
+		index = int(i)
+	default:
+		return nil, errors.New("missing statusListIndex")
+	}
+
+	purpose, _ := credentialStatus["statusPurpose"].(string) //nolint: errcheck
+
+	return &Entry{
+		ListID:  path.Base(strings.TrimSuffix(u.Path, "/")),
+		ListURL: listURL,
+		Index:   index,
+		Purpose: Purpose(purpose),
+	}, nil
+}
+
+// IsSet returns the status bit of the index in the encoded list of a status list credential. The list is base64url
+// or base64 encoded, with the multibase prefix of the Bitstring Status List or without.
+func IsSet(encodedList string, index int) (bool, error) {
+	encodedList = strings.TrimPrefix(encodedList, multibaseBase64URL)
+	encodedList = strings.NewReplacer("+", "-", "/", "_", "=", "").Replace(encodedList)
+
+	bits, err := decode(encodedList)
+	if err != nil {
+		return false, err
+	}
+
+	if index < 0 || index >= len(bits)*bitsPerByte {
+		return false, ErrInvalidIndex
+	}
+
+	return bitSet(bits, index), nil
+}
+
+func (m *Manager) create(issuerID, baseURL string, purpose Purpose) (*StatusList, error) {
+	encodedList, err := encode(make([]byte, m.size/bitsPerByte))
+	if err != nil {
+		return nil, err
+	}
+
+	listID := uuid.NewString()
+
+	return &StatusList{
+		ID:          listID,
+		IssuerID:    issuerID,
+		Purpose:     purpose,
+		Size:        m.size,
+		URL:         strings.TrimSuffix(baseURL, "/") + "/" + listID,
+		EncodedList: encodedList,
+	}, nil
+}
+
+func (m *Manager) save(list *StatusList) error {
+	listBytes, err := json.Marshal(list)
+	if err != nil {
+		return fmt.Errorf("marshal status list : %w", err)
+	}
+
+	err = m.store.Put(list.ID, listBytes)
+	if err != nil {
+		return fmt.Errorf("save status list : %w", err)
+	}
+
+	return nil
+}
+
+func (l *StatusList) bits(index int) ([]byte, error) {
+	if index < 0 || index >= l.Size {
+		return nil, ErrInvalidIndex
+	}
+
+	return decode(l.EncodedList)
+}
+
+func bitSet(bits []byte, index int) bool {
+	return bits[index/bitsPerByte]&(1<<(bitsPerByte-1-index%bitsPerByte)) != 0
+}
+
+func encode(bits []byte) (string, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+
+	_, err := w.Write(bits)
+	if err != nil {
+		return "", fmt.Errorf("compress status list : %w", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return "", fmt.Errorf("compress status list : %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decode(encodedList string) ([]byte, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(encodedList)
+	if err != nil {
+		return nil, fmt.Errorf("decode status list : %w", err)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompress status list : %w", err)
+	}
+
+	bits, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("decompress status list : %w", err)
+	}
+
+	return bits, nil
+}
@@ -0,0 +1,331 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statuslist
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const baseURL = "https://issuer.example.com/issuer/status"
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), WithType(BitstringStatusList), WithSize(16))
+		require.NoError(t, err)
+		require.Equal(t, BitstringStatusList, m.Type())
+		require.Equal(t, 16, m.size)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open status list store : open error")
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := New(mem.NewProvider(), WithType("RevocationList2020"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported credential status type RevocationList2020")
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		_, err := New(mem.NewProvider(), WithSize(10))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "status list size 10 must be a positive multiple of 8")
+	})
+}
+
+func TestManager_Assign(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), WithSize(16))
+		require.NoError(t, err)
+
+		var first *Entry
+
+		for i := 0; i < 16; i++ {
+			entry, e := m.Assign("issuer", baseURL, Revocation)
+			require.NoError(t, e)
+			require.Equal(t, i, entry.Index)
+			require.Equal(t, Revocation, entry.Purpose)
+
+			if first == nil {
+				first = entry
+			}
+
+			require.Equal(t, first.ListID, entry.ListID)
+		}
+
+		entry, err := m.Assign("issuer", baseURL, Revocation)
+		require.NoError(t, err)
+		require.NotEqual(t, first.ListID, entry.ListID)
+		require.Equal(t, 0, entry.Index)
+
+		entry, err = m.Assign("issuer", baseURL, Suspension)
+		require.NoError(t, err)
+		require.NotEqual(t, first.ListID, entry.ListID)
+
+		entry, err = m.Assign("other", baseURL, Revocation)
+		require.NoError(t, err)
+		require.NotEqual(t, first.ListID, entry.ListID)
+
+		list, err := m.Get(first.ListID)
+		require.NoError(t, err)
+		require.Equal(t, "issuer", list.IssuerID)
+		require.Equal(t, 16, list.Next)
+	})
+
+	t.Run("unsupported purpose", func(t *testing.T) {
+		m, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		_, err = m.Assign("issuer", baseURL, "refresh")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported status purpose refresh")
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		m := &Manager{store: &mockstorage.Store{ErrGet: errors.New("get error")}, size: 8}
+
+		_, err := m.Assign("issuer", baseURL, Revocation)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get current status list : get error")
+
+		m = &Manager{store: &mockstorage.Store{ErrGet: storage.ErrDataNotFound, ErrPut: errors.New("put error")},
+			size: 8}
+
+		_, err = m.Assign("issuer", baseURL, Revocation)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save current status list : put error")
+
+		m = &Manager{store: &mockstorage.Store{GetReturn: []byte("{")}, size: 8}
+
+		_, err = m.Assign("issuer", baseURL, Revocation)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal status list")
+	})
+}
+
+func TestManager_Update(t *testing.T) {
+	t.Run("revocation", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), WithSize(16))
+		require.NoError(t, err)
+
+		entry, err := m.Assign("issuer", baseURL, Revocation)
+		require.NoError(t, err)
+
+		entry, err = m.Assign("issuer", baseURL, Revocation)
+		require.NoError(t, err)
+
+		status, err := m.Status(entry.ListID, entry.Index)
+		require.NoError(t, err)
+		require.False(t, status)
+
+		require.NoError(t, m.Update(entry.ListID, entry.Index, true))
+		require.NoError(t, m.Update(entry.ListID, entry.Index, true))
+
+		status, err = m.Status(entry.ListID, entry.Index)
+		require.NoError(t, err)
+		require.True(t, status)
+
+		status, err = m.Status(entry.ListID, 0)
+		require.NoError(t, err)
+		require.False(t, status)
+
+		list, err := m.Get(entry.ListID)
+		require.NoError(t, err)
+
+		bits, err := decode(list.EncodedList)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x40, 0}, bits)
+
+		require.ErrorIs(t, m.Update(entry.ListID, entry.Index, false), ErrRevoked)
+	})
+
+	t.Run("suspension", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), WithSize(16))
+		require.NoError(t, err)
+
+		entry, err := m.Assign("issuer", baseURL, Suspension)
+		require.NoError(t, err)
+
+		require.NoError(t, m.Update(entry.ListID, entry.Index, true))
+		require.NoError(t, m.Update(entry.ListID, entry.Index, false))
+
+		status, err := m.Status(entry.ListID, entry.Index)
+		require.NoError(t, err)
+		require.False(t, status)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		m, err := New(mem.NewProvider(), WithSize(16))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, m.Update("unknown", 0, true), ErrStatusListNotFound)
+
+		_, err = m.Status("unknown", 0)
+		require.ErrorIs(t, err, ErrStatusListNotFound)
+
+		entry, err := m.Assign("issuer", baseURL, Revocation)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, m.Update(entry.ListID, 16, true), ErrInvalidIndex)
+
+		_, err = m.Status(entry.ListID, -1)
+		require.ErrorIs(t, err, ErrInvalidIndex)
+
+		m = &Manager{store: &mockstorage.Store{ErrGet: errors.New("get error")}}
+
+		err = m.Update("list", 0, true)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get status list : get error")
+
+		m = &Manager{store: &mockstorage.Store{GetReturn: []byte(`{"size":8,"encodedList":"@"}`)}}
+
+		err = m.Update("list", 0, true)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "decode status list")
+	})
+}
+
+func TestManager_Credential(t *testing.T) {
+	for _, statusType := range []Type{StatusList2021, BitstringStatusList} {
+		t.Run(string(statusType), func(t *testing.T) {
+			m, err := New(mem.NewProvider(), WithType(statusType), WithSize(16))
+			require.NoError(t, err)
+
+			entry, err := m.Assign("issuer", baseURL, Revocation)
+			require.NoError(t, err)
+
+			require.NoError(t, m.Update(entry.ListID, entry.Index, true))
+
+			listURL := baseURL + "/" + entry.ListID
+			require.Equal(t, listURL, entry.ListURL)
+
+			credentialStatus := m.CredentialStatus(entry)
+			require.Equal(t, string(statusType)+"Entry", credentialStatus["type"])
+			require.Equal(t, "0", credentialStatus["statusListIndex"])
+			require.Equal(t, listURL+"#0", credentialStatus["id"])
+
+			parsed, err := ParseEntry(credentialStatus)
+			require.NoError(t, err)
+			require.Equal(t, entry, parsed)
+
+			vcBytes, err := m.Credential(entry.ListID, "did:example:issuer")
+			require.NoError(t, err)
+
+			var vc struct {
+				Context []string `json:"@context"`
+				ID      string   `json:"id"`
+				Types   []string `json:"type"`
+				Issuer  string   `json:"issuer"`
+				Subject struct {
+					ID            string `json:"id"`
+					Type          string `json:"type"`
+					StatusPurpose string `json:"statusPurpose"`
+					EncodedList   string `json:"encodedList"`
+				} `json:"credentialSubject"`
+			}
+
+			require.NoError(t, json.Unmarshal(vcBytes, &vc))
+			require.Equal(t, m.Context(), vc.Context[1])
+			require.Equal(t, listURL, vc.ID)
+			require.Equal(t, string(statusType)+"Credential", vc.Types[1])
+			require.Equal(t, "did:example:issuer", vc.Issuer)
+			require.Equal(t, listURL+"#list", vc.Subject.ID)
+			require.Equal(t, string(Revocation), vc.Subject.StatusPurpose)
+
+			encodedList := vc.Subject.EncodedList
+			if statusType == BitstringStatusList {
+				require.True(t, strings.HasPrefix(encodedList, "u"))
+				encodedList = encodedList[1:]
+			}
+
+			bits, err := decode(encodedList)
+			require.NoError(t, err)
+			require.Equal(t, []byte{0x80, 0}, bits)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		m, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		_, err = m.Credential("unknown", "did:example:issuer")
+		require.ErrorIs(t, err, ErrStatusListNotFound)
+	})
+}
+
+func TestIsSet(t *testing.T) {
+	bits := make([]byte, 2)
+	bits[1] = 0x40
+
+	encodedList, err := encode(bits)
+	require.NoError(t, err)
+
+	compressed, err := base64.RawURLEncoding.DecodeString(encodedList)
+	require.NoError(t, err)
+
+	for _, list := range []string{
+		encodedList,
+		multibaseBase64URL + encodedList,
+		base64.StdEncoding.EncodeToString(compressed),
+	} {
+		set, e := IsSet(list, 9)
+		require.NoError(t, e)
+		require.True(t, set)
+
+		set, e = IsSet(list, 8)
+		require.NoError(t, e)
+		require.False(t, set)
+	}
+
+	_, err = IsSet(encodedList, 16)
+	require.ErrorIs(t, err, ErrInvalidIndex)
+
+	_, err = IsSet(encodedList, -1)
+	require.ErrorIs(t, err, ErrInvalidIndex)
+
+	_, err = IsSet("invalid!", 0)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "decode status list")
+}
+
+func TestParseEntry(t *testing.T) {
+	entry, err := ParseEntry(map[string]interface{}{
+		"type":                 BitstringStatusListEntry,
+		"statusListCredential": baseURL + "/list1/",
+		"statusListIndex":      float64(7),
+		"statusPurpose":        "suspension",
+	})
+	require.NoError(t, err)
+	require.Equal(t, &Entry{ListID: "list1", ListURL: baseURL + "/list1/", Index: 7, Purpose: Suspension}, entry)
+
+	listURL := baseURL + "/list1"
+
+	for errMsg, fields := range map[string]map[string]interface{}{
+		"unsupported credential status type RevocationList2020Status": {"type": "RevocationList2020Status"},
+
+		"missing statusListCredential": {"type": StatusList2021Entry},
+		"invalid statusListCredential": {"type": StatusList2021Entry, "statusListCredential": "%zz"},
+		"missing statusListIndex":      {"type": StatusList2021Entry, "statusListCredential": listURL},
+		"invalid statusListIndex": {"type": StatusList2021Entry, "statusListCredential": listURL,
+			"statusListIndex": "x"},
+	} {
+		_, err = ParseEntry(fields)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errMsg)
+	}
+}
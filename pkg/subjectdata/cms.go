@@ -0,0 +1,113 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// CMS is the source backed by the Strapi style CMS, which serves the users at /users and the subject data of each
+// collection at /<collection>, both filtered by the query parameters.
+type CMS struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewCMS returns new CMS source.
+func NewCMS(cmsURL string, httpClient *http.Client) *CMS {
+	return &CMS{url: cmsURL, httpClient: httpClient}
+}
+
+// User returns the single CMS user whose field matches the value.
+func (c *CMS) User(ctx context.Context, field, value string) (*User, error) {
+	userURL := c.url + "/users?"
+	if field != "" {
+		userURL += url.Values{field: {value}}.Encode()
+	}
+
+	userBytes, err := get(ctx, c.httpClient, userURL, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalUser(userBytes)
+}
+
+// SubjectData returns the single record of the CMS collection belonging to the user.
+func (c *CMS) SubjectData(ctx context.Context, collection, userID string) (map[string]interface{}, error) {
+	u := c.url + "/" + collection + "?" + url.Values{userIDField: {userID}}.Encode()
+
+	logger.Infof("url = %s", u)
+
+	subjectBytes, err := get(ctx, c.httpClient, u, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalSubject(subjectBytes)
+}
+
+// Search returns the records of the CMS collection matching the equality filters of the top level fields, the other
+// filters are evaluated by the caller.
+func (c *CMS) Search(ctx context.Context, collection string, filters []Filter) ([]map[string]interface{}, error) {
+	params := url.Values{"_limit": {"-1"}}
+
+	for _, f := range filters {
+		if (f.Op == "" || f.Op == OpEqual) && !strings.Contains(f.Field, ".") {
+			params.Add(f.Field, f.Value)
+		}
+	}
+
+	recordBytes, err := get(ctx, c.httpClient, c.url+"/"+collection+"?"+params.Encode(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	var records []map[string]interface{}
+
+	err = json.Unmarshal(recordBytes, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func unmarshalUser(userBytes []byte) (*User, error) {
+	var users []User
+
+	err := json.Unmarshal(userBytes, &users)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	if len(users) > 1 {
+		return nil, errors.New("multiple users found")
+	}
+
+	return &users[0], nil
+}
+
+func unmarshalSubject(data []byte) (map[string]interface{}, error) {
+	var subjects []map[string]interface{}
+
+	err := json.Unmarshal(data, &subjects)
+	if err != nil {
+		return nil, err
+	}
+
+	return single(subjects)
+}
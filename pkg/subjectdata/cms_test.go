@@ -0,0 +1,173 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const foo = `{"id":1,"userid":"100","name":"Foo Bar","email":"foo@bar.com"}`
+
+func TestCMS(t *testing.T) {
+	cms := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users":
+			if r.URL.Query().Get("name") == "Foo Bar" {
+				fmt.Fprintf(w, "[%s]", foo)
+
+				return
+			}
+
+			fmt.Fprint(w, "[]")
+		case "/studentcards":
+			require.Equal(t, "100", r.URL.Query().Get(userIDField))
+			require.Equal(t, "Bearer tk1", r.Header.Get("Authorization"))
+
+			fmt.Fprint(w, `[{"userid":"100","university":"Faber College"}]`)
+		case "/permanentresidentcards":
+			require.Equal(t, "-1", r.URL.Query().Get("_limit"))
+			require.Equal(t, "Foo", r.URL.Query().Get("givenName"))
+			require.Empty(t, r.URL.Query().Get("familyName"))
+
+			fmt.Fprint(w, `[{"userid":"100","givenName":"Foo","familyName":"Bar"}]`)
+		case "/invalid":
+			fmt.Fprint(w, `invalid`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "not found")
+		}
+	}))
+	defer cms.Close()
+
+	source := NewCMS(cms.URL, http.DefaultClient)
+
+	t.Run("test success", func(t *testing.T) {
+		user, err := source.User(context.Background(), nameField, "Foo Bar")
+		require.NoError(t, err)
+		require.Equal(t, "100", user.UserID)
+		require.Equal(t, "foo@bar.com", user.Email)
+
+		data, err := source.SubjectData(WithToken(context.Background(), "tk1"), "studentcards", user.UserID)
+		require.NoError(t, err)
+		require.Equal(t, "Faber College", data["university"])
+	})
+	t.Run("user not found", func(t *testing.T) {
+		user, err := source.User(context.Background(), emailField, "bar@foo.com")
+		require.ErrorIs(t, err, ErrUserNotFound)
+		require.Nil(t, user)
+	})
+	t.Run("unknown collection", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "unknowns", "100")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "404 Not Found")
+		require.Nil(t, data)
+	})
+	t.Run("unsupported protocol scheme", func(t *testing.T) {
+		user, err := NewCMS("xyz:cms", http.DefaultClient).User(context.Background(), "", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported protocol scheme")
+		require.Nil(t, user)
+	})
+	t.Run("invalid http request", func(t *testing.T) {
+		data, err := NewCMS("http://cms\\", http.DefaultClient).SubjectData(context.Background(), "users", "")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid character")
+		require.Nil(t, data)
+	})
+	t.Run("search", func(t *testing.T) {
+		records, err := source.Search(context.Background(), "permanentresidentcards", []Filter{
+			{Field: "givenName", Value: "Foo"},
+			{Field: "familyName", Op: OpPrefix, Value: "b"},
+		})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		records, err = source.Search(context.Background(), "invalid", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid character")
+		require.Nil(t, records)
+
+		records, err = source.Search(context.Background(), "unknowns", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "404 Not Found")
+		require.Nil(t, records)
+	})
+	t.Run("context http client", func(t *testing.T) {
+		client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("context client")
+		})}
+
+		user, err := source.User(WithHTTPClient(context.Background(), client), nameField, "Foo Bar")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "context client")
+		require.Nil(t, user)
+	})
+}
+
+func TestUnmarshalUser(t *testing.T) {
+	t.Run("test success", func(t *testing.T) {
+		user, err := unmarshalUser([]byte(fmt.Sprintf("[%s]", foo)))
+		require.NoError(t, err)
+		require.Equal(t, user.Email, "foo@bar.com")
+	})
+	t.Run("json unmarshal error", func(t *testing.T) {
+		data, err := unmarshalUser([]byte("invalid"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid character")
+		require.Nil(t, data)
+	})
+	t.Run("user not found", func(t *testing.T) {
+		data, err := unmarshalUser([]byte("[]"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "user not found")
+		require.Nil(t, data)
+	})
+	t.Run("multiple users error", func(t *testing.T) {
+		data, err := unmarshalUser([]byte("[{},{}]"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "multiple users found")
+		require.Nil(t, data)
+	})
+}
+
+func TestUnmarshalSubject(t *testing.T) {
+	t.Run("test success", func(t *testing.T) {
+		data, err := unmarshalSubject([]byte(`[{"email":"foo@bar.com"}]`))
+		require.NoError(t, err)
+		require.Equal(t, data["email"], "foo@bar.com")
+	})
+	t.Run("json unmarshal error", func(t *testing.T) {
+		data, err := unmarshalSubject([]byte("invalid"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid character")
+		require.Nil(t, data)
+	})
+	t.Run("record not found", func(t *testing.T) {
+		data, err := unmarshalSubject([]byte("[]"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "record not found")
+		require.Nil(t, data)
+	})
+	t.Run("multiple records error", func(t *testing.T) {
+		data, err := unmarshalSubject([]byte("[{},{}]"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "multiple records found")
+		require.Nil(t, data)
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RESTConfig configures the REST source.
+type RESTConfig struct {
+	// UserURL returns the users, {field} and {value} are replaced by the search, e.g.
+	// https://example.com/users?{field}={value}.
+	UserURL string `json:"userURL"`
+	// DataURL returns the subject data, {collection} and {userID} are replaced by the search, e.g.
+	// https://example.com/{collection}/{userID}.
+	DataURL string `json:"dataURL"`
+	// SearchURL returns the records of the collection, {collection} is replaced by the collection and the equality
+	// filters are added as the query parameters, e.g. https://example.com/{collection}. The source can't search if
+	// not set.
+	SearchURL string `json:"searchURL,omitempty"`
+	// ResultPath is the dot separated path of the records in the responses, the response itself by default.
+	ResultPath string `json:"resultPath,omitempty"`
+	// UserFields maps the user fields, i.e. userid, name and email, to the fields of the API.
+	UserFields map[string]string `json:"userFields,omitempty"`
+	// Token is sent as the bearer token, otherwise the token of the context is sent, if any.
+	Token string `json:"token,omitempty"`
+}
+
+// REST is the source backed by the REST API, which returns a record or an array of records.
+type REST struct {
+	config     *RESTConfig
+	httpClient *http.Client
+}
+
+// NewREST returns new REST source.
+func NewREST(config *RESTConfig, httpClient *http.Client) (*REST, error) {
+	if config.UserURL == "" || config.DataURL == "" {
+		return nil, errors.New("missing user or data url")
+	}
+
+	return &REST{config: config, httpClient: httpClient}, nil
+}
+
+// User returns the single user whose field matches the value.
+func (r *REST) User(ctx context.Context, field, value string) (*User, error) {
+	apiField := field
+	if f, ok := r.config.UserFields[field]; ok {
+		apiField = f
+	}
+
+	records, err := r.records(ctx, strings.NewReplacer(
+		"{field}", url.QueryEscape(apiField),
+		"{value}", url.QueryEscape(value),
+	).Replace(r.config.UserURL))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		for userField, f := range r.config.UserFields {
+			record[userField] = record[f]
+		}
+	}
+
+	return singleUser(records)
+}
+
+// SubjectData returns the single record of the collection belonging to the user.
+func (r *REST) SubjectData(ctx context.Context, collection, userID string) (map[string]interface{}, error) {
+	records, err := r.records(ctx, strings.NewReplacer(
+		"{collection}", url.PathEscape(collection),
+		"{userID}", url.PathEscape(userID),
+	).Replace(r.config.DataURL))
+	if err != nil {
+		return nil, err
+	}
+
+	return single(records)
+}
+
+// Search returns the records of the collection, the filters are evaluated by the caller.
+func (r *REST) Search(ctx context.Context, collection string, filters []Filter) ([]map[string]interface{}, error) {
+	if r.config.SearchURL == "" {
+		return nil, ErrSearchNotSupported
+	}
+
+	u := strings.ReplaceAll(r.config.SearchURL, "{collection}", url.PathEscape(collection))
+
+	params := url.Values{}
+
+	for _, f := range filters {
+		if (f.Op == "" || f.Op == OpEqual) && !strings.Contains(f.Field, ".") {
+			params.Add(f.Field, f.Value)
+		}
+	}
+
+	if len(params) > 0 {
+		separator := "?"
+		if strings.Contains(u, "?") {
+			separator = "&"
+		}
+
+		u += separator + params.Encode()
+	}
+
+	return r.records(ctx, u)
+}
+
+func (r *REST) records(ctx context.Context, u string) ([]map[string]interface{}, error) {
+	respBytes, err := get(ctx, r.httpClient, u, r.config.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+
+	err = json.Unmarshal(respBytes, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal response : %w", err)
+	}
+
+	if r.config.ResultPath != "" {
+		for _, key := range strings.Split(r.config.ResultPath, ".") {
+			m, ok := result.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid result path %s", r.config.ResultPath)
+			}
+
+			result = m[key]
+		}
+	}
+
+	switch v := result.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return []map[string]interface{}{v}, nil
+	case []interface{}:
+		records := make([]map[string]interface{}, 0, len(v))
+
+		for _, item := range v {
+			record, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, errors.New("invalid record")
+			}
+
+			records = append(records, record)
+		}
+
+		return records, nil
+	default:
+		return nil, errors.New("invalid records")
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestREST(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer tk1", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/people":
+			if r.URL.Query().Get("mail") == "foo@bar.com" {
+				fmt.Fprint(w, `{"data":{"people":[{"id":"100","fullName":"Foo Bar","mail":"foo@bar.com"}]}}`)
+
+				return
+			}
+
+			fmt.Fprint(w, `{"data":{"people":[]}}`)
+		case "/search/studentcards":
+			require.Equal(t, "Faber College", r.URL.Query().Get("university"))
+			require.Equal(t, "1", r.URL.Query().Get("v"))
+
+			fmt.Fprint(w, `{"data":{"people":[{"university":"Faber College"}]}}`)
+		case "/studentcards/100":
+			fmt.Fprint(w, `{"data":{"people":{"university":"Faber College"}}}`)
+		case "/studentcards/200":
+			fmt.Fprint(w, `{"data":{"people":["invalid"]}}`)
+		case "/studentcards/300":
+			fmt.Fprint(w, `{"data":"invalid"}`)
+		default:
+			fmt.Fprint(w, `invalid`)
+		}
+	}))
+	defer api.Close()
+
+	source, err := NewREST(&RESTConfig{
+		UserURL:    api.URL + "/people?{field}={value}",
+		DataURL:    api.URL + "/{collection}/{userID}",
+		SearchURL:  api.URL + "/search/{collection}?v=1",
+		ResultPath: "data.people",
+		UserFields: map[string]string{userIDField: "id", nameField: "fullName", emailField: "mail"},
+		Token:      "tk1",
+	}, http.DefaultClient)
+	require.NoError(t, err)
+
+	t.Run("test success", func(t *testing.T) {
+		user, err := source.User(context.Background(), emailField, "foo@bar.com")
+		require.NoError(t, err)
+		require.Equal(t, &User{UserID: "100", Name: "Foo Bar", Email: "foo@bar.com"}, user)
+
+		data, err := source.SubjectData(context.Background(), "studentcards", user.UserID)
+		require.NoError(t, err)
+		require.Equal(t, "Faber College", data["university"])
+	})
+	t.Run("user not found", func(t *testing.T) {
+		user, err := source.User(context.Background(), emailField, "bar@foo.com")
+		require.ErrorIs(t, err, ErrUserNotFound)
+		require.Nil(t, user)
+	})
+	t.Run("invalid record", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "studentcards", "200")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid record")
+		require.Nil(t, data)
+	})
+	t.Run("invalid result path", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "studentcards", "300")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid result path")
+		require.Nil(t, data)
+	})
+	t.Run("invalid response", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "unknowns", "100")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal response")
+		require.Nil(t, data)
+	})
+	t.Run("search", func(t *testing.T) {
+		records, err := source.Search(context.Background(), "studentcards", []Filter{
+			{Field: "university", Value: "Faber College"},
+			{Field: "name", Op: OpContains, Value: "Foo"},
+		})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+
+		s, err := NewREST(&RESTConfig{UserURL: api.URL, DataURL: api.URL}, http.DefaultClient)
+		require.NoError(t, err)
+
+		records, err = s.Search(context.Background(), "studentcards", nil)
+		require.ErrorIs(t, err, ErrSearchNotSupported)
+		require.Nil(t, records)
+	})
+	t.Run("missing url", func(t *testing.T) {
+		s, err := NewREST(&RESTConfig{UserURL: api.URL}, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing user or data url")
+		require.Nil(t, s)
+	})
+}
@@ -0,0 +1,279 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Filter operators.
+const (
+	OpEqual          = "eq"
+	OpNotEqual       = "ne"
+	OpContains       = "contains"
+	OpPrefix         = "prefix"
+	OpGreater        = "gt"
+	OpGreaterOrEqual = "gte"
+	OpLess           = "lt"
+	OpLessOrEqual    = "lte"
+)
+
+const (
+	// DefaultSearchLimit is the page size of the searches without a limit.
+	DefaultSearchLimit = 20
+	// MaxSearchLimit is the largest page size.
+	MaxSearchLimit = 100
+)
+
+// nolint:gochecknoglobals
+var collectionRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+var (
+	// ErrSearchNotSupported is returned when the source of the collection can't search.
+	ErrSearchNotSupported = errors.New("search not supported")
+	// ErrInvalidQuery is returned when the query is invalid.
+	ErrInvalidQuery = errors.New("invalid query")
+)
+
+// Filter of the subject data records. Contains and prefix match case insensitively, the comparisons are numeric
+// when both values are numbers, lexical otherwise, e.g. for the ISO dates.
+type Filter struct {
+	// Field is the dot separated path of the field.
+	Field string `json:"field"`
+	// Op is the operator, eq by default.
+	Op    string `json:"op,omitempty"`
+	Value string `json:"value"`
+}
+
+// Query of the subject data records of the collection.
+type Query struct {
+	Collection string   `json:"collection"`
+	Filters    []Filter `json:"filters,omitempty"`
+	// Fields are the dot separated paths of the fields of the results, all the fields by default.
+	Fields []string `json:"fields,omitempty"`
+	Offset int      `json:"offset,omitempty"`
+	// Limit is the page size, DefaultSearchLimit by default.
+	Limit int `json:"limit,omitempty"`
+}
+
+// SearchResult is the page of the records matching the query.
+type SearchResult struct {
+	Records []map[string]interface{}
+	// Total is the number of the records matching the query.
+	Total int
+}
+
+// Searcher is implemented by the sources searching the subject data records. The sources may return the records
+// not matching all the filters, e.g. those they can't evaluate, the records are filtered again.
+type Searcher interface {
+	Search(ctx context.Context, collection string, filters []Filter) ([]map[string]interface{}, error)
+}
+
+// PageSearcher is implemented by the sources evaluating all the filters and the paging of the query themselves,
+// e.g. in the database query, instead of returning all the records of the collection.
+type PageSearcher interface {
+	SearchPage(ctx context.Context, query *Query) (*SearchResult, error)
+}
+
+// Search returns the page of the records of the query collection matching the filters, searching the source of the
+// scope or of the collection.
+func (s *Sources) Search(ctx context.Context, scope string, query *Query) (*SearchResult, error) {
+	err := query.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	source := s.Source(scope, query.Collection)
+
+	if pageSearcher, ok := source.(PageSearcher); ok {
+		return pageSearcher.SearchPage(ctx, query)
+	}
+
+	searcher, ok := source.(Searcher)
+	if !ok {
+		return nil, ErrSearchNotSupported
+	}
+
+	records, err := searcher.Search(ctx, query.Collection, query.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []map[string]interface{}
+
+	for _, record := range records {
+		if Match(record, query.Filters) {
+			matches = append(matches, record)
+		}
+	}
+
+	result := &SearchResult{Total: len(matches), Records: []map[string]interface{}{}}
+
+	if query.Offset < len(matches) {
+		end := query.Offset + query.limit()
+		if end > len(matches) {
+			end = len(matches)
+		}
+
+		result.Records = matches[query.Offset:end]
+	}
+
+	return result, nil
+}
+
+// limit returns the page size of the query.
+func (q *Query) limit() int {
+	if q.Limit == 0 {
+		return DefaultSearchLimit
+	}
+
+	return q.Limit
+}
+
+func (q *Query) validate() error {
+	if !collectionRegex.MatchString(q.Collection) || q.Collection == usersCollection {
+		return fmt.Errorf("%w : invalid collection %q", ErrInvalidQuery, q.Collection)
+	}
+
+	if q.Offset < 0 || q.Limit < 0 || q.Limit > MaxSearchLimit {
+		return fmt.Errorf("%w : offset must not be negative and limit must be up to %d", ErrInvalidQuery,
+			MaxSearchLimit)
+	}
+
+	for _, f := range q.Filters {
+		if f.Field == "" {
+			return fmt.Errorf("%w : missing filter field", ErrInvalidQuery)
+		}
+
+		switch f.Op {
+		case "", OpEqual, OpNotEqual, OpContains, OpPrefix, OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual:
+		default:
+			return fmt.Errorf("%w : unsupported filter operator %s", ErrInvalidQuery, f.Op)
+		}
+	}
+
+	return nil
+}
+
+// Match returns true if the record matches all the filters.
+func Match(record map[string]interface{}, filters []Filter) bool {
+	for _, f := range filters {
+		v, ok := Lookup(record, f.Field)
+		if !ok {
+			if f.Op == OpNotEqual {
+				continue
+			}
+
+			return false
+		}
+
+		if !f.match(stringValue(v)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Filter) match(value string) bool {
+	switch f.Op {
+	case "", OpEqual:
+		return value == f.Value
+	case OpNotEqual:
+		return value != f.Value
+	case OpContains:
+		return strings.Contains(strings.ToLower(value), strings.ToLower(f.Value))
+	case OpPrefix:
+		return strings.HasPrefix(strings.ToLower(value), strings.ToLower(f.Value))
+	case OpGreater:
+		return compare(value, f.Value) > 0
+	case OpGreaterOrEqual:
+		return compare(value, f.Value) >= 0
+	case OpLess:
+		return compare(value, f.Value) < 0
+	case OpLessOrEqual:
+		return compare(value, f.Value) <= 0
+	default:
+		return false
+	}
+}
+
+func compare(a, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+
+	if errX == nil && errY == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+// Lookup returns the value of the dot separated path of the record.
+func Lookup(record map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = record
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// Project returns the record having only the fields of the dot separated paths, the record itself if there are no
+// fields.
+func Project(record map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return record
+	}
+
+	projection := map[string]interface{}{}
+
+	for _, field := range fields {
+		value, ok := Lookup(record, field)
+		if !ok {
+			continue
+		}
+
+		keys := strings.Split(field, ".")
+		m := projection
+
+		for _, key := range keys[:len(keys)-1] {
+			next, ok := m[key].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				m[key] = next
+			}
+
+			m = next
+		}
+
+		m[keys[len(keys)-1]] = value
+	}
+
+	return projection
+}
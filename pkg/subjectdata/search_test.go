@@ -0,0 +1,144 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSources_Search(t *testing.T) {
+	dir := t.TempDir()
+
+	var records []string
+	for i := 0; i < 25; i++ {
+		records = append(records, fmt.Sprintf(`{"userid":"%d","givenName":"Foo","familyName":"Bar%d",`+
+			`"birthDate":"19%02d-01-01","address":{"city":"Toronto","street":"%d Main St"}}`, i, i, 70+i, i))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "prcs.json"),
+		[]byte("["+strings.Join(records, ",")+"]"), 0o600))
+
+	static, err := NewStatic(dir)
+	require.NoError(t, err)
+
+	sources := NewSources(static)
+
+	t.Run("test success", func(t *testing.T) {
+		result, err := sources.Search(context.Background(), "PRC", &Query{
+			Collection: "prcs",
+			Filters: []Filter{
+				{Field: "givenName", Op: OpContains, Value: "fo"},
+				{Field: "birthDate", Op: OpGreaterOrEqual, Value: "1980-01-01"},
+				{Field: "address.city", Value: "Toronto"},
+			},
+			Offset: 10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 15, result.Total)
+		require.Len(t, result.Records, 5)
+		require.Equal(t, "1990-01-01", result.Records[0]["birthDate"])
+	})
+	t.Run("default limit", func(t *testing.T) {
+		result, err := sources.Search(context.Background(), "PRC", &Query{Collection: "prcs"})
+		require.NoError(t, err)
+		require.Equal(t, 25, result.Total)
+		require.Len(t, result.Records, DefaultSearchLimit)
+	})
+	t.Run("offset past the results", func(t *testing.T) {
+		result, err := sources.Search(context.Background(), "PRC", &Query{Collection: "prcs", Offset: 30})
+		require.NoError(t, err)
+		require.Equal(t, 25, result.Total)
+		require.Empty(t, result.Records)
+	})
+	t.Run("invalid query", func(t *testing.T) {
+		for _, query := range []*Query{
+			{},
+			{Collection: "users"},
+			{Collection: "prcs/../users"},
+			{Collection: "prcs", Limit: MaxSearchLimit + 1},
+			{Collection: "prcs", Offset: -1},
+			{Collection: "prcs", Filters: []Filter{{Value: "Foo"}}},
+			{Collection: "prcs", Filters: []Filter{{Field: "givenName", Op: "like", Value: "Foo"}}},
+		} {
+			result, err := sources.Search(context.Background(), "PRC", query)
+			require.ErrorIs(t, err, ErrInvalidQuery)
+			require.Nil(t, result)
+		}
+	})
+	t.Run("search not supported", func(t *testing.T) {
+		result, err := NewSources(&mockSource{}).Search(context.Background(), "PRC", &Query{Collection: "prcs"})
+		require.ErrorIs(t, err, ErrSearchNotSupported)
+		require.Nil(t, result)
+	})
+}
+
+func TestMatch(t *testing.T) {
+	record := map[string]interface{}{
+		"name":  "Foo Bar",
+		"score": 700.0,
+		"born":  "1980-05-01",
+		"address": map[string]interface{}{
+			"city": "Toronto",
+		},
+	}
+
+	for _, tc := range []struct {
+		filter Filter
+		match  bool
+	}{
+		{Filter{Field: "name", Value: "Foo Bar"}, true},
+		{Filter{Field: "name", Op: OpEqual, Value: "foo bar"}, false},
+		{Filter{Field: "name", Op: OpNotEqual, Value: "Foo"}, true},
+		{Filter{Field: "missing", Op: OpNotEqual, Value: "Foo"}, true},
+		{Filter{Field: "missing", Value: "Foo"}, false},
+		{Filter{Field: "name", Op: OpContains, Value: "BAR"}, true},
+		{Filter{Field: "name", Op: OpPrefix, Value: "bar"}, false},
+		{Filter{Field: "score", Op: OpGreater, Value: "650"}, true},
+		{Filter{Field: "score", Op: OpGreater, Value: "1000"}, false},
+		{Filter{Field: "score", Op: OpLessOrEqual, Value: "700"}, true},
+		{Filter{Field: "born", Op: OpLess, Value: "1990-01-01"}, true},
+		{Filter{Field: "born", Op: OpGreaterOrEqual, Value: "1990-01-01"}, false},
+		{Filter{Field: "address.city", Value: "Toronto"}, true},
+		{Filter{Field: "name.first", Value: "Foo"}, false},
+		{Filter{Field: "name", Op: "like", Value: "Foo"}, false},
+	} {
+		require.Equal(t, tc.match, Match(record, []Filter{tc.filter}), tc.filter)
+	}
+}
+
+func TestProject(t *testing.T) {
+	record := map[string]interface{}{
+		"name": "Foo Bar",
+		"address": map[string]interface{}{
+			"city":   "Toronto",
+			"street": "1 Main St",
+		},
+	}
+
+	require.Equal(t, record, Project(record, nil))
+	require.Equal(t, map[string]interface{}{
+		"name":    "Foo Bar",
+		"address": map[string]interface{}{"city": "Toronto"},
+	}, Project(record, []string{"name", "address.city", "missing", "name.first"}))
+}
+
+type mockSource struct{}
+
+func (s *mockSource) User(context.Context, string, string) (*User, error) {
+	return nil, ErrUserNotFound
+}
+
+func (s *mockSource) SubjectData(context.Context, string, string) (map[string]interface{}, error) {
+	return nil, ErrRecordNotFound
+}
@@ -0,0 +1,275 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultUsersTable = "users"
+)
+
+// nolint:gochecknoglobals
+var (
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	likeEscaper     = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+	sqlOperators    = map[string]string{
+		"":               "=",
+		OpEqual:          "=",
+		OpNotEqual:       "<>",
+		OpGreater:        ">",
+		OpGreaterOrEqual: ">=",
+		OpLess:           "<",
+		OpLessOrEqual:    "<=",
+	}
+)
+
+// SQLConfig configures the SQL source.
+type SQLConfig struct {
+	// Driver is the name of the registered database/sql driver, e.g. mysql.
+	Driver string `json:"driver"`
+	DSN    string `json:"dsn"`
+	// UsersTable holds the users, users by default.
+	UsersTable string `json:"usersTable,omitempty"`
+	// Tables maps the collections to the tables holding their subject data. Only the collections of the tables and
+	// those of the scopes routed to the source, held in the table of the same name, are served.
+	Tables map[string]string `json:"tables,omitempty"`
+	// UserIDColumn references the user in the subject data tables, userid by default.
+	UserIDColumn string `json:"userIDColumn,omitempty"`
+}
+
+// SQL is the source backed by the SQL database, which holds the users and the subject data of each collection in
+// tables.
+type SQL struct {
+	db           *sql.DB
+	usersTable   string
+	tables       map[string]string
+	userIDColumn string
+}
+
+// NewSQL returns new SQL source.
+func NewSQL(db *sql.DB, config *SQLConfig) (*SQL, error) {
+	s := &SQL{
+		db:           db,
+		usersTable:   config.UsersTable,
+		tables:       map[string]string{},
+		userIDColumn: config.UserIDColumn,
+	}
+
+	for collection, table := range config.Tables {
+		s.tables[collection] = table
+	}
+
+	if s.usersTable == "" {
+		s.usersTable = defaultUsersTable
+	}
+
+	if s.userIDColumn == "" {
+		s.userIDColumn = userIDField
+	}
+
+	identifiers := []string{s.usersTable, s.userIDColumn}
+	for _, table := range s.tables {
+		identifiers = append(identifiers, table)
+	}
+
+	for _, identifier := range identifiers {
+		if !identifierRegex.MatchString(identifier) {
+			return nil, fmt.Errorf("invalid identifier %q", identifier)
+		}
+	}
+
+	return s, nil
+}
+
+// User returns the single user whose field matches the value.
+func (s *SQL) User(ctx context.Context, field, value string) (*User, error) {
+	if field != userIDField && field != nameField && field != emailField {
+		return nil, fmt.Errorf("unsupported user field %q", field)
+	}
+
+	records, err := s.query(ctx, s.usersTable, []string{field + " = ?"}, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return singleUser(records)
+}
+
+// SubjectData returns the single record of the collection table belonging to the user.
+func (s *SQL) SubjectData(ctx context.Context, collection, userID string) (map[string]interface{}, error) {
+	table, err := s.table(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.query(ctx, table, []string{s.userIDColumn + " = ?"}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return single(records)
+}
+
+// SearchPage returns the page of the records of the collection table matching the query filters, which are all
+// evaluated by the database.
+func (s *SQL) SearchPage(ctx context.Context, query *Query) (*SearchResult, error) {
+	table, err := s.table(query.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, args, err := sqlConditions(query.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	where := whereClause(conditions)
+	result := &SearchResult{}
+
+	q := "SELECT COUNT(*) FROM " + table + where
+
+	err = s.db.QueryRowContext(ctx, q, args...).Scan(&result.Total)
+	if err != nil {
+		return nil, fmt.Errorf("count %s : %w", table, err)
+	}
+
+	result.Records, err = s.records(ctx, table, "SELECT * FROM "+table+where+" ORDER BY "+s.userIDColumn+
+		" LIMIT "+strconv.Itoa(query.limit())+" OFFSET "+strconv.Itoa(query.Offset), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Records == nil {
+		result.Records = []map[string]interface{}{}
+	}
+
+	return result, nil
+}
+
+// allowCollection makes the source serve the collection of the scope routed to it from the table of the same name,
+// unless the collection has a table.
+func (s *SQL) allowCollection(collection string) {
+	if _, ok := s.tables[collection]; !ok && identifierRegex.MatchString(collection) {
+		s.tables[collection] = collection
+	}
+}
+
+func (s *SQL) table(collection string) (string, error) {
+	table, ok := s.tables[collection]
+	if !ok || table == s.usersTable {
+		return "", fmt.Errorf("invalid collection %q", collection)
+	}
+
+	return table, nil
+}
+
+// sqlConditions returns the conditions and the arguments of the filters, whose fields must be columns.
+func sqlConditions(filters []Filter) ([]string, []interface{}, error) {
+	conditions := make([]string, 0, len(filters))
+	args := make([]interface{}, 0, len(filters))
+
+	for _, f := range filters {
+		if !identifierRegex.MatchString(f.Field) {
+			return nil, nil, fmt.Errorf("%w : unsupported filter field %q", ErrInvalidQuery, f.Field)
+		}
+
+		switch f.Op {
+		case OpContains:
+			conditions = append(conditions, "LOWER("+f.Field+") LIKE ? ESCAPE '!'")
+			args = append(args, "%"+likeEscaper.Replace(strings.ToLower(f.Value))+"%")
+		case OpPrefix:
+			conditions = append(conditions, "LOWER("+f.Field+") LIKE ? ESCAPE '!'")
+			args = append(args, likeEscaper.Replace(strings.ToLower(f.Value))+"%")
+		default:
+			operator, ok := sqlOperators[f.Op]
+			if !ok {
+				return nil, nil, fmt.Errorf("%w : unsupported filter operator %s", ErrInvalidQuery, f.Op)
+			}
+
+			conditions = append(conditions, f.Field+" "+operator+" ?")
+			args = append(args, f.Value)
+		}
+	}
+
+	return conditions, args, nil
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// query returns the rows of the table matching the conditions, whose identifiers are validated by the callers and
+// whose values are the arguments.
+func (s *SQL) query(ctx context.Context, table string, conditions []string,
+	args ...interface{}) ([]map[string]interface{}, error) {
+	return s.records(ctx, table, "SELECT * FROM "+table+whereClause(conditions), args...)
+}
+
+// records returns the rows of the query of the table as records.
+func (s *SQL) records(ctx context.Context, table, q string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query %s : %w", table, err)
+	}
+
+	defer func() {
+		if e := rows.Close(); e != nil {
+			logger.Warnf("failed to close rows : %s", e)
+		}
+	}()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("get columns of %s : %w", table, err)
+	}
+
+	var records []map[string]interface{}
+
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err = rows.Scan(pointers...); err != nil {
+			return nil, fmt.Errorf("scan %s : %w", table, err)
+		}
+
+		record := make(map[string]interface{}, len(columns))
+
+		for i, column := range columns {
+			if b, ok := values[i].([]byte); ok {
+				record[column] = string(b)
+
+				continue
+			}
+
+			record[column] = values[i]
+		}
+
+		records = append(records, record)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s : %w", table, err)
+	}
+
+	return records, nil
+}
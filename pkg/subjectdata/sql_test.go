@@ -0,0 +1,346 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDriverName = "subjectdata-test"
+
+// nolint:gochecknoglobals
+var testDatabase = &mockDriver{tables: map[string]*mockTable{
+	"users": {
+		columns: []string{"id", "userid", "name", "email"},
+		rows: [][]driver.Value{
+			{int64(1), []byte("100"), []byte("Foo Bar"), []byte("foo@bar.com")},
+			{int64(2), []byte("200"), []byte("Foo Bar"), []byte("foo2@bar.com")},
+		},
+	},
+	"student_cards": {
+		columns: []string{"user_id", "university"},
+		rows: [][]driver.Value{
+			{[]byte("100"), []byte("Faber College")},
+			{[]byte("300"), []byte("Faber University")},
+			{[]byte("400"), []byte("Hogwarts 100%")},
+		},
+	},
+}}
+
+func init() { // nolint:gochecknoinits
+	sql.Register(testDriverName, testDatabase)
+}
+
+func TestSQL(t *testing.T) {
+	db, err := sql.Open(testDriverName, "")
+	require.NoError(t, err)
+
+	source, err := NewSQL(db, &SQLConfig{
+		Tables:       map[string]string{"studentcards": "student_cards", "staff": "users", "missing": "missing_table"},
+		UserIDColumn: "user_id",
+	})
+	require.NoError(t, err)
+
+	t.Run("test success", func(t *testing.T) {
+		user, err := source.User(context.Background(), emailField, "foo@bar.com")
+		require.NoError(t, err)
+		require.Equal(t, &User{UserID: "100", Name: "Foo Bar", Email: "foo@bar.com"}, user)
+
+		data, err := source.SubjectData(context.Background(), "studentcards", user.UserID)
+		require.NoError(t, err)
+		require.Equal(t, "Faber College", data["university"])
+	})
+	t.Run("user not found", func(t *testing.T) {
+		user, err := source.User(context.Background(), userIDField, "300")
+		require.ErrorIs(t, err, ErrUserNotFound)
+		require.Nil(t, user)
+	})
+	t.Run("multiple users found", func(t *testing.T) {
+		user, err := source.User(context.Background(), nameField, "Foo Bar")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "multiple users found")
+		require.Nil(t, user)
+	})
+	t.Run("unsupported user field", func(t *testing.T) {
+		user, err := source.User(context.Background(), "password", "secret")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported user field")
+		require.Nil(t, user)
+	})
+	t.Run("record not found", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "studentcards", "200")
+		require.ErrorIs(t, err, ErrRecordNotFound)
+		require.Nil(t, data)
+	})
+	t.Run("invalid collection", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "cards; DROP TABLE users", "100")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid collection")
+		require.Nil(t, data)
+	})
+	t.Run("unknown collection", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "unknowns", "100")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid collection")
+		require.Nil(t, data)
+	})
+	t.Run("query error", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "missing", "100")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "query missing_table")
+		require.Nil(t, data)
+	})
+	t.Run("search page", func(t *testing.T) {
+		result, err := source.SearchPage(context.Background(), &Query{
+			Collection: "studentcards",
+			Filters:    []Filter{{Field: "university", Op: OpContains, Value: "faber"}},
+			Offset:     1,
+			Limit:      1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, result.Total)
+		require.Len(t, result.Records, 1)
+		require.Equal(t, "300", result.Records[0]["user_id"])
+
+		result, err = source.SearchPage(context.Background(), &Query{
+			Collection: "studentcards",
+			Filters: []Filter{
+				{Field: "university", Op: OpPrefix, Value: "HOGWARTS 100%"},
+				{Field: "user_id", Op: OpGreater, Value: "150"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, result.Total)
+		require.Equal(t, "400", result.Records[0]["user_id"])
+
+		result, err = source.SearchPage(context.Background(), &Query{
+			Collection: "studentcards",
+			Filters:    []Filter{{Field: "university", Op: OpPrefix, Value: "Faber_"}},
+		})
+		require.NoError(t, err)
+		require.Zero(t, result.Total)
+		require.Empty(t, result.Records)
+	})
+	t.Run("search page - invalid query", func(t *testing.T) {
+		for _, query := range []*Query{
+			{Collection: "users"},
+			{Collection: "staff"},
+			{Collection: "unknowns"},
+			{Collection: "cards; DROP TABLE users"},
+			{Collection: "studentcards", Filters: []Filter{{Field: "address.city", Value: "Toronto"}}},
+			{Collection: "studentcards", Filters: []Filter{{Field: "university", Op: "like", Value: "Faber"}}},
+		} {
+			result, err := source.SearchPage(context.Background(), query)
+			require.Error(t, err)
+			require.Nil(t, result)
+		}
+	})
+	t.Run("search page - scope collection", func(t *testing.T) {
+		s, err := NewSQL(db, &SQLConfig{})
+		require.NoError(t, err)
+
+		s.allowCollection("student_cards")
+
+		result, err := NewSources(s).Search(context.Background(), "StudentCard", &Query{Collection: "student_cards"})
+		require.NoError(t, err)
+		require.Equal(t, 3, result.Total)
+		require.Len(t, result.Records, 3)
+
+		s.allowCollection("mdls")
+
+		result, err = s.SearchPage(context.Background(), &Query{Collection: "mdls"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "count mdls")
+		require.Nil(t, result)
+	})
+	t.Run("invalid identifier", func(t *testing.T) {
+		s, err := NewSQL(db, &SQLConfig{UsersTable: "users u"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid identifier")
+		require.Nil(t, s)
+	})
+}
+
+type mockTable struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+type mockDriver struct {
+	tables map[string]*mockTable
+}
+
+func (d *mockDriver) Open(string) (driver.Conn, error) {
+	return &mockConn{driver: d}, nil
+}
+
+type mockConn struct {
+	driver *mockDriver
+}
+
+func (c *mockConn) Prepare(query string) (driver.Stmt, error) {
+	// SELECT {*|COUNT(*)} FROM <table> [WHERE <condition> [AND <condition>]...] [ORDER BY <column> LIMIT n OFFSET m]
+	parts := strings.SplitN(query, " ORDER BY ", 2)
+	clauses := strings.SplitN(parts[0], " WHERE ", 2)
+
+	fields := strings.Fields(clauses[0])
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("unsupported query %s", query)
+	}
+
+	table, ok := c.driver.tables[fields[3]]
+	if !ok {
+		return nil, fmt.Errorf("unknown table %s", fields[3])
+	}
+
+	stmt := &mockStmt{table: table, count: fields[1] == "COUNT(*)"}
+
+	if len(clauses) == 2 {
+		for _, condition := range strings.Split(clauses[1], " AND ") {
+			// <column> <op> ? or LOWER(<column>) LIKE ? ESCAPE '!'
+			f := strings.Fields(condition)
+			column := strings.TrimSuffix(strings.TrimPrefix(f[0], "LOWER("), ")")
+
+			stmt.conditions = append(stmt.conditions, [2]string{column, f[1]})
+		}
+	}
+
+	if len(parts) == 2 {
+		var column string
+
+		if _, err := fmt.Sscanf(parts[1], "%s LIMIT %d OFFSET %d", &column, &stmt.limit, &stmt.offset); err != nil {
+			return nil, fmt.Errorf("unsupported page %s : %w", parts[1], err)
+		}
+	}
+
+	return stmt, nil
+}
+
+func (c *mockConn) Close() error {
+	return nil
+}
+
+func (c *mockConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+type mockStmt struct {
+	table         *mockTable
+	conditions    [][2]string
+	count         bool
+	limit, offset int
+}
+
+func (s *mockStmt) Close() error {
+	return nil
+}
+
+func (s *mockStmt) NumInput() int {
+	return len(s.conditions)
+}
+
+func (s *mockStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *mockStmt) Query(args []driver.Value) (driver.Rows, error) {
+	var matches [][]driver.Value
+
+	for _, row := range s.table.rows {
+		if s.match(row, args) {
+			matches = append(matches, row)
+		}
+	}
+
+	if s.count {
+		return &mockRows{columns: []string{"COUNT(*)"}, rows: [][]driver.Value{{int64(len(matches))}}}, nil
+	}
+
+	if s.offset > len(matches) {
+		matches = nil
+	} else {
+		matches = matches[s.offset:]
+	}
+
+	if s.limit > 0 && s.limit < len(matches) {
+		matches = matches[:s.limit]
+	}
+
+	return &mockRows{columns: s.table.columns, rows: matches}, nil
+}
+
+func (s *mockStmt) match(row, args []driver.Value) bool {
+	for i, condition := range s.conditions {
+		var value string
+
+		for j, column := range s.table.columns {
+			if column == condition[0] {
+				value = fmt.Sprintf("%s", row[j])
+			}
+		}
+
+		if !matchCondition(value, condition[1], args[i].(string)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchCondition(value, op, arg string) bool {
+	switch op {
+	case "=":
+		return value == arg
+	case "<>":
+		return value != arg
+	case ">":
+		return compare(value, arg) > 0
+	case "LIKE":
+		unescaped := strings.NewReplacer("!!", "!", "!%", "%", "!_", "_").Replace(
+			strings.TrimPrefix(strings.TrimSuffix(arg, "%"), "%"))
+		if strings.HasPrefix(arg, "%") {
+			return strings.Contains(strings.ToLower(value), unescaped)
+		}
+
+		return strings.HasPrefix(strings.ToLower(value), unescaped)
+	default:
+		return false
+	}
+}
+
+type mockRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *mockRows) Columns() []string {
+	return r.columns
+}
+
+func (r *mockRows) Close() error {
+	return nil
+}
+
+func (r *mockRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	usersCollection = "users"
+)
+
+var errUsersCollection = errors.New("users is not a subject data collection")
+
+// StaticConfig configures the static source.
+type StaticConfig struct {
+	// Dir holds the users and the subject data of each collection as <collection>.json or <collection>.csv files.
+	Dir string `json:"dir"`
+}
+
+// Static is the source backed by the JSON and CSV files, which are loaded once.
+type Static struct {
+	collections map[string][]map[string]interface{}
+}
+
+// NewStatic returns new static source loading the files of the directory. The JSON files hold an array of records,
+// the header row of the CSV files holds the fields of the records.
+func NewStatic(dir string) (*Static, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read static data dir : %w", err)
+	}
+
+	s := &Static{collections: map[string][]map[string]interface{}{}}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(file.Name())
+		collection := strings.TrimSuffix(file.Name(), ext)
+
+		var records []map[string]interface{}
+
+		switch ext {
+		case ".json":
+			records, err = readJSONRecords(filepath.Join(dir, file.Name()))
+		case ".csv":
+			records, err = readCSVRecords(filepath.Join(dir, file.Name()))
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("read static data %s : %w", file.Name(), err)
+		}
+
+		s.collections[collection] = append(s.collections[collection], records...)
+	}
+
+	return s, nil
+}
+
+// User returns the single user whose field matches the value.
+func (s *Static) User(_ context.Context, field, value string) (*User, error) {
+	return singleUser(s.find(usersCollection, field, value))
+}
+
+// SubjectData returns the single record of the collection belonging to the user.
+func (s *Static) SubjectData(_ context.Context, collection, userID string) (map[string]interface{}, error) {
+	if collection == usersCollection {
+		return nil, errUsersCollection
+	}
+
+	return single(s.find(collection, userIDField, userID))
+}
+
+// Search returns all the records of the collection, which are filtered by the caller. The users can't be searched.
+func (s *Static) Search(_ context.Context, collection string, _ []Filter) ([]map[string]interface{}, error) {
+	if collection == usersCollection {
+		return nil, errUsersCollection
+	}
+
+	return s.collections[collection], nil
+}
+
+func (s *Static) find(collection, field, value string) []map[string]interface{} {
+	var records []map[string]interface{}
+
+	for _, record := range s.collections[collection] {
+		if stringValue(record[field]) == value {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+func readJSONRecords(path string) ([]map[string]interface{}, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []map[string]interface{}
+
+	err = json.Unmarshal(data, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func readCSVRecords(path string) ([]map[string]interface{}, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := f.Close(); e != nil {
+			logger.Warnf("failed to close file : %s", e)
+		}
+	}()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	records := make([]map[string]interface{}, 0, len(rows)-1)
+
+	for _, row := range rows[1:] {
+		record := make(map[string]interface{}, len(header))
+
+		for i, field := range header {
+			record[field] = row[i]
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
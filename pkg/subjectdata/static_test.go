@@ -0,0 +1,93 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatic(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "users.json"),
+		[]byte(`[{"userid":"100","name":"Foo Bar","email":"foo@bar.com"}]`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "studentcards.csv"),
+		[]byte("userid,university\n100,Faber College\n200,Faber College\n200,Faber College\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "ignored"), 0o700))
+
+	source, err := NewStatic(dir)
+	require.NoError(t, err)
+
+	t.Run("test success", func(t *testing.T) {
+		user, err := source.User(context.Background(), emailField, "foo@bar.com")
+		require.NoError(t, err)
+		require.Equal(t, &User{UserID: "100", Name: "Foo Bar", Email: "foo@bar.com"}, user)
+
+		data, err := source.SubjectData(context.Background(), "studentcards", user.UserID)
+		require.NoError(t, err)
+		require.Equal(t, "Faber College", data["university"])
+	})
+	t.Run("user not found", func(t *testing.T) {
+		user, err := source.User(context.Background(), emailField, "bar@foo.com")
+		require.ErrorIs(t, err, ErrUserNotFound)
+		require.Nil(t, user)
+	})
+	t.Run("multiple records found", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "studentcards", "200")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "multiple records found")
+		require.Nil(t, data)
+	})
+	t.Run("search", func(t *testing.T) {
+		records, err := source.Search(context.Background(), "studentcards", nil)
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+
+		records, err = source.Search(context.Background(), "unknowns", nil)
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+	t.Run("users collection", func(t *testing.T) {
+		data, err := source.SubjectData(context.Background(), "users", "100")
+		require.Error(t, err)
+		require.Nil(t, data)
+
+		records, err := source.Search(context.Background(), "users", nil)
+		require.Error(t, err)
+		require.Nil(t, records)
+	})
+	t.Run("missing dir", func(t *testing.T) {
+		s, err := NewStatic(filepath.Join(dir, "missing"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read static data dir")
+		require.Nil(t, s)
+	})
+	t.Run("invalid json", func(t *testing.T) {
+		invalidDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(invalidDir, "users.json"), []byte("{"), 0o600))
+
+		s, err := NewStatic(invalidDir)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read static data users.json")
+		require.Nil(t, s)
+	})
+	t.Run("invalid csv", func(t *testing.T) {
+		invalidDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(invalidDir, "users.csv"), []byte("userid,name\n100\n"), 0o600))
+
+		s, err := NewStatic(invalidDir)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read static data users.csv")
+		require.Nil(t, s)
+	})
+}
@@ -0,0 +1,286 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/trustbloc/edge-core/pkg/log"
+)
+
+const (
+	// CMSSourceName is the name of the CMS source, which is always available.
+	CMSSourceName = "cms"
+
+	// user fields
+	userIDField = "userid"
+	nameField   = "name"
+	emailField  = "email"
+)
+
+var logger = log.New("sandbox-subjectdata")
+
+var (
+	// ErrUserNotFound is returned when no user matches the search.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrRecordNotFound is returned when the user has no record in the collection.
+	ErrRecordNotFound = errors.New("record not found")
+)
+
+// User identifies the subject, subject data records reference it by user ID.
+type User struct {
+	UserID string `json:"userid"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+// Source of the users and their subject data.
+type Source interface {
+	// User returns the single user whose field matches the value.
+	User(ctx context.Context, field, value string) (*User, error)
+	// SubjectData returns the single record of the collection belonging to the user.
+	SubjectData(ctx context.Context, collection, userID string) (map[string]interface{}, error)
+}
+
+// Config of the subject data sources.
+type Config struct {
+	// Sources keyed by name, exactly one backend of each source is to be configured.
+	Sources map[string]*SourceConfig `json:"sources"`
+	// Scopes maps the credential scopes, or collections, to the names of the sources. The SQL sources serve the
+	// collections of their scopes besides those of their tables.
+	Scopes map[string]string `json:"scopes,omitempty"`
+	// Default is the name of the source of the scopes without a source, the CMS by default.
+	Default string `json:"default,omitempty"`
+}
+
+// SourceConfig configures the backend of the source.
+type SourceConfig struct {
+	CMS    *CMSConfig    `json:"cms,omitempty"`
+	SQL    *SQLConfig    `json:"sql,omitempty"`
+	Static *StaticConfig `json:"static,omitempty"`
+	REST   *RESTConfig   `json:"rest,omitempty"`
+}
+
+// CMSConfig configures the CMS source.
+type CMSConfig struct {
+	URL string `json:"url"`
+}
+
+// Sources routes the scopes to their sources.
+type Sources struct {
+	defaultSource Source
+	sources       map[string]Source
+	scopes        map[string]Source
+}
+
+// NewSources returns the sources, which route every scope to the CMS one.
+func NewSources(cms Source) *Sources {
+	return &Sources{
+		defaultSource: cms,
+		sources:       map[string]Source{CMSSourceName: cms},
+		scopes:        map[string]Source{},
+	}
+}
+
+// New returns the sources configured by the config file, the CMS source serves the scopes without a source.
+func New(configPath string, cms Source, httpClient *http.Client) (*Sources, error) {
+	config, err := readConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewSources(cms)
+
+	for name, sourceConfig := range config.Sources {
+		source, e := newSource(sourceConfig, httpClient)
+		if e != nil {
+			return nil, fmt.Errorf("subject data source %s : %w", name, e)
+		}
+
+		s.sources[name] = source
+	}
+
+	for scope, name := range config.Scopes {
+		source, ok := s.sources[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown subject data source %s of scope %s", name, scope)
+		}
+
+		s.scopes[scope] = source
+
+		if sqlSource, ok := source.(*SQL); ok {
+			sqlSource.allowCollection(scope)
+		}
+	}
+
+	if config.Default != "" {
+		source, ok := s.sources[config.Default]
+		if !ok {
+			return nil, fmt.Errorf("unknown default subject data source %s", config.Default)
+		}
+
+		s.defaultSource = source
+	}
+
+	return s, nil
+}
+
+// Source returns the source of the first of the scopes having one, or the default source.
+func (s *Sources) Source(scopes ...string) Source {
+	for _, scope := range scopes {
+		if source, ok := s.scopes[scope]; ok {
+			return source
+		}
+	}
+
+	return s.defaultSource
+}
+
+func readConfig(configPath string) (*Config, error) {
+	configBytes, err := os.ReadFile(filepath.Clean(configPath))
+	if err != nil {
+		return nil, fmt.Errorf("read subject data source config : %w", err)
+	}
+
+	config := &Config{}
+
+	err = json.Unmarshal(configBytes, config)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal subject data source config : %w", err)
+	}
+
+	return config, nil
+}
+
+func newSource(config *SourceConfig, httpClient *http.Client) (Source, error) {
+	switch {
+	case config.CMS != nil:
+		return NewCMS(config.CMS.URL, httpClient), nil
+	case config.SQL != nil:
+		db, err := sql.Open(config.SQL.Driver, config.SQL.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("open database : %w", err)
+		}
+
+		return NewSQL(db, config.SQL)
+	case config.Static != nil:
+		return NewStatic(config.Static.Dir)
+	case config.REST != nil:
+		return NewREST(config.REST, httpClient)
+	default:
+		return nil, errors.New("missing source backend")
+	}
+}
+
+type contextKey int
+
+const (
+	httpClientContextKey contextKey = iota
+	tokenContextKey
+)
+
+// WithHTTPClient returns the context making the HTTP sources send the requests using the client, e.g. the one
+// authorized by the OAuth2 token of the user.
+func WithHTTPClient(ctx context.Context, httpClient *http.Client) context.Context {
+	return context.WithValue(ctx, httpClientContextKey, httpClient)
+}
+
+// WithToken returns the context making the HTTP sources send the bearer token.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenContextKey, token)
+}
+
+// get sends the GET request using the HTTP client of the context, if any, and returns the body of the OK response.
+func get(ctx context.Context, httpClient *http.Client, u, token string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if client, ok := ctx.Value(httpClientContextKey).(*http.Client); ok && client != nil {
+		httpClient = client
+	}
+
+	if t, ok := ctx.Value(tokenContextKey).(string); ok && token == "" {
+		token = t
+	}
+
+	if token != "" {
+		req.Header.Add("Authorization", "Bearer "+token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Warnf("failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body : %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, string(body))
+	}
+
+	return body, nil
+}
+
+// single returns the single record, there must be exactly one.
+func single(records []map[string]interface{}) (map[string]interface{}, error) {
+	if len(records) == 0 {
+		return nil, ErrRecordNotFound
+	}
+
+	if len(records) > 1 {
+		return nil, errors.New("multiple records found")
+	}
+
+	return records[0], nil
+}
+
+// singleUser returns the user of the single record, there must be exactly one.
+func singleUser(records []map[string]interface{}) (*User, error) {
+	if len(records) == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	if len(records) > 1 {
+		return nil, errors.New("multiple users found")
+	}
+
+	return &User{
+		UserID: stringValue(records[0][userIDField]),
+		Name:   stringValue(records[0][nameField]),
+		Email:  stringValue(records[0][emailField]),
+	}, nil
+}
+
+func stringValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subjectdata
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	cms := NewCMS("https://cms.example.com", http.DefaultClient)
+
+	writeConfig := func(t *testing.T, config string) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), "sources.json")
+		require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+
+		return path
+	}
+
+	t.Run("test success", func(t *testing.T) {
+		staticDir := t.TempDir()
+
+		sources, err := New(writeConfig(t, `{
+			"sources": {
+				"files": {"static": {"dir": "`+staticDir+`"}},
+				"api": {"rest": {"userURL": "https://api.example.com/users", "dataURL": "https://api.example.com/data"}},
+				"db": {"sql": {"driver": "`+testDriverName+`"}},
+				"cms2": {"cms": {"url": "https://cms2.example.com"}}
+			},
+			"scopes": {"StudentCard": "files", "prc": "api", "mdl": "db", "CreditCardStatement": "cms"},
+			"default": "cms2"
+		}`), cms, http.DefaultClient)
+		require.NoError(t, err)
+
+		require.IsType(t, &Static{}, sources.Source("StudentCard"))
+		require.IsType(t, &REST{}, sources.Source("unknown", "prc"))
+		require.IsType(t, &SQL{}, sources.Source("mdl"))
+		require.Equal(t, map[string]string{"mdl": "mdl"}, sources.Source("mdl").(*SQL).tables)
+		require.Equal(t, &CMS{url: "https://cms2.example.com", httpClient: http.DefaultClient}, sources.Source("unknown"))
+		require.Equal(t, cms, sources.Source("CreditCardStatement"))
+	})
+	t.Run("default cms source", func(t *testing.T) {
+		sources := NewSources(cms)
+		require.Equal(t, cms, sources.Source("StudentCard"))
+		require.Equal(t, cms, sources.Source())
+	})
+	t.Run("missing config file", func(t *testing.T) {
+		sources, err := New(filepath.Join(t.TempDir(), "missing.json"), cms, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read subject data source config")
+		require.Nil(t, sources)
+	})
+	t.Run("invalid config file", func(t *testing.T) {
+		sources, err := New(writeConfig(t, "{"), cms, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal subject data source config")
+		require.Nil(t, sources)
+	})
+	t.Run("missing source backend", func(t *testing.T) {
+		sources, err := New(writeConfig(t, `{"sources":{"files":{}}}`), cms, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "subject data source files : missing source backend")
+		require.Nil(t, sources)
+	})
+	t.Run("invalid source", func(t *testing.T) {
+		sources, err := New(writeConfig(t, `{"sources":{"db":{"sql":{"driver":"unknown"}}}}`), cms, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open database")
+		require.Nil(t, sources)
+	})
+	t.Run("unknown scope source", func(t *testing.T) {
+		sources, err := New(writeConfig(t, `{"scopes":{"prc":"api"}}`), cms, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown subject data source api of scope prc")
+		require.Nil(t, sources)
+	})
+	t.Run("unknown default source", func(t *testing.T) {
+		sources, err := New(writeConfig(t, `{"default":"api"}`), cms, http.DefaultClient)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown default subject data source api")
+		require.Nil(t, sources)
+	})
+}
@@ -0,0 +1,545 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package trustregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/trustbloc/edge-core/pkg/log"
+)
+
+const (
+	// store
+	trustStoreName = "rp_trust_registry"
+	issuerTagName  = "trustedIssuer"
+
+	// SourceAdmin is the source of the issuers managed through the admin API.
+	SourceAdmin = "admin"
+	// SourceFile is the source of the issuers of the trust list file.
+	SourceFile = "file"
+	// SourceRemote is the source of the issuers of the remote trust list.
+	SourceRemote = "remote"
+
+	// AssuranceLow is the lowest assurance level, the level of the issuers without one.
+	AssuranceLow AssuranceLevel = "low"
+	// AssuranceSubstantial is the substantial assurance level.
+	AssuranceSubstantial AssuranceLevel = "substantial"
+	// AssuranceHigh is the highest assurance level.
+	AssuranceHigh AssuranceLevel = "high"
+
+	// DefaultRefreshInterval is the interval the remote trust list is fetched again by default.
+	DefaultRefreshInterval = 10 * time.Minute
+
+	verifiableCredentialType = "VerifiableCredential"
+)
+
+var logger = log.New("sandbox-trustregistry")
+
+var (
+	// ErrIssuerNotFound is returned when the issuer isn't in the trust registry.
+	ErrIssuerNotFound = errors.New("trusted issuer not found")
+	// ErrInvalidIssuer is returned when the trusted issuer is rejected by the registry.
+	ErrInvalidIssuer = errors.New("invalid trusted issuer")
+	// ErrNotTrusted is returned when the issuer isn't trusted for the credential.
+	ErrNotTrusted = errors.New("issuer not trusted")
+)
+
+// assuranceRanks orders the assurance levels.
+var assuranceRanks = map[AssuranceLevel]int{ // nolint: gochecknoglobals
+	AssuranceLow:         1,
+	AssuranceSubstantial: 2,
+	AssuranceHigh:        3,
+}
+
+// AssuranceLevel of the trusted issuer, following the eIDAS levels.
+type AssuranceLevel string
+
+// Issuer is the trusted issuer.
+type Issuer struct {
+	DID  string `json:"did"`
+	Name string `json:"name,omitempty"`
+	// CredentialTypes the issuer is trusted for, any type if empty.
+	CredentialTypes []string `json:"credentialTypes,omitempty"`
+	// ValidFrom and ValidUntil bound the issuance dates of the credentials the issuer is trusted for.
+	ValidFrom      *time.Time     `json:"validFrom,omitempty"`
+	ValidUntil     *time.Time     `json:"validUntil,omitempty"`
+	AssuranceLevel AssuranceLevel `json:"assuranceLevel,omitempty"`
+	// Source of the issuer, set by the registry.
+	Source string `json:"source,omitempty"`
+}
+
+// List is the trust list of the file and the remote URL.
+type List struct {
+	Issuers []*Issuer `json:"issuers"`
+}
+
+// Registry of the trusted issuers. The issuers are managed through the admin API and loaded from the trust list
+// file, which is reloaded when modified, and from the remote trust list, which is fetched again periodically.
+// The admin API issuers take precedence over the file ones, which take precedence over the remote ones.
+type Registry struct {
+	store           storage.Store
+	file            string
+	remoteURL       string
+	httpClient      *http.Client
+	refreshInterval time.Duration
+	minAssurance    AssuranceLevel
+	now             func() time.Time
+
+	mutex           sync.Mutex
+	fileIssuers     map[string]*Issuer
+	fileModTime     time.Time
+	remoteIssuers   map[string]*Issuer
+	remoteFetchedAt time.Time
+}
+
+// Opt configures the registry.
+type Opt func(r *Registry)
+
+// WithFile sets the trust list file.
+func WithFile(path string) Opt {
+	return func(r *Registry) {
+		r.file = path
+	}
+}
+
+// WithRemoteList sets the URL of the remote trust list.
+func WithRemoteList(listURL string) Opt {
+	return func(r *Registry) {
+		r.remoteURL = listURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client fetching the remote trust list.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(r *Registry) {
+		r.httpClient = client
+	}
+}
+
+// WithRefreshInterval sets the interval the remote trust list is fetched again, DefaultRefreshInterval by default.
+func WithRefreshInterval(interval time.Duration) Opt {
+	return func(r *Registry) {
+		r.refreshInterval = interval
+	}
+}
+
+// WithMinAssuranceLevel sets the assurance level the issuers must have at least to be trusted.
+func WithMinAssuranceLevel(level AssuranceLevel) Opt {
+	return func(r *Registry) {
+		r.minAssurance = level
+	}
+}
+
+// New returns new trust registry keeping the admin API issuers in the given storage provider.
+func New(provider storage.Provider, opts ...Opt) (*Registry, error) {
+	store, err := provider.OpenStore(trustStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open trust registry store : %w", err)
+	}
+
+	err = provider.SetStoreConfig(trustStoreName, storage.StoreConfiguration{TagNames: []string{issuerTagName}})
+	if err != nil {
+		return nil, fmt.Errorf("set trust registry store configuration : %w", err)
+	}
+
+	r := &Registry{
+		store:           store,
+		httpClient:      http.DefaultClient,
+		refreshInterval: DefaultRefreshInterval,
+		now:             time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.minAssurance != "" && assuranceRanks[r.minAssurance] == 0 {
+		return nil, fmt.Errorf("unsupported assurance level %s", r.minAssurance)
+	}
+
+	if r.file != "" {
+		if _, err = r.fileList(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Put validates the issuer and saves it as an admin API issuer, replacing an existing one with the same DID.
+func (r *Registry) Put(issuer *Issuer) (*Issuer, error) {
+	i := *issuer
+	i.Source = SourceAdmin
+
+	if err := validate(&i); err != nil {
+		return nil, err
+	}
+
+	issuerBytes, err := json.Marshal(&i)
+	if err != nil {
+		return nil, fmt.Errorf("marshal trusted issuer : %w", err)
+	}
+
+	err = r.store.Put(i.DID, issuerBytes, storage.Tag{Name: issuerTagName})
+	if err != nil {
+		return nil, fmt.Errorf("save trusted issuer : %w", err)
+	}
+
+	return &i, nil
+}
+
+// Get returns the trusted issuer with the given DID.
+func (r *Registry) Get(did string) (*Issuer, error) {
+	adminIssuer, err := r.adminIssuer(did)
+	if !errors.Is(err, ErrIssuerNotFound) {
+		return adminIssuer, err
+	}
+
+	fileIssuers, err := r.fileList()
+	if err != nil {
+		return nil, err
+	}
+
+	if issuer, ok := fileIssuers[did]; ok {
+		return issuer, nil
+	}
+
+	if issuer, ok := r.remoteList()[did]; ok {
+		return issuer, nil
+	}
+
+	return nil, fmt.Errorf("%w : %s", ErrIssuerNotFound, did)
+}
+
+// List returns the trusted issuers of all the sources sorted by DID.
+func (r *Registry) List() ([]*Issuer, error) {
+	issuers := map[string]*Issuer{}
+
+	for did, issuer := range r.remoteList() {
+		issuers[did] = issuer
+	}
+
+	fileIssuers, err := r.fileList()
+	if err != nil {
+		return nil, err
+	}
+
+	for did, issuer := range fileIssuers {
+		issuers[did] = issuer
+	}
+
+	adminIssuers, err := r.adminList()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issuer := range adminIssuers {
+		issuers[issuer.DID] = issuer
+	}
+
+	result := make([]*Issuer, 0, len(issuers))
+	for _, issuer := range issuers {
+		result = append(result, issuer)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].DID < result[j].DID })
+
+	return result, nil
+}
+
+// Delete removes the admin API issuer, the issuers of the trust lists can't be removed.
+func (r *Registry) Delete(did string) error {
+	_, err := r.adminIssuer(did)
+	if err != nil {
+		return err
+	}
+
+	err = r.store.Delete(did)
+	if err != nil {
+		return fmt.Errorf("delete trusted issuer : %w", err)
+	}
+
+	return nil
+}
+
+// Evaluate checks that the issuer is trusted for the credential types both at the issuance date of the credential
+// and at the time of the verification, and has the required assurance level. ErrNotTrusted is returned with the reason if it isn't.
+func (r *Registry) Evaluate(did string, types []string, issued time.Time) error {
+	issuer, err := r.Get(did)
+	if err != nil {
+		if errors.Is(err, ErrIssuerNotFound) {
+			return fmt.Errorf("%w : issuer %s isn't in the trust registry", ErrNotTrusted, did)
+		}
+
+		return err
+	}
+
+	if notAllowed := issuer.notAllowedTypes(types); len(notAllowed) > 0 {
+		return fmt.Errorf("%w : issuer %s isn't trusted for the credential types %s", ErrNotTrusted, did,
+			strings.Join(notAllowed, ", "))
+	}
+
+	if !issuer.trustedAt(issued) {
+		return fmt.Errorf("%w : credential issued at %s is out of the trust period of the issuer %s", ErrNotTrusted,
+			issued.UTC().Format(time.RFC3339), did)
+	}
+
+	if now := r.now(); !issuer.trustedAt(now) {
+		return fmt.Errorf("%w : issuer %s is out of its trust period at %s", ErrNotTrusted, did,
+			now.UTC().Format(time.RFC3339))
+	}
+
+	level := issuer.AssuranceLevel
+	if level == "" {
+		level = AssuranceLow
+	}
+
+	if assuranceRanks[level] < assuranceRanks[r.minAssurance] {
+		return fmt.Errorf("%w : assurance level %s of the issuer %s is below the required %s", ErrNotTrusted,
+			level, did, r.minAssurance)
+	}
+
+	return nil
+}
+
+// trustedAt checks that the time is within the trust period of the issuer.
+func (i *Issuer) trustedAt(t time.Time) bool {
+	if i.ValidFrom != nil && t.Before(*i.ValidFrom) {
+		return false
+	}
+
+	return i.ValidUntil == nil || !t.After(*i.ValidUntil)
+}
+
+// notAllowedTypes returns the credential types the issuer isn't trusted for. The VerifiableCredential type is
+// ignored unless it's the only one.
+func (i *Issuer) notAllowedTypes(types []string) []string {
+	if len(i.CredentialTypes) == 0 {
+		return nil
+	}
+
+	allowed := map[string]bool{}
+	for _, t := range i.CredentialTypes {
+		allowed[t] = true
+	}
+
+	var notAllowed []string
+
+	for _, t := range types {
+		if t == verifiableCredentialType && len(types) > 1 {
+			continue
+		}
+
+		if !allowed[t] {
+			notAllowed = append(notAllowed, t)
+		}
+	}
+
+	return notAllowed
+}
+
+func (r *Registry) adminIssuer(did string) (*Issuer, error) {
+	issuerBytes, err := r.store.Get(did)
+	if err != nil {
+		if errors.Is(err, storage.ErrDataNotFound) {
+			return nil, fmt.Errorf("%w : %s", ErrIssuerNotFound, did)
+		}
+
+		return nil, fmt.Errorf("get trusted issuer : %w", err)
+	}
+
+	issuer := &Issuer{}
+
+	err = json.Unmarshal(issuerBytes, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal trusted issuer : %w", err)
+	}
+
+	return issuer, nil
+}
+
+func (r *Registry) adminList() ([]*Issuer, error) {
+	iter, err := r.store.Query(issuerTagName)
+	if err != nil {
+		return nil, fmt.Errorf("query trusted issuers : %w", err)
+	}
+
+	defer func() {
+		if e := iter.Close(); e != nil {
+			logger.Warnf("failed to close iterator : %s", e)
+		}
+	}()
+
+	var issuers []*Issuer
+
+	more, err := iter.Next()
+
+	for ; err == nil && more; more, err = iter.Next() {
+		issuerBytes, e := iter.Value()
+		if e != nil {
+			return nil, fmt.Errorf("get trusted issuer : %w", e)
+		}
+
+		issuer := &Issuer{}
+
+		e = json.Unmarshal(issuerBytes, issuer)
+		if e != nil {
+			return nil, fmt.Errorf("unmarshal trusted issuer : %w", e)
+		}
+
+		issuers = append(issuers, issuer)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("iterate trusted issuers : %w", err)
+	}
+
+	return issuers, nil
+}
+
+// fileList returns the issuers of the trust list file, which is read again if modified. The registry fails closed,
+// the error is returned if the file can't be read.
+func (r *Registry) fileList() (map[string]*Issuer, error) {
+	if r.file == "" {
+		return map[string]*Issuer{}, nil
+	}
+
+	info, err := os.Stat(r.file)
+	if err != nil {
+		return nil, fmt.Errorf("stat trust list file : %w", err)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !info.ModTime().Equal(r.fileModTime) {
+		data, e := os.ReadFile(r.file) // nolint:gosec
+		if e != nil {
+			return nil, fmt.Errorf("read trust list file : %w", e)
+		}
+
+		issuers, e := parseList(data, SourceFile)
+		if e != nil {
+			return nil, fmt.Errorf("trust list file : %w", e)
+		}
+
+		r.fileIssuers = issuers
+		r.fileModTime = info.ModTime()
+
+		logger.Infof("loaded %d trusted issuers from %s", len(issuers), r.file)
+	}
+
+	return r.fileIssuers, nil
+}
+
+// remoteList returns the issuers of the remote trust list, fetched again if the refresh interval elapsed. The last
+// fetched list is kept if the remote trust list can't be fetched.
+func (r *Registry) remoteList() map[string]*Issuer {
+	if r.remoteURL == "" {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.remoteFetchedAt.IsZero() && r.now().Before(r.remoteFetchedAt.Add(r.refreshInterval)) {
+		return r.remoteIssuers
+	}
+
+	r.remoteFetchedAt = r.now()
+
+	issuers, err := r.fetch()
+	if err != nil {
+		logger.Warnf("failed to fetch trust list %s, keeping %d trusted issuers : %s", r.remoteURL,
+			len(r.remoteIssuers), err)
+
+		return r.remoteIssuers
+	}
+
+	r.remoteIssuers = issuers
+
+	logger.Infof("fetched %d trusted issuers from %s", len(issuers), r.remoteURL)
+
+	return r.remoteIssuers
+}
+
+func (r *Registry) fetch() (map[string]*Issuer, error) {
+	req, err := http.NewRequest(http.MethodGet, r.remoteURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Warnf("failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, string(body))
+	}
+
+	return parseList(body, SourceRemote)
+}
+
+func parseList(data []byte, source string) (map[string]*Issuer, error) {
+	list := &List{}
+
+	if err := json.Unmarshal(data, list); err != nil {
+		return nil, fmt.Errorf("parse trust list : %w", err)
+	}
+
+	issuers := make(map[string]*Issuer, len(list.Issuers))
+
+	for _, issuer := range list.Issuers {
+		issuer.Source = source
+
+		if err := validate(issuer); err != nil {
+			return nil, err
+		}
+
+		issuers[issuer.DID] = issuer
+	}
+
+	return issuers, nil
+}
+
+func validate(issuer *Issuer) error {
+	if !strings.HasPrefix(issuer.DID, "did:") {
+		return fmt.Errorf("%w : invalid DID %s", ErrInvalidIssuer, issuer.DID)
+	}
+
+	if issuer.AssuranceLevel != "" && assuranceRanks[issuer.AssuranceLevel] == 0 {
+		return fmt.Errorf("%w : unsupported assurance level %s", ErrInvalidIssuer, issuer.AssuranceLevel)
+	}
+
+	if issuer.ValidFrom != nil && issuer.ValidUntil != nil && issuer.ValidUntil.Before(*issuer.ValidFrom) {
+		return fmt.Errorf("%w : validUntil of %s is before validFrom", ErrInvalidIssuer, issuer.DID)
+	}
+
+	return nil
+}
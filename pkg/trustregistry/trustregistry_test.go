@@ -0,0 +1,435 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package trustregistry
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFileList = `{"issuers": [
+  {"did": "did:example:university", "name": "University", "credentialTypes": ["UniversityDegreeCredential"],
+   "validFrom": "2020-01-01T00:00:00Z", "validUntil": "2030-01-01T00:00:00Z", "assuranceLevel": "high"},
+  {"did": "did:example:shared", "name": "File"}
+]}`
+	testRemoteList = `{"issuers": [
+  {"did": "did:example:government", "credentialTypes": ["PermanentResidentCard"], "assuranceLevel": "substantial"},
+  {"did": "did:example:shared", "name": "Remote"}
+]}`
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r, err := New(mem.NewProvider(), WithFile(writeList(t, testFileList)), WithRemoteList("https://example.com/list"),
+			WithHTTPClient(&http.Client{}), WithRefreshInterval(time.Minute), WithMinAssuranceLevel(AssuranceHigh))
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/list", r.remoteURL)
+		require.Equal(t, time.Minute, r.refreshInterval)
+		require.Equal(t, AssuranceHigh, r.minAssurance)
+		require.Len(t, r.fileIssuers, 2)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open trust registry store : open error")
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{
+			OpenStoreReturn:   &mockstorage.Store{},
+			ErrSetStoreConfig: errors.New("config error"),
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "set trust registry store configuration : config error")
+	})
+
+	t.Run("unsupported assurance level", func(t *testing.T) {
+		_, err := New(mem.NewProvider(), WithMinAssuranceLevel("medium"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported assurance level medium")
+	})
+
+	t.Run("invalid trust list file", func(t *testing.T) {
+		_, err := New(mem.NewProvider(), WithFile(filepath.Join(t.TempDir(), "missing.json")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "stat trust list file")
+
+		_, err = New(mem.NewProvider(), WithFile(writeList(t, "{")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "trust list file : parse trust list")
+
+		_, err = New(mem.NewProvider(), WithFile(writeList(t, `{"issuers": [{"did": "university"}]}`)))
+		require.ErrorIs(t, err, ErrInvalidIssuer)
+		require.Contains(t, err.Error(), "invalid DID university")
+	})
+}
+
+func TestRegistry_Put(t *testing.T) {
+	r, err := New(mem.NewProvider())
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		issuer, err := r.Put(&Issuer{DID: "did:example:bank", Source: SourceRemote, AssuranceLevel: AssuranceLow})
+		require.NoError(t, err)
+		require.Equal(t, SourceAdmin, issuer.Source)
+
+		saved, err := r.Get("did:example:bank")
+		require.NoError(t, err)
+		require.Equal(t, issuer, saved)
+	})
+
+	t.Run("invalid issuer", func(t *testing.T) {
+		from, until := time.Now(), time.Now().Add(-time.Hour)
+
+		for issuer, errMsg := range map[*Issuer]string{
+			{DID: "bank"}: "invalid DID bank",
+			{DID: "did:example:bank", AssuranceLevel: "medium"}:             "unsupported assurance level medium",
+			{DID: "did:example:bank", ValidFrom: &from, ValidUntil: &until}: "validUntil of did:example:bank is before",
+		} {
+			_, err := r.Put(issuer)
+			require.ErrorIs(t, err, ErrInvalidIssuer)
+			require.Contains(t, err.Error(), errMsg)
+		}
+	})
+
+	t.Run("save error", func(t *testing.T) {
+		r := &Registry{store: &mockstorage.Store{ErrPut: errors.New("put error")}}
+
+		_, err := r.Put(&Issuer{DID: "did:example:bank"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save trusted issuer : put error")
+	})
+}
+
+func TestRegistry_Get(t *testing.T) {
+	t.Run("sources precedence", func(t *testing.T) {
+		r, err := New(mem.NewProvider(), WithFile(writeList(t, testFileList)),
+			WithRemoteList(listServer(t, testRemoteList, nil).URL))
+		require.NoError(t, err)
+
+		issuer, err := r.Get("did:example:government")
+		require.NoError(t, err)
+		require.Equal(t, SourceRemote, issuer.Source)
+
+		issuer, err = r.Get("did:example:shared")
+		require.NoError(t, err)
+		require.Equal(t, SourceFile, issuer.Source)
+		require.Equal(t, "File", issuer.Name)
+
+		_, err = r.Put(&Issuer{DID: "did:example:shared", Name: "Admin"})
+		require.NoError(t, err)
+
+		issuer, err = r.Get("did:example:shared")
+		require.NoError(t, err)
+		require.Equal(t, SourceAdmin, issuer.Source)
+		require.Equal(t, "Admin", issuer.Name)
+
+		_, err = r.Get("did:example:unknown")
+		require.ErrorIs(t, err, ErrIssuerNotFound)
+	})
+
+	t.Run("get error", func(t *testing.T) {
+		r := &Registry{store: &mockstorage.Store{ErrGet: errors.New("get error")}}
+
+		_, err := r.Get("did:example:bank")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get trusted issuer : get error")
+	})
+
+	t.Run("invalid issuer", func(t *testing.T) {
+		r := &Registry{store: &mockstorage.Store{GetReturn: []byte("{")}}
+
+		_, err := r.Get("did:example:bank")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal trusted issuer")
+	})
+
+	t.Run("trust list file removed", func(t *testing.T) {
+		file := writeList(t, testFileList)
+
+		r, err := New(mem.NewProvider(), WithFile(file))
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(file))
+
+		_, err = r.Get("did:example:university")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "stat trust list file")
+	})
+}
+
+func TestRegistry_FileReload(t *testing.T) {
+	file := writeList(t, testFileList)
+
+	r, err := New(mem.NewProvider(), WithFile(file))
+	require.NoError(t, err)
+
+	_, err = r.Get("did:example:government")
+	require.ErrorIs(t, err, ErrIssuerNotFound)
+
+	require.NoError(t, os.WriteFile(file, []byte(testRemoteList), 0o600))
+	require.NoError(t, os.Chtimes(file, time.Now().Add(time.Minute), time.Now().Add(time.Minute)))
+
+	issuer, err := r.Get("did:example:government")
+	require.NoError(t, err)
+	require.Equal(t, SourceFile, issuer.Source)
+
+	_, err = r.Get("did:example:university")
+	require.ErrorIs(t, err, ErrIssuerNotFound)
+}
+
+func TestRegistry_RemoteRefresh(t *testing.T) {
+	var (
+		fetches int32
+		failing atomic.Value
+	)
+
+	failing.Store(false)
+
+	server := listServer(t, testRemoteList, func(w http.ResponseWriter) bool {
+		atomic.AddInt32(&fetches, 1)
+
+		if failing.Load().(bool) {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return true
+		}
+
+		return false
+	})
+
+	now := time.Now()
+
+	r, err := New(mem.NewProvider(), WithRemoteList(server.URL), WithRefreshInterval(time.Minute))
+	require.NoError(t, err)
+
+	r.now = func() time.Time { return now }
+
+	_, err = r.Get("did:example:government")
+	require.NoError(t, err)
+
+	_, err = r.Get("did:example:shared")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	failing.Store(true)
+
+	now = now.Add(2 * time.Minute)
+
+	issuer, err := r.Get("did:example:government")
+	require.NoError(t, err)
+	require.Equal(t, SourceRemote, issuer.Source)
+	require.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+
+	r = &Registry{remoteURL: server.URL, httpClient: http.DefaultClient, now: time.Now,
+		store: &mockstorage.Store{ErrGet: storage.ErrDataNotFound}}
+
+	_, err = r.Get("did:example:government")
+	require.ErrorIs(t, err, ErrIssuerNotFound)
+
+	r.remoteURL = "%"
+	require.Empty(t, r.remoteList())
+}
+
+func TestRegistry_ListAndDelete(t *testing.T) {
+	r, err := New(mem.NewProvider(), WithFile(writeList(t, testFileList)),
+		WithRemoteList(listServer(t, testRemoteList, nil).URL))
+	require.NoError(t, err)
+
+	_, err = r.Put(&Issuer{DID: "did:example:bank"})
+	require.NoError(t, err)
+
+	_, err = r.Put(&Issuer{DID: "did:example:shared", Name: "Admin"})
+	require.NoError(t, err)
+
+	issuers, err := r.List()
+	require.NoError(t, err)
+	require.Len(t, issuers, 4)
+	require.Equal(t, "did:example:bank", issuers[0].DID)
+	require.Equal(t, "did:example:shared", issuers[2].DID)
+	require.Equal(t, SourceAdmin, issuers[2].Source)
+	require.Equal(t, "did:example:university", issuers[3].DID)
+
+	require.NoError(t, r.Delete("did:example:shared"))
+	require.ErrorIs(t, r.Delete("did:example:shared"), ErrIssuerNotFound)
+	require.ErrorIs(t, r.Delete("did:example:university"), ErrIssuerNotFound)
+
+	issuers, err = r.List()
+	require.NoError(t, err)
+	require.Len(t, issuers, 4)
+	require.Equal(t, SourceFile, issuers[2].Source)
+}
+
+func TestRegistry_ListErrors(t *testing.T) {
+	for iter, errMsg := range map[*mockstorage.Iterator]string{
+		{ErrNext: errors.New("next error")}:                     "iterate trusted issuers : next error",
+		{NextReturn: true, ErrValue: errors.New("value error")}: "get trusted issuer : value error",
+		{NextReturn: true, ValueReturn: []byte("{")}:            "unmarshal trusted issuer",
+	} {
+		r := &Registry{store: &mockstorage.Store{QueryReturn: iter}}
+
+		_, err := r.List()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errMsg)
+	}
+
+	r := &Registry{store: &mockstorage.Store{ErrQuery: errors.New("query error")}}
+
+	_, err := r.List()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query trusted issuers : query error")
+
+	r = &Registry{file: filepath.Join(t.TempDir(), "missing.json")}
+
+	_, err = r.List()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "stat trust list file")
+}
+
+func TestRegistry_DeleteError(t *testing.T) {
+	r := &Registry{store: &mockstorage.Store{GetReturn: []byte("{}"), ErrDelete: errors.New("delete error")}}
+
+	err := r.Delete("did:example:bank")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "delete trusted issuer : delete error")
+}
+
+func TestRegistry_Evaluate(t *testing.T) {
+	r, err := New(mem.NewProvider(), WithFile(writeList(t, testFileList)),
+		WithRemoteList(listServer(t, testRemoteList, nil).URL))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	issued := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("trusted", func(t *testing.T) {
+		require.NoError(t, r.Evaluate("did:example:university",
+			[]string{"VerifiableCredential", "UniversityDegreeCredential"}, issued))
+		require.NoError(t, r.Evaluate("did:example:government",
+			[]string{"VerifiableCredential", "PermanentResidentCard"}, issued))
+		require.NoError(t, r.Evaluate("did:example:shared", []string{"VerifiableCredential", "AnyCredential"}, issued))
+	})
+
+	t.Run("not trusted", func(t *testing.T) {
+		for _, tc := range []struct {
+			did    string
+			types  []string
+			issued time.Time
+			errMsg string
+		}{
+			{
+				did:    "did:example:unknown",
+				types:  []string{"VerifiableCredential"},
+				issued: issued,
+				errMsg: "issuer did:example:unknown isn't in the trust registry",
+			},
+			{
+				did:    "did:example:university",
+				types:  []string{"VerifiableCredential", "PermanentResidentCard"},
+				issued: issued,
+				errMsg: "isn't trusted for the credential types PermanentResidentCard",
+			},
+			{
+				did:    "did:example:university",
+				types:  []string{"VerifiableCredential"},
+				issued: issued,
+				errMsg: "isn't trusted for the credential types VerifiableCredential",
+			},
+			{
+				did:    "did:example:university",
+				types:  []string{"UniversityDegreeCredential"},
+				issued: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				errMsg: "credential issued at 2019-01-01T00:00:00Z is out of the trust period",
+			},
+			{
+				did:    "did:example:university",
+				types:  []string{"UniversityDegreeCredential"},
+				issued: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+				errMsg: "credential issued at 2031-01-01T00:00:00Z is out of the trust period",
+			},
+		} {
+			err := r.Evaluate(tc.did, tc.types, tc.issued)
+			require.ErrorIs(t, err, ErrNotTrusted)
+			require.Contains(t, err.Error(), tc.errMsg)
+		}
+	})
+
+	t.Run("issuer expired at verification", func(t *testing.T) {
+		r.now = func() time.Time { return time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC) }
+		defer func() { r.now = func() time.Time { return now } }()
+
+		err := r.Evaluate("did:example:university", []string{"UniversityDegreeCredential"}, issued)
+		require.ErrorIs(t, err, ErrNotTrusted)
+		require.Contains(t, err.Error(), "issuer did:example:university is out of its trust period at "+
+			"2031-01-01T00:00:00Z")
+
+		require.NoError(t, r.Evaluate("did:example:government", []string{"PermanentResidentCard"}, issued))
+	})
+
+	t.Run("assurance level", func(t *testing.T) {
+		r.minAssurance = AssuranceSubstantial
+		defer func() { r.minAssurance = "" }()
+
+		require.NoError(t, r.Evaluate("did:example:university", []string{"UniversityDegreeCredential"}, issued))
+		require.NoError(t, r.Evaluate("did:example:government", []string{"PermanentResidentCard"}, issued))
+
+		err := r.Evaluate("did:example:shared", []string{"AnyCredential"}, issued)
+		require.ErrorIs(t, err, ErrNotTrusted)
+		require.Contains(t, err.Error(), "assurance level low of the issuer did:example:shared is below the "+
+			"required substantial")
+	})
+
+	t.Run("registry error", func(t *testing.T) {
+		r := &Registry{store: &mockstorage.Store{ErrGet: errors.New("get error")}}
+
+		err := r.Evaluate("did:example:bank", nil, issued)
+		require.Error(t, err)
+		require.False(t, errors.Is(err, ErrNotTrusted))
+		require.Contains(t, err.Error(), "get trusted issuer : get error")
+	})
+}
+
+func writeList(t *testing.T, list string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "trust-list.json")
+	require.NoError(t, os.WriteFile(file, []byte(list), 0o600))
+
+	return file
+}
+
+// listServer serves the trust list unless the handle function handles the request.
+func listServer(t *testing.T, list string, handle func(w http.ResponseWriter) bool) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handle != nil && handle(w) {
+			return
+		}
+
+		_, err := w.Write([]byte(list))
+		require.NoError(t, err)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
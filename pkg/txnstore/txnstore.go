@@ -0,0 +1,236 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txnstore
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/trustbloc/edge-core/pkg/log"
+)
+
+const (
+	// expiryTagName is the tag holding expiry time (unix seconds) of the records put with TTL.
+	expiryTagName = "txnExpiresAt"
+)
+
+var logger = log.New("sandbox-txnstore")
+
+// Store wraps storage.Store adding expiry to the records. Expired records are not returned by Get and GetBulk
+// and are removed by Sweep. Expiry is kept in a record tag, so that it works with any storage backend.
+type Store struct {
+	storage.Store
+	now   func() time.Time
+	mutex sync.Mutex
+}
+
+// New opens the named store of the provider and returns it wrapped with expiry support.
+func New(provider storage.Provider, name string) (*Store, error) {
+	store, err := provider.OpenStore(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = provider.SetStoreConfig(name, storage.StoreConfiguration{TagNames: []string{expiryTagName}})
+	if err != nil {
+		return nil, fmt.Errorf("set store configuration of %s : %w", name, err)
+	}
+
+	return &Store{Store: store, now: time.Now}, nil
+}
+
+// PutWithTTL stores the record, which expires after the given TTL.
+func (s *Store) PutWithTTL(key string, value []byte, ttl time.Duration, tags ...storage.Tag) error {
+	expiresAt := s.now().Add(ttl).Unix()
+
+	return s.Store.Put(key, value,
+		append(tags, storage.Tag{Name: expiryTagName, Value: strconv.FormatInt(expiresAt, 10)})...)
+}
+
+// Get returns the record, or storage.ErrDataNotFound if the record doesn't exist or has expired.
+func (s *Store) Get(key string) ([]byte, error) {
+	expired, err := s.expired(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if expired {
+		return nil, storage.ErrDataNotFound
+	}
+
+	return s.Store.Get(key)
+}
+
+// GetBulk returns the records, expired records are returned as nil values.
+func (s *Store) GetBulk(keys ...string) ([][]byte, error) {
+	values, err := s.Store.GetBulk(keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		if values[i] == nil {
+			continue
+		}
+
+		expired, err := s.expired(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if expired {
+			values[i] = nil
+		}
+	}
+
+	return values, nil
+}
+
+// Consume returns the record and deletes it, so that it can be used only once.
+//
+// The read and the delete are serialized by an in-process mutex only, since the storage interface has no
+// conditional delete. Consume is therefore single-instance only: replicas sharing the same storage backend may
+// both consume the same record.
+func (s *Store) Consume(key string) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	value, err := s.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Store.Delete(key)
+	if err != nil {
+		return nil, fmt.Errorf("delete consumed record : %w", err)
+	}
+
+	return value, nil
+}
+
+// Sweep deletes all the expired records and returns the number of deleted records.
+func (s *Store) Sweep() (int, error) {
+	iter, err := s.Store.Query(expiryTagName)
+	if err != nil {
+		return 0, fmt.Errorf("query expiring records : %w", err)
+	}
+
+	defer func() {
+		if e := iter.Close(); e != nil {
+			logger.Warnf("failed to close iterator : %s", e)
+		}
+	}()
+
+	var expiredKeys []string
+
+	more, err := iter.Next()
+
+	for ; err == nil && more; more, err = iter.Next() {
+		tags, e := iter.Tags()
+		if e != nil {
+			return 0, fmt.Errorf("get record tags : %w", e)
+		}
+
+		if !s.isExpired(tags) {
+			continue
+		}
+
+		key, e := iter.Key()
+		if e != nil {
+			return 0, fmt.Errorf("get record key : %w", e)
+		}
+
+		expiredKeys = append(expiredKeys, key)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("iterate expiring records : %w", err)
+	}
+
+	for _, key := range expiredKeys {
+		err = s.Store.Delete(key)
+		if err != nil {
+			return 0, fmt.Errorf("delete expired record : %w", err)
+		}
+	}
+
+	return len(expiredKeys), nil
+}
+
+// StartSweeper sweeps expired records periodically until the returned stop function is called.
+func (s *Store) StartSweeper(interval time.Duration) func() {
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				n, err := s.Sweep()
+				if err != nil {
+					logger.Errorf("failed to sweep expired records : %s", err)
+
+					continue
+				}
+
+				if n > 0 {
+					logger.Debugf("swept %d expired records", n)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+func (s *Store) expired(key string) (bool, error) {
+	tags, err := s.Store.GetTags(key)
+	if err != nil {
+		return false, err
+	}
+
+	if !s.isExpired(tags) {
+		return false, nil
+	}
+
+	err = s.Store.Delete(key)
+	if err != nil {
+		logger.Warnf("failed to delete expired record %s : %s", key, err)
+	}
+
+	return true, nil
+}
+
+func (s *Store) isExpired(tags []storage.Tag) bool {
+	for _, tag := range tags {
+		if tag.Name != expiryTagName {
+			continue
+		}
+
+		expiresAt, err := strconv.ParseInt(tag.Value, 10, 64)
+		if err != nil {
+			logger.Warnf("invalid expiry tag value %s : %s", tag.Value, err)
+
+			return false
+		}
+
+		return !s.now().Before(time.Unix(expiresAt, 0))
+	}
+
+	return false
+}
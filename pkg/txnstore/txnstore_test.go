@@ -0,0 +1,213 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txnstore
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s, err := New(mem.NewProvider(), "txn")
+		require.NoError(t, err)
+		require.NotNil(t, s)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		s, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")}, "txn")
+		require.Error(t, err)
+		require.EqualError(t, err, "open error")
+		require.Nil(t, s)
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		s, err := New(&mockstorage.Provider{
+			OpenStoreReturn:   &mockstorage.Store{},
+			ErrSetStoreConfig: errors.New("config error"),
+		}, "txn")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "set store configuration of txn : config error")
+		require.Nil(t, s)
+	})
+}
+
+func TestStore_PutWithTTL(t *testing.T) {
+	s, now := newStore(t)
+
+	require.NoError(t, s.PutWithTTL("k1", []byte("v1"), time.Minute))
+	require.NoError(t, s.PutWithTTL("k2", []byte("v2"), time.Hour, storage.Tag{Name: "tag"}))
+	require.NoError(t, s.Put("k3", []byte("v3")))
+
+	value, err := s.Get("k1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), value)
+
+	values, err := s.GetBulk("k1", "k2", "k3")
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("v1"), []byte("v2"), []byte("v3")}, values)
+
+	*now = now.Add(2 * time.Minute)
+
+	_, err = s.Get("k1")
+	require.ErrorIs(t, err, storage.ErrDataNotFound)
+
+	values, err = s.GetBulk("k1", "k2", "k3")
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{nil, []byte("v2"), []byte("v3")}, values)
+
+	value, err = s.Get("k2")
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), value)
+
+	*now = now.Add(24 * time.Hour)
+
+	_, err = s.Get("k2")
+	require.ErrorIs(t, err, storage.ErrDataNotFound)
+
+	value, err = s.Get("k3")
+	require.NoError(t, err)
+	require.Equal(t, []byte("v3"), value)
+}
+
+func TestStore_Consume(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s, _ := newStore(t)
+
+		require.NoError(t, s.PutWithTTL("code", []byte("state"), time.Minute))
+
+		value, err := s.Consume("code")
+		require.NoError(t, err)
+		require.Equal(t, []byte("state"), value)
+
+		_, err = s.Consume("code")
+		require.ErrorIs(t, err, storage.ErrDataNotFound)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		s, now := newStore(t)
+
+		require.NoError(t, s.PutWithTTL("code", []byte("state"), time.Minute))
+
+		*now = now.Add(time.Minute)
+
+		_, err := s.Consume("code")
+		require.ErrorIs(t, err, storage.ErrDataNotFound)
+	})
+
+	t.Run("delete error", func(t *testing.T) {
+		s := &Store{Store: &mockstorage.Store{GetReturn: []byte("state"), ErrDelete: errors.New("delete error")},
+			now: time.Now}
+
+		_, err := s.Consume("code")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "delete consumed record : delete error")
+	})
+}
+
+func TestStore_Sweep(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s, now := newStore(t)
+
+		require.NoError(t, s.PutWithTTL("k1", []byte("v1"), time.Minute))
+		require.NoError(t, s.PutWithTTL("k2", []byte("v2"), time.Minute))
+		require.NoError(t, s.PutWithTTL("k3", []byte("v3"), time.Hour))
+		require.NoError(t, s.Put("k4", []byte("v4")))
+
+		n, err := s.Sweep()
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		*now = now.Add(time.Minute)
+
+		n, err = s.Sweep()
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+
+		_, err = s.Store.Get("k1")
+		require.ErrorIs(t, err, storage.ErrDataNotFound)
+
+		_, err = s.Store.Get("k3")
+		require.NoError(t, err)
+
+		_, err = s.Store.Get("k4")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid expiry is kept", func(t *testing.T) {
+		s, _ := newStore(t)
+
+		require.NoError(t, s.Put("k1", []byte("v1"), storage.Tag{Name: expiryTagName, Value: "invalid"}))
+
+		n, err := s.Sweep()
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		_, err = s.Get("k1")
+		require.NoError(t, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		expiredTags := []storage.Tag{{Name: expiryTagName, Value: "1"}}
+
+		for iter, errMsg := range map[*mockstorage.Iterator]string{
+			{ErrNext: errors.New("next error")}:                   "iterate expiring records : next error",
+			{NextReturn: true, ErrTags: errors.New("tags error")}: "get record tags : tags error",
+
+			{NextReturn: true, TagsReturn: expiredTags, ErrKey: errors.New("key error")}: "get record key : key error",
+		} {
+			s := &Store{Store: &mockstorage.Store{QueryReturn: iter}, now: time.Now}
+
+			_, err := s.Sweep()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), errMsg)
+		}
+
+		s := &Store{Store: &mockstorage.Store{ErrQuery: errors.New("query error")}, now: time.Now}
+
+		_, err := s.Sweep()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "query expiring records : query error")
+	})
+}
+
+func TestStore_StartSweeper(t *testing.T) {
+	s, now := newStore(t)
+
+	require.NoError(t, s.PutWithTTL("k1", []byte("v1"), time.Minute))
+
+	*now = now.Add(time.Hour)
+
+	stop := s.StartSweeper(time.Millisecond)
+	defer stop()
+
+	require.Eventually(t, func() bool {
+		_, err := s.Store.Get("k1")
+
+		return errors.Is(err, storage.ErrDataNotFound)
+	}, time.Second, time.Millisecond)
+
+	stop()
+}
+
+func newStore(t *testing.T) (*Store, *time.Time) {
+	t.Helper()
+
+	s, err := New(mem.NewProvider(), "txn")
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	return s, &now
+}
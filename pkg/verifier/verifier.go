@@ -0,0 +1,592 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifier
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	sigverifier "github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	vdrapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdr"
+	"github.com/piprate/json-gold/ld"
+	"github.com/square/go-jose/v3/jwt"
+	"github.com/trustbloc/edge-core/pkg/log"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/trustbloc/sandbox/pkg/statuslist"
+)
+
+const (
+	// CheckProof verifies the proofs of the presentation and the credentials with the keys of the DIDs.
+	CheckProof = "proof"
+	// CheckDates verifies the credentials are issued and not expired.
+	CheckDates = "dates"
+	// CheckCredentialStatus verifies the credentials aren't revoked or suspended in their status lists.
+	CheckCredentialStatus = "credentialStatus"
+	// CheckSchema validates the credentials against their JSON schemas.
+	CheckSchema = "schema"
+	// CheckTrust verifies the issuers are trusted for the credentials in the trust registry.
+	CheckTrust = "trust"
+
+	presentationTarget = "presentation"
+	credentialTarget   = "credential"
+
+	clockSkew = time.Minute
+)
+
+var logger = log.New("sandbox-verifier")
+
+// ErrInvalidInput is returned when the presentation or credential can't be parsed or the checks are unknown.
+var ErrInvalidInput = errors.New("invalid verification input")
+
+// schemaTypes are the supported types of the credential schemas.
+var schemaTypes = map[string]bool{"JsonSchemaValidator2018": true, "JsonSchema": true} // nolint: gochecknoglobals
+
+// CheckResult is the result of the check of the presentation or a credential, the error is set if it failed.
+type CheckResult struct {
+	Check string `json:"check"`
+	// Target is "presentation" or the ID of the credential, or its position if the credential has no ID.
+	Target string `json:"target"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report of the verification, the presentation or the credential is verified if all of the checks passed.
+type Report struct {
+	Verified bool           `json:"verified"`
+	Checks   []*CheckResult `json:"checks"`
+}
+
+// Err returns the error listing the failed checks, nil if the verification succeeded.
+func (r *Report) Err() error {
+	var failures []string
+
+	for _, result := range r.Checks {
+		if result.Error != "" {
+			failures = append(failures, fmt.Sprintf("%s check of %s : %s", result.Check, result.Target, result.Error))
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(failures, "; "))
+}
+
+func (r *Report) add(check, target string, err error) {
+	result := &CheckResult{Check: check, Target: target}
+
+	if err != nil {
+		result.Error = err.Error()
+		r.Verified = false
+	}
+
+	r.Checks = append(r.Checks, result)
+}
+
+// TrustRegistry evaluates whether the issuer is trusted for the credential types at the issuance date.
+type TrustRegistry interface {
+	Evaluate(issuerDID string, types []string, issued time.Time) error
+}
+
+// Verifier verifies the presentations and the credentials locally, the keys of the proofs are resolved through
+// the VDR registry and the status lists and the schemas are fetched over HTTP.
+type Verifier struct {
+	documentLoader ld.DocumentLoader
+	vdr            vdrapi.Registry
+	httpClient     *http.Client
+	trustRegistry  TrustRegistry
+	now            func() time.Time
+}
+
+// Opt configures the verifier.
+type Opt func(v *Verifier)
+
+// WithHTTPClient sets the HTTP client fetching the status list credentials and the schemas.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(v *Verifier) {
+		v.httpClient = client
+	}
+}
+
+// WithTrustRegistry sets the trust registry of the trust check, which is run by default if set.
+func WithTrustRegistry(registry TrustRegistry) Opt {
+	return func(v *Verifier) {
+		v.trustRegistry = registry
+	}
+}
+
+// New returns new verifier resolving the keys with the VDR registry and loading the JSON-LD contexts with the
+// document loader.
+func New(vdr vdrapi.Registry, documentLoader ld.DocumentLoader, opts ...Opt) *Verifier {
+	v := &Verifier{
+		documentLoader: documentLoader,
+		vdr:            vdr,
+		httpClient:     http.DefaultClient,
+		now:            time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// VerifyCredential runs the checks on the credential, all of them if none is given.
+func (v *Verifier) VerifyCredential(vcBytes []byte, checks []string) (*Report, error) {
+	checks, err := v.selectChecks(checks)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Verified: true}
+
+	err = v.verifyCredential(vcBytes, credentialTarget, checks, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// VerifyPresentation runs the checks on the presentation and its credentials, all of them if none is given. The
+// proof check of the presentation verifies the challenge and the domain of the proofs too, if set.
+func (v *Verifier) VerifyPresentation(vpBytes []byte, checks []string, challenge, domain string) (*Report, error) {
+	checks, err := v.selectChecks(checks)
+	if err != nil {
+		return nil, err
+	}
+
+	vp, err := verifiable.ParsePresentation(vpBytes,
+		verifiable.WithPresJSONLDDocumentLoader(v.documentLoader),
+		verifiable.WithPresDisabledProofCheck())
+	if err != nil {
+		return nil, fmt.Errorf("%w : parse presentation : %s", ErrInvalidInput, err)
+	}
+
+	report := &Report{Verified: true}
+
+	if contains(checks, CheckProof) {
+		report.add(CheckProof, presentationTarget, v.checkPresentationProof(vpBytes, vp, challenge, domain))
+	}
+
+	for i, credential := range vp.Credentials() {
+		// the JWT credentials are marshalled to JSON strings, which are parsed as JWTs
+		vcBytes, e := json.Marshal(credential)
+		if e != nil {
+			return nil, fmt.Errorf("%w : marshal credential : %s", ErrInvalidInput, e)
+		}
+
+		e = v.verifyCredential(vcBytes, fmt.Sprintf("%s[%d]", credentialTarget, i), checks, report)
+		if e != nil {
+			return nil, e
+		}
+	}
+
+	return report, nil
+}
+
+func (v *Verifier) verifyCredential(vcBytes []byte, target string, checks []string, report *Report) error {
+	vc, err := verifiable.ParseCredential(vcBytes,
+		verifiable.WithJSONLDDocumentLoader(v.documentLoader),
+		verifiable.WithDisabledProofCheck(),
+		verifiable.WithNoCustomSchemaCheck())
+	if err != nil {
+		return fmt.Errorf("%w : parse %s : %s", ErrInvalidInput, target, err)
+	}
+
+	if vc.ID != "" {
+		target = vc.ID
+	}
+
+	for _, check := range checks {
+		switch check {
+		case CheckProof:
+			report.add(check, target, v.checkCredentialProof(vcBytes, vc))
+		case CheckDates:
+			report.add(check, target, v.checkDates(vc))
+		case CheckCredentialStatus:
+			report.add(check, target, v.checkStatus(vc))
+		case CheckSchema:
+			report.add(check, target, v.checkSchemas(vc))
+		case CheckTrust:
+			report.add(check, target, v.checkTrust(vc))
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) checkPresentationProof(vpBytes []byte, vp *verifiable.Presentation,
+	challenge, domain string) error {
+	if vp.JWT == "" && len(vp.Proofs) == 0 {
+		return errors.New("presentation has no proof")
+	}
+
+	_, err := verifiable.ParsePresentation(vpBytes,
+		verifiable.WithPresJSONLDDocumentLoader(v.documentLoader),
+		verifiable.WithPresPublicKeyFetcher(v.publicKeyFetcher))
+	if err != nil {
+		return fmt.Errorf("invalid presentation proof : %w", err)
+	}
+
+	if vp.JWT != "" {
+		return checkJWTBinding(vp.JWT, challenge, domain)
+	}
+
+	for _, proof := range vp.Proofs {
+		if challenge != "" && proof["challenge"] != challenge {
+			return errors.New("unexpected proof challenge")
+		}
+
+		if domain != "" && proof["domain"] != domain {
+			return errors.New("unexpected proof domain")
+		}
+	}
+
+	return nil
+}
+
+// checkJWTBinding checks the nonce and the audience of the JWT presentation, its signature is already verified.
+func checkJWTBinding(token, nonce, audience string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 { // nolint: gomnd
+		return errors.New("invalid presentation jwt")
+	}
+
+	claims := &struct {
+		Nonce    string       `json:"nonce"`
+		Audience jwt.Audience `json:"aud"`
+	}{}
+
+	err := decodeJWTPart(parts[1], claims)
+	if err != nil {
+		return fmt.Errorf("decode presentation jwt : %w", err)
+	}
+
+	if nonce != "" && claims.Nonce != nonce {
+		return errors.New("unexpected presentation nonce")
+	}
+
+	if audience != "" && !claims.Audience.Contains(audience) {
+		return errors.New("unexpected presentation audience")
+	}
+
+	return nil
+}
+
+func (v *Verifier) checkCredentialProof(vcBytes []byte, vc *verifiable.Credential) error {
+	if vc.JWT == "" && len(vc.Proofs) == 0 {
+		return errors.New("credential has no proof")
+	}
+
+	_, err := verifiable.ParseCredential(vcBytes,
+		verifiable.WithJSONLDDocumentLoader(v.documentLoader),
+		verifiable.WithPublicKeyFetcher(v.publicKeyFetcher),
+		verifiable.WithNoCustomSchemaCheck())
+	if err != nil {
+		return fmt.Errorf("invalid credential proof : %w", err)
+	}
+
+	err = checkProofIssuer(vc)
+	if err != nil {
+		return fmt.Errorf("credential %w", err)
+	}
+
+	return nil
+}
+
+// checkProofIssuer checks that the proofs of the credential are created by its issuer. The keys of the proofs are
+// resolved from their verification methods, which must be the issuer's.
+func checkProofIssuer(vc *verifiable.Credential) error {
+	methods := []string{}
+
+	for _, proof := range vc.Proofs {
+		method, _ := proof["verificationMethod"].(string) // nolint: errcheck
+		methods = append(methods, method)
+	}
+
+	if vc.JWT != "" {
+		header := &struct {
+			KeyID string `json:"kid"`
+		}{}
+
+		err := decodeJWTPart(strings.Split(vc.JWT, ".")[0], header)
+		if err != nil {
+			return fmt.Errorf("proof jwt header : %w", err)
+		}
+
+		methods = append(methods, header.KeyID)
+	}
+
+	for _, method := range methods {
+		if strings.Split(method, "#")[0] != vc.Issuer.ID {
+			return fmt.Errorf("proof isn't created by the issuer %s", vc.Issuer.ID)
+		}
+	}
+
+	return nil
+}
+
+// publicKeyFetcher returns the public key of the verification method of the DID. The key ID is the fragment of
+// the verification method ID, with the leading "#" for the embedded proofs and without it for the JWTs.
+func (v *Verifier) publicKeyFetcher(issuerID, keyID string) (*sigverifier.PublicKey, error) {
+	docResolution, err := v.vdr.Resolve(issuerID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve DID %s : %w", issuerID, err)
+	}
+
+	methodID := issuerID + "#" + strings.TrimPrefix(keyID, "#")
+
+	for _, methods := range docResolution.DIDDocument.VerificationMethods() {
+		for _, method := range methods {
+			vm := method.VerificationMethod
+
+			// the verification method IDs are either absolute or relative to the DID
+			if vm.ID == methodID || vm.ID == "#"+strings.TrimPrefix(keyID, "#") {
+				return &sigverifier.PublicKey{
+					Type:  vm.Type,
+					Value: vm.Value,
+					JWK:   vm.JSONWebKey(),
+				}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("key %s not found for DID %s", keyID, issuerID)
+}
+
+// decodeJWTPart decodes the base64url encoded JSON header or payload of the JWT.
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func (v *Verifier) checkDates(vc *verifiable.Credential) error {
+	now := v.now()
+
+	if vc.Issued != nil && vc.Issued.Time.After(now.Add(clockSkew)) {
+		return fmt.Errorf("credential isn't valid before %s", vc.Issued.Time.Format(time.RFC3339))
+	}
+
+	if vc.Expired != nil && now.After(vc.Expired.Time) {
+		return fmt.Errorf("credential expired at %s", vc.Expired.Time.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// checkTrust evaluates the issuer in the trust registry, at the current time if the credential has no issuance date.
+func (v *Verifier) checkTrust(vc *verifiable.Credential) error {
+	issued := v.now()
+	if vc.Issued != nil {
+		issued = vc.Issued.Time
+	}
+
+	return v.trustRegistry.Evaluate(vc.Issuer.ID, vc.Types, issued)
+}
+
+// checkStatus checks the status bit of the credential in the status list credential of its issuer.
+func (v *Verifier) checkStatus(vc *verifiable.Credential) error {
+	if vc.Status == nil {
+		return nil
+	}
+
+	credentialStatus := map[string]interface{}{"id": vc.Status.ID, "type": vc.Status.Type}
+	for k, val := range vc.Status.CustomFields {
+		credentialStatus[k] = val
+	}
+
+	entry, err := statuslist.ParseEntry(credentialStatus)
+	if err != nil {
+		return err
+	}
+
+	listVC, err := v.statusListCredential(entry.ListURL)
+	if err != nil {
+		return err
+	}
+
+	if listVC.Issuer.ID != vc.Issuer.ID {
+		return fmt.Errorf("status list credential %s isn't issued by the issuer %s", entry.ListURL, vc.Issuer.ID)
+	}
+
+	set, err := isStatusSet(listVC, entry.Index)
+	if err != nil {
+		return fmt.Errorf("status list credential %s : %w", entry.ListURL, err)
+	}
+
+	if !set {
+		return nil
+	}
+
+	if entry.Purpose == statuslist.Suspension {
+		return errors.New("credential is suspended")
+	}
+
+	return errors.New("credential is revoked")
+}
+
+func isStatusSet(listVC *verifiable.Credential, index int) (bool, error) {
+	subjects, ok := listVC.Subject.([]verifiable.Subject)
+	if !ok || len(subjects) == 0 {
+		return false, errors.New("missing credential subject")
+	}
+
+	encodedList, _ := subjects[0].CustomFields["encodedList"].(string) // nolint: errcheck
+
+	return statuslist.IsSet(encodedList, index)
+}
+
+// statusListCredential fetches the status list credential and verifies its proof.
+func (v *Verifier) statusListCredential(listURL string) (*verifiable.Credential, error) {
+	listBytes, err := v.fetch(listURL)
+	if err != nil {
+		return nil, fmt.Errorf("get status list credential : %w", err)
+	}
+
+	listVC, err := verifiable.ParseCredential(listBytes,
+		verifiable.WithJSONLDDocumentLoader(v.documentLoader),
+		verifiable.WithPublicKeyFetcher(v.publicKeyFetcher))
+	if err != nil {
+		return nil, fmt.Errorf("invalid status list credential %s : %w", listURL, err)
+	}
+
+	if listVC.JWT == "" && len(listVC.Proofs) == 0 {
+		return nil, fmt.Errorf("status list credential %s has no proof", listURL)
+	}
+
+	err = checkProofIssuer(listVC)
+	if err != nil {
+		return nil, fmt.Errorf("status list credential %s %w", listURL, err)
+	}
+
+	return listVC, nil
+}
+
+// checkSchemas validates the credential against the JSON schemas of its credentialSchema property.
+func (v *Verifier) checkSchemas(vc *verifiable.Credential) error {
+	if len(vc.Schemas) == 0 {
+		return nil
+	}
+
+	jsonVC := *vc
+	jsonVC.JWT = ""
+
+	vcBytes, err := jsonVC.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal credential : %w", err)
+	}
+
+	for _, schema := range vc.Schemas {
+		if !schemaTypes[schema.Type] {
+			return fmt.Errorf("unsupported credential schema type %s", schema.Type)
+		}
+
+		schemaBytes, e := v.fetch(schema.ID)
+		if e != nil {
+			return fmt.Errorf("get credential schema : %w", e)
+		}
+
+		result, e := gojsonschema.Validate(gojsonschema.NewBytesLoader(schemaBytes),
+			gojsonschema.NewBytesLoader(vcBytes))
+		if e != nil {
+			return fmt.Errorf("invalid credential schema %s : %w", schema.ID, e)
+		}
+
+		if !result.Valid() {
+			return fmt.Errorf("credential doesn't match the schema %s : %s", schema.ID, result.Errors()[0])
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) fetch(url string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Warnf("failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s : %s: %s", url, resp.Status, string(body))
+	}
+
+	return body, nil
+}
+
+// selectChecks returns the given checks, skipping the empty ones, or all of the checks if none is given. The trust
+// check is part of all of the checks only if the trust registry is set.
+func (v *Verifier) selectChecks(checks []string) ([]string, error) {
+	var selected []string
+
+	for _, check := range checks {
+		switch check {
+		case "":
+			continue
+		case CheckProof, CheckDates, CheckCredentialStatus, CheckSchema:
+			selected = append(selected, check)
+		case CheckTrust:
+			if v.trustRegistry == nil {
+				return nil, fmt.Errorf("%w : trust registry isn't configured", ErrInvalidInput)
+			}
+
+			selected = append(selected, check)
+		default:
+			return nil, fmt.Errorf("%w : unsupported check %s", ErrInvalidInput, check)
+		}
+	}
+
+	if len(selected) > 0 {
+		return selected, nil
+	}
+
+	selected = []string{CheckProof, CheckDates, CheckCredentialStatus, CheckSchema}
+	if v.trustRegistry != nil {
+		selected = append(selected, CheckTrust)
+	}
+
+	return selected, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
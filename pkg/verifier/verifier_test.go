@@ -0,0 +1,571 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/ld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	mockldstore "github.com/hyperledger/aries-framework-go/pkg/mock/ld"
+	ldstore "github.com/hyperledger/aries-framework-go/pkg/store/ld"
+	vdrpkg "github.com/hyperledger/aries-framework-go/pkg/vdr"
+	vdrkey "github.com/hyperledger/aries-framework-go/pkg/vdr/key"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustbloc/sandbox/pkg/kms"
+	"github.com/trustbloc/sandbox/pkg/statuslist"
+)
+
+const (
+	challenge = "challenge-123"
+	domain    = "verifier.example.com"
+	holderDID = "did:example:holder"
+
+	subjectSchema = `{
+  "type": "object",
+  "required": ["credentialSubject"],
+  "properties": {
+    "credentialSubject": {
+      "type": "object",
+      "required": ["id"],
+      "properties": {"id": {"type": "string", "pattern": "^did:example:"}}
+    }
+  }
+}`
+)
+
+func TestVerifier_VerifyCredential(t *testing.T) {
+	loader := createTestDocumentLoader(t)
+	issuer := createKey(t)
+	srv := newIssuerServer(t, issuer, loader)
+	v := New(vdrpkg.New(vdrpkg.WithVDR(vdrkey.New())), loader, WithHTTPClient(srv.Client()))
+
+	t.Run("success", func(t *testing.T) {
+		credential := srv.credential(t, statuslist.Revocation)
+		credential["credentialSchema"] = map[string]interface{}{
+			"id": srv.URL + "/schema", "type": "JsonSchemaValidator2018",
+		}
+
+		report, err := v.VerifyCredential(signCredential(t, issuer, credential, loader), nil)
+		require.NoError(t, err)
+		require.True(t, report.Verified)
+		require.NoError(t, report.Err())
+		require.Len(t, report.Checks, 4)
+
+		for i, check := range []string{CheckProof, CheckDates, CheckCredentialStatus, CheckSchema} {
+			require.Equal(t, &CheckResult{Check: check, Target: credential["id"].(string)}, report.Checks[i])
+		}
+	})
+
+	t.Run("success - jwt credential", func(t *testing.T) {
+		report, err := v.VerifyCredential(createJWTCredential(t, issuer, srv.credential(t, ""), loader),
+			[]string{CheckProof, CheckDates})
+		require.NoError(t, err)
+		require.True(t, report.Verified)
+		require.Len(t, report.Checks, 2)
+	})
+
+	t.Run("proof check failed", func(t *testing.T) {
+		credential := srv.credential(t, "")
+
+		report, err := v.VerifyCredential(marshal(t, credential), []string{CheckProof})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Equal(t, "credential has no proof", report.Checks[0].Error)
+
+		credential["issuer"] = "did:example:issuer"
+
+		report, err = v.VerifyCredential(signCredential(t, issuer, credential, loader), []string{CheckProof})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "isn't created by the issuer did:example:issuer")
+
+		other := createKey(t)
+
+		jwtVC := createJWTCredential(t, other, srv.credential(t, ""), loader)
+
+		report, err = v.VerifyCredential(jwtVC, []string{CheckProof})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "credential proof isn't created by the issuer")
+	})
+
+	t.Run("dates check failed", func(t *testing.T) {
+		credential := srv.credential(t, "")
+		credential["expirationDate"] = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+		report, err := v.VerifyCredential(signCredential(t, issuer, credential, loader), []string{CheckDates})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "credential expired at")
+
+		credential = srv.credential(t, "")
+		credential["issuanceDate"] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+		report, err = v.VerifyCredential(signCredential(t, issuer, credential, loader), []string{CheckDates})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "credential isn't valid before")
+	})
+
+	t.Run("status check failed", func(t *testing.T) {
+		for purpose, reason := range map[statuslist.Purpose]string{
+			statuslist.Revocation: "credential is revoked",
+			statuslist.Suspension: "credential is suspended",
+		} {
+			credential := srv.credential(t, purpose)
+			vcBytes := signCredential(t, issuer, credential, loader)
+
+			report, err := v.VerifyCredential(vcBytes, []string{CheckCredentialStatus})
+			require.NoError(t, err)
+			require.True(t, report.Verified)
+
+			srv.setStatus(t, credential)
+
+			report, err = v.VerifyCredential(vcBytes, []string{CheckCredentialStatus})
+			require.NoError(t, err)
+			require.False(t, report.Verified)
+			require.Equal(t, reason, report.Checks[0].Error)
+		}
+
+		credential := srv.credential(t, statuslist.Revocation)
+		credential["credentialStatus"].(map[string]interface{})["statusListCredential"] = srv.URL + "/status/missing"
+
+		report, err := v.VerifyCredential(signCredential(t, issuer, credential, loader),
+			[]string{CheckCredentialStatus})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "get status list credential")
+
+		other := createKey(t)
+
+		forged := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			listVC, e := srv.statusLists.Credential(strings.TrimPrefix(r.URL.Path, "/status/"), issuer.DID)
+			require.NoError(t, e)
+
+			_, e = w.Write(signCredential(t, other, unmarshal(t, listVC), loader))
+			require.NoError(t, e)
+		}))
+		defer forged.Close()
+
+		credential = srv.credential(t, statuslist.Revocation)
+		credentialStatus := credential["credentialStatus"].(map[string]interface{})
+		credentialStatus["statusListCredential"] = strings.Replace(
+			credentialStatus["statusListCredential"].(string), srv.URL, forged.URL, 1)
+
+		report, err = v.VerifyCredential(signCredential(t, issuer, credential, loader),
+			[]string{CheckCredentialStatus})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "proof isn't created by the issuer "+issuer.DID)
+	})
+
+	t.Run("schema check failed", func(t *testing.T) {
+		credential := srv.credential(t, "")
+		credential["credentialSubject"] = map[string]interface{}{"id": "did:key:123"}
+		credential["credentialSchema"] = map[string]interface{}{
+			"id": srv.URL + "/schema", "type": "JsonSchemaValidator2018",
+		}
+
+		report, err := v.VerifyCredential(signCredential(t, issuer, credential, loader), []string{CheckSchema})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Contains(t, report.Checks[0].Error, "credential doesn't match the schema")
+	})
+
+	t.Run("trust check", func(t *testing.T) {
+		registry := &mockTrustRegistry{}
+		trustV := New(vdrpkg.New(vdrpkg.WithVDR(vdrkey.New())), loader, WithHTTPClient(srv.Client()),
+			WithTrustRegistry(registry))
+
+		credential := srv.credential(t, "")
+		vcBytes := signCredential(t, issuer, credential, loader)
+
+		report, err := trustV.VerifyCredential(vcBytes, nil)
+		require.NoError(t, err)
+		require.True(t, report.Verified)
+		require.Len(t, report.Checks, 5)
+		require.Equal(t, &CheckResult{Check: CheckTrust, Target: credential["id"].(string)}, report.Checks[4])
+		require.Equal(t, issuer.DID, registry.issuerDID)
+		require.Equal(t, []string{"VerifiableCredential"}, registry.types)
+		require.Equal(t, credential["issuanceDate"], registry.issued.UTC().Format(time.RFC3339))
+
+		registry.err = errors.New("issuer not trusted")
+
+		report, err = trustV.VerifyCredential(vcBytes, []string{CheckTrust})
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Len(t, report.Checks, 1)
+		require.Equal(t, "issuer not trusted", report.Checks[0].Error)
+	})
+
+	t.Run("error - invalid input", func(t *testing.T) {
+		_, err := v.VerifyCredential([]byte("{}"), []string{CheckProof, "unknown"})
+		require.True(t, errors.Is(err, ErrInvalidInput))
+		require.Contains(t, err.Error(), "unsupported check unknown")
+
+		_, err = v.VerifyCredential([]byte("{}"), []string{CheckTrust})
+		require.True(t, errors.Is(err, ErrInvalidInput))
+		require.Contains(t, err.Error(), "trust registry isn't configured")
+
+		_, err = v.VerifyCredential([]byte("invalid"), nil)
+		require.True(t, errors.Is(err, ErrInvalidInput))
+		require.Contains(t, err.Error(), "parse credential")
+	})
+}
+
+func TestVerifier_VerifyPresentation(t *testing.T) {
+	loader := createTestDocumentLoader(t)
+	issuer := createKey(t)
+	holder := createKey(t)
+	srv := newIssuerServer(t, issuer, loader)
+	v := New(vdrpkg.New(vdrpkg.WithVDR(vdrkey.New())), loader, WithHTTPClient(srv.Client()))
+
+	credential := srv.credential(t, statuslist.Revocation)
+	vcBytes := signCredential(t, issuer, credential, loader)
+
+	t.Run("success", func(t *testing.T) {
+		vpBytes := signPresentation(t, holder, loader, vcBytes)
+
+		report, err := v.VerifyPresentation(vpBytes, nil, challenge, domain)
+		require.NoError(t, err)
+		require.True(t, report.Verified)
+		require.Len(t, report.Checks, 5)
+		require.Equal(t, &CheckResult{Check: CheckProof, Target: presentationTarget}, report.Checks[0])
+		require.Equal(t, &CheckResult{Check: CheckProof, Target: credential["id"].(string)}, report.Checks[1])
+	})
+
+	t.Run("success - jwt presentation", func(t *testing.T) {
+		jwtCredential := srv.credential(t, "")
+		vpBytes := createJWTPresentation(t, holder, loader, createJWTCredential(t, issuer, jwtCredential, loader))
+
+		report, err := v.VerifyPresentation(vpBytes, []string{CheckProof}, "", domain)
+		require.NoError(t, err)
+		require.True(t, report.Verified)
+		require.Equal(t, &CheckResult{Check: CheckProof, Target: jwtCredential["id"].(string)}, report.Checks[1])
+
+		report, err = v.VerifyPresentation(vpBytes, []string{CheckProof}, "", "other.example.com")
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Equal(t, "unexpected presentation audience", report.Checks[0].Error)
+	})
+
+	t.Run("proof check failed", func(t *testing.T) {
+		vpBytes := signPresentation(t, holder, loader, vcBytes)
+
+		report, err := v.VerifyPresentation(vpBytes, []string{CheckProof}, "other", domain)
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Equal(t, "unexpected proof challenge", report.Checks[0].Error)
+
+		report, err = v.VerifyPresentation(vpBytes, []string{CheckProof}, challenge, "other.example.com")
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Equal(t, "unexpected proof domain", report.Checks[0].Error)
+		require.Empty(t, report.Checks[1].Error)
+
+		vp, err := verifiable.NewPresentation()
+		require.NoError(t, err)
+
+		report, err = v.VerifyPresentation(marshal(t, vp), []string{CheckProof}, challenge, domain)
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Equal(t, "presentation has no proof", report.Checks[0].Error)
+	})
+
+	t.Run("credential check failed", func(t *testing.T) {
+		revoked := srv.credential(t, statuslist.Revocation)
+		vpBytes := signPresentation(t, holder, loader, signCredential(t, issuer, revoked, loader))
+
+		srv.setStatus(t, revoked)
+
+		report, err := v.VerifyPresentation(vpBytes, []string{CheckCredentialStatus}, challenge, domain)
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.EqualError(t, report.Err(), fmt.Sprintf("credentialStatus check of %s : credential is revoked",
+			revoked["id"]))
+	})
+
+	t.Run("trust check failed", func(t *testing.T) {
+		trustV := New(vdrpkg.New(vdrpkg.WithVDR(vdrkey.New())), loader, WithHTTPClient(srv.Client()),
+			WithTrustRegistry(&mockTrustRegistry{err: errors.New("issuer not trusted")}))
+
+		report, err := trustV.VerifyPresentation(signPresentation(t, holder, loader, vcBytes),
+			[]string{CheckProof, CheckTrust}, challenge, domain)
+		require.NoError(t, err)
+		require.False(t, report.Verified)
+		require.Len(t, report.Checks, 3)
+		require.Empty(t, report.Checks[0].Error)
+		require.Empty(t, report.Checks[1].Error)
+		require.EqualError(t, report.Err(), fmt.Sprintf("trust check of %s : issuer not trusted", credential["id"]))
+	})
+
+	t.Run("error - invalid input", func(t *testing.T) {
+		_, err := v.VerifyPresentation([]byte("invalid"), nil, challenge, domain)
+		require.True(t, errors.Is(err, ErrInvalidInput))
+		require.Contains(t, err.Error(), "parse presentation")
+
+		_, err = v.VerifyPresentation(signPresentation(t, holder, loader, vcBytes), []string{"unknown"}, "", "")
+		require.True(t, errors.Is(err, ErrInvalidInput))
+		require.Contains(t, err.Error(), "unsupported check unknown")
+	})
+}
+
+func TestReport_Err(t *testing.T) {
+	report := &Report{Verified: true}
+	report.add(CheckProof, presentationTarget, nil)
+	require.True(t, report.Verified)
+	require.NoError(t, report.Err())
+
+	report.add(CheckDates, "credential[0]", errors.New("credential expired"))
+	report.add(CheckSchema, "credential[1]", errors.New("unsupported credential schema type"))
+	require.False(t, report.Verified)
+	require.EqualError(t, report.Err(), "dates check of credential[0] : credential expired; "+
+		"schema check of credential[1] : unsupported credential schema type")
+}
+
+// issuerServer publishes the status list credentials and the credential schema of the issuer.
+type issuerServer struct {
+	*httptest.Server
+	statusLists *statuslist.Manager
+	issuer      *kms.Key
+	counter     int
+}
+
+func newIssuerServer(t *testing.T, issuer *kms.Key, loader *ld.DocumentLoader) *issuerServer {
+	t.Helper()
+
+	statusLists, err := statuslist.New(mem.NewProvider(), statuslist.WithSize(16))
+	require.NoError(t, err)
+
+	s := &issuerServer{statusLists: statusLists, issuer: issuer}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status/", func(w http.ResponseWriter, r *http.Request) {
+		listVC, e := statusLists.Credential(strings.TrimPrefix(r.URL.Path, "/status/"), issuer.DID)
+		if e != nil {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, e = w.Write(signCredential(t, issuer, unmarshal(t, listVC), loader))
+		require.NoError(t, e)
+	})
+	mux.HandleFunc("/schema", func(w http.ResponseWriter, r *http.Request) {
+		_, e := w.Write([]byte(subjectSchema))
+		require.NoError(t, e)
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// credential returns new unsigned credential of the issuer, with the credential status of the purpose if set.
+func (s *issuerServer) credential(t *testing.T, purpose statuslist.Purpose) map[string]interface{} {
+	t.Helper()
+
+	s.counter++
+
+	credential := map[string]interface{}{
+		"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1", s.statusLists.Context()},
+		"id":                fmt.Sprintf("http://example.com/credentials/%d", s.counter),
+		"type":              []interface{}{"VerifiableCredential"},
+		"issuer":            s.issuer.DID,
+		"issuanceDate":      time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+		"credentialSubject": map[string]interface{}{"id": holderDID},
+	}
+
+	if purpose != "" {
+		entry, err := s.statusLists.Assign(s.issuer.DID, s.URL+"/status/", purpose)
+		require.NoError(t, err)
+
+		credential["credentialStatus"] = s.statusLists.CredentialStatus(entry)
+	}
+
+	return credential
+}
+
+func (s *issuerServer) setStatus(t *testing.T, credential map[string]interface{}) {
+	t.Helper()
+
+	credentialStatus, ok := credential["credentialStatus"].(map[string]interface{})
+	require.True(t, ok)
+
+	entry, err := statuslist.ParseEntry(credentialStatus)
+	require.NoError(t, err)
+
+	require.NoError(t, s.statusLists.Update(entry.ListID, entry.Index, true))
+}
+
+func createKey(t *testing.T) *kms.Key {
+	t.Helper()
+
+	k, err := kms.New(mem.NewProvider())
+	require.NoError(t, err)
+
+	key, err := k.Create("issuer", kms.Ed25519)
+	require.NoError(t, err)
+
+	return key
+}
+
+func parseCredential(t *testing.T, vcBytes []byte, loader *ld.DocumentLoader) *verifiable.Credential {
+	t.Helper()
+
+	vc, err := verifiable.ParseCredential(vcBytes, verifiable.WithJSONLDDocumentLoader(loader),
+		verifiable.WithDisabledProofCheck(), verifiable.WithNoCustomSchemaCheck())
+	require.NoError(t, err)
+
+	return vc
+}
+
+func signCredential(t *testing.T, key *kms.Key, credential map[string]interface{},
+	loader *ld.DocumentLoader) []byte {
+	t.Helper()
+
+	vc := parseCredential(t, marshal(t, credential), loader)
+
+	created := time.Now()
+
+	err := vc.AddLinkedDataProof(&verifiable.LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: verifiable.SignatureProofValue,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(key.Signer())),
+		VerificationMethod:      key.ID,
+		Purpose:                 "assertionMethod",
+		Created:                 &created,
+	}, jsonld.WithDocumentLoader(loader))
+	require.NoError(t, err)
+
+	return marshal(t, vc)
+}
+
+func createJWTCredential(t *testing.T, key *kms.Key, credential map[string]interface{},
+	loader *ld.DocumentLoader) []byte {
+	t.Helper()
+
+	claims, err := parseCredential(t, marshal(t, credential), loader).JWTClaims(false)
+	require.NoError(t, err)
+
+	jws, err := claims.MarshalJWS(verifiable.EdDSA, key.Signer(), key.ID)
+	require.NoError(t, err)
+
+	return []byte(jws)
+}
+
+func signPresentation(t *testing.T, key *kms.Key, loader *ld.DocumentLoader, vcBytes []byte) []byte {
+	t.Helper()
+
+	vp, err := verifiable.NewPresentation(verifiable.WithCredentials(parseCredential(t, vcBytes, loader)))
+	require.NoError(t, err)
+
+	vp.Holder = key.DID
+
+	created := time.Now()
+
+	err = vp.AddLinkedDataProof(&verifiable.LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: verifiable.SignatureProofValue,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(key.Signer())),
+		VerificationMethod:      key.ID,
+		Purpose:                 "authentication",
+		Challenge:               challenge,
+		Domain:                  domain,
+		Created:                 &created,
+	}, jsonld.WithDocumentLoader(loader))
+	require.NoError(t, err)
+
+	return marshal(t, vp)
+}
+
+func createJWTPresentation(t *testing.T, key *kms.Key, loader *ld.DocumentLoader, jwtVC []byte) []byte {
+	t.Helper()
+
+	vp, err := verifiable.NewPresentation(verifiable.WithCredentials(parseCredential(t, jwtVC, loader)))
+	require.NoError(t, err)
+
+	vp.Holder = key.DID
+
+	claims, err := vp.JWTClaims([]string{domain}, false)
+	require.NoError(t, err)
+
+	jws, err := claims.MarshalJWS(verifiable.EdDSA, key.Signer(), key.ID)
+	require.NoError(t, err)
+
+	return []byte(jws)
+}
+
+func marshal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return b
+}
+
+func unmarshal(t *testing.T, b []byte) map[string]interface{} {
+	t.Helper()
+
+	m := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(b, &m))
+
+	return m
+}
+
+type mockLDStoreProvider struct {
+	ContextStore        ldstore.ContextStore
+	RemoteProviderStore ldstore.RemoteProviderStore
+}
+
+func (p *mockLDStoreProvider) JSONLDContextStore() ldstore.ContextStore {
+	return p.ContextStore
+}
+
+func (p *mockLDStoreProvider) JSONLDRemoteProviderStore() ldstore.RemoteProviderStore {
+	return p.RemoteProviderStore
+}
+
+func createTestDocumentLoader(t *testing.T) *ld.DocumentLoader {
+	t.Helper()
+
+	loader, err := ld.NewDocumentLoader(&mockLDStoreProvider{
+		ContextStore:        mockldstore.NewMockContextStore(),
+		RemoteProviderStore: mockldstore.NewMockRemoteProviderStore(),
+	})
+	require.NoError(t, err)
+
+	return loader
+}
+
+type mockTrustRegistry struct {
+	issuerDID string
+	types     []string
+	issued    time.Time
+	err       error
+}
+
+func (r *mockTrustRegistry) Evaluate(issuerDID string, types []string, issued time.Time) error {
+	r.issuerDID, r.types, r.issued = issuerDID, types, issued
+
+	return r.err
+}
@@ -0,0 +1,344 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifierprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/spi/storage"
+	"github.com/trustbloc/edge-core/pkg/log"
+)
+
+const (
+	// store
+	profileStoreName = "rp_verifier_profiles"
+	profileTagName   = "verifierProfile"
+
+	// DefaultProfileID is the ID of the built-in profile used by the requests without a profile.
+	DefaultProfileID = "default"
+
+	// DefaultVCSProfileID is the VCS verifier profile of the built-in profile.
+	DefaultVCSProfileID = "trustbloc-verifier"
+	// DefaultOIDC4VPProfileID is the VCS verifier profile of the OIDC4VP interactions of the built-in profile.
+	DefaultOIDC4VPProfileID = "jwt-web-ED25519-JsonWebSignature2020"
+)
+
+var logger = log.New("sandbox-verifierprofile")
+
+var (
+	// ErrProfileNotFound is returned when no profile is registered with the given ID.
+	ErrProfileNotFound = errors.New("verifier profile not found")
+	// ErrInvalidProfile is returned when the profile is rejected.
+	ErrInvalidProfile = errors.New("invalid verifier profile")
+	// ErrBuiltInProfile is returned when deleting the built-in profile.
+	ErrBuiltInProfile = errors.New("built-in verifier profile can't be deleted")
+)
+
+// Profile of the verifier demo hosted by the RP.
+type Profile struct {
+	ID string `json:"id"`
+	// VCSProfileID is the VCS verifier profile verifying the presentations and the credentials.
+	VCSProfileID string `json:"vcsProfileID"`
+	// Checks are the verification checks of the requests without checks, the default checks of the verifier
+	// if not set.
+	Checks []string `json:"checks,omitempty"`
+	// PresentationDefinition is requested by the OIDC share requests without a presentation definition.
+	PresentationDefinition json.RawMessage `json:"presentationDefinition,omitempty"`
+	// OIDC4VP settings of the OIDC4VP interactions and the DID configuration, OIDC4VP is disabled if not set.
+	OIDC4VP *OIDC4VP `json:"oidc4vp,omitempty"`
+	// Labels of the verifier UI.
+	Labels *Labels `json:"labels,omitempty"`
+}
+
+// OIDC4VP settings of the profile.
+type OIDC4VP struct {
+	// VCSProfileID is the VCS verifier profile initiating the interactions and publishing the DID configuration.
+	VCSProfileID string `json:"vcsProfileID"`
+	// PresentationDefinitionID is the presentation definition of the VCS profile requested by the interactions,
+	// the default of the VCS profile if not set.
+	PresentationDefinitionID string `json:"presentationDefinitionID,omitempty"`
+	// Purpose of the interactions displayed by the wallet.
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// Labels of the verifier UI.
+type Labels struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	LogoURL     string `json:"logoURL,omitempty"`
+}
+
+// Registry of the verifier profiles. Registered profiles take precedence over the built-in one.
+type Registry struct {
+	store    storage.Store
+	builtIns map[string]*Profile
+}
+
+// New returns new verifier profile registry backed by the given storage provider.
+func New(provider storage.Provider) (*Registry, error) {
+	store, err := provider.OpenStore(profileStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open verifier profile store : %w", err)
+	}
+
+	err = provider.SetStoreConfig(profileStoreName, storage.StoreConfiguration{TagNames: []string{profileTagName}})
+	if err != nil {
+		return nil, fmt.Errorf("set verifier profile store configuration : %w", err)
+	}
+
+	return &Registry{store: store, builtIns: builtInProfiles()}, nil
+}
+
+// builtInProfiles returns the profile of the VCS profiles the RP was using before profiles were introduced.
+func builtInProfiles() map[string]*Profile {
+	return map[string]*Profile{
+		DefaultProfileID: {
+			ID:           DefaultProfileID,
+			VCSProfileID: DefaultVCSProfileID,
+			OIDC4VP:      &OIDC4VP{VCSProfileID: DefaultOIDC4VPProfileID},
+			Labels:       &Labels{Name: "TrustBloc Verifier"},
+		},
+	}
+}
+
+// Put validates and saves the profile. An existing profile with the same ID is replaced.
+func (r *Registry) Put(profile *Profile) error {
+	err := profile.validate()
+	if err != nil {
+		return err
+	}
+
+	profileBytes, err := json.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("marshal verifier profile : %w", err)
+	}
+
+	err = r.store.Put(profile.ID, profileBytes, storage.Tag{Name: profileTagName})
+	if err != nil {
+		return fmt.Errorf("save verifier profile : %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the profile, the default profile if the ID is empty.
+func (r *Registry) Get(id string) (*Profile, error) {
+	if id == "" {
+		id = DefaultProfileID
+	}
+
+	profileBytes, err := r.store.Get(id)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		if profile, ok := r.builtIns[id]; ok {
+			return profile, nil
+		}
+
+		return nil, ErrProfileNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get verifier profile : %w", err)
+	}
+
+	profile := &Profile{}
+
+	err = json.Unmarshal(profileBytes, profile)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal verifier profile : %w", err)
+	}
+
+	return profile, nil
+}
+
+// List returns all the profiles sorted by ID.
+func (r *Registry) List() ([]*Profile, error) {
+	iter, err := r.store.Query(profileTagName)
+	if err != nil {
+		return nil, fmt.Errorf("query verifier profiles : %w", err)
+	}
+
+	defer func() {
+		if e := iter.Close(); e != nil {
+			logger.Warnf("failed to close iterator : %s", e)
+		}
+	}()
+
+	profiles := map[string]*Profile{}
+
+	for id, profile := range r.builtIns {
+		profiles[id] = profile
+	}
+
+	more, err := iter.Next()
+
+	for ; err == nil && more; more, err = iter.Next() {
+		profileBytes, e := iter.Value()
+		if e != nil {
+			return nil, fmt.Errorf("get verifier profile : %w", e)
+		}
+
+		profile := &Profile{}
+
+		e = json.Unmarshal(profileBytes, profile)
+		if e != nil {
+			return nil, fmt.Errorf("unmarshal verifier profile : %w", e)
+		}
+
+		profiles[profile.ID] = profile
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("iterate verifier profiles : %w", err)
+	}
+
+	result := make([]*Profile, 0, len(profiles))
+	for _, profile := range profiles {
+		result = append(result, profile)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return result, nil
+}
+
+// Delete removes the registered profile, the built-in profile with the same ID, if any, applies again.
+func (r *Registry) Delete(id string) error {
+	_, err := r.store.Get(id)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		if _, ok := r.builtIns[id]; ok {
+			return ErrBuiltInProfile
+		}
+
+		return ErrProfileNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("get verifier profile : %w", err)
+	}
+
+	err = r.store.Delete(id)
+	if err != nil {
+		return fmt.Errorf("delete verifier profile : %w", err)
+	}
+
+	return nil
+}
+
+// Load registers the profiles of the file, or of every JSON file of the directory. File holds either a single
+// profile or an array of them.
+func (r *Registry) Load(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("read verifier profiles : %w", err)
+	}
+
+	files := []string{path}
+
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return fmt.Errorf("read verifier profiles : %w", err)
+		}
+	}
+
+	for _, file := range files {
+		profiles, err := readProfiles(file)
+		if err != nil {
+			return err
+		}
+
+		for _, profile := range profiles {
+			err = r.Put(profile)
+			if err != nil {
+				return fmt.Errorf("load verifier profile from %s : %w", file, err)
+			}
+		}
+
+		logger.Infof("loaded %d verifier profiles from %s", len(profiles), file)
+	}
+
+	return nil
+}
+
+func readProfiles(file string) ([]*Profile, error) {
+	profilesBytes, err := os.ReadFile(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("read verifier profiles : %w", err)
+	}
+
+	var profiles []*Profile
+
+	if strings.HasPrefix(strings.TrimSpace(string(profilesBytes)), "[") {
+		err = json.Unmarshal(profilesBytes, &profiles)
+	} else {
+		profile := &Profile{}
+		err = json.Unmarshal(profilesBytes, profile)
+		profiles = append(profiles, profile)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal verifier profiles of %s : %w", file, err)
+	}
+
+	return profiles, nil
+}
+
+// Definition returns the presentation definition of the profile, nil if it has none.
+func (p *Profile) Definition() (*presexch.PresentationDefinition, error) {
+	if len(p.PresentationDefinition) == 0 {
+		return nil, nil
+	}
+
+	pd := &presexch.PresentationDefinition{}
+
+	err := json.Unmarshal(p.PresentationDefinition, pd)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal presentation definition : %w", err)
+	}
+
+	return pd, nil
+}
+
+func (p *Profile) validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("%w : missing id", ErrInvalidProfile)
+	}
+
+	if p.VCSProfileID == "" {
+		return fmt.Errorf("%w : missing vcsProfileID", ErrInvalidProfile)
+	}
+
+	for _, check := range p.Checks {
+		if check == "" {
+			return fmt.Errorf("%w : empty check", ErrInvalidProfile)
+		}
+	}
+
+	if p.OIDC4VP != nil && p.OIDC4VP.VCSProfileID == "" {
+		return fmt.Errorf("%w : missing oidc4vp vcsProfileID", ErrInvalidProfile)
+	}
+
+	pd, err := p.Definition()
+	if err != nil {
+		return fmt.Errorf("%w : %s", ErrInvalidProfile, err)
+	}
+
+	if pd != nil {
+		if err = pd.ValidateSchema(); err != nil {
+			return fmt.Errorf("%w : invalid presentation definition : %s", ErrInvalidProfile, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifierprofile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/component/storageutil/mem"
+	mockstorage "github.com/hyperledger/aries-framework-go/component/storageutil/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const prcProfile = `{
+	"id": "prc",
+	"vcsProfileID": "prc-verifier",
+	"checks": ["proof", "credentialStatus"],
+	"presentationDefinition": {
+		"id": "prc-definition",
+		"input_descriptors": [{
+			"id": "prc",
+			"schema": [{"uri": "https://w3id.org/citizenship#PermanentResidentCard"}]
+		}]
+	},
+	"oidc4vp": {"vcsProfileID": "prc-oidc4vp", "presentationDefinitionID": "prc-definition", "purpose": "background"},
+	"labels": {"name": "Background Check", "description": "Permanent resident card verifier"}
+}`
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+		require.NotNil(t, r)
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{ErrOpenStore: errors.New("open error")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open verifier profile store : open error")
+	})
+
+	t.Run("set store config error", func(t *testing.T) {
+		_, err := New(&mockstorage.Provider{
+			OpenStoreReturn:   &mockstorage.Store{},
+			ErrSetStoreConfig: errors.New("config error"),
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "set verifier profile store configuration : config error")
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("put, get, list and delete", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		require.NoError(t, r.Put(parseProfile(t, prcProfile)))
+
+		profile, err := r.Get("prc")
+		require.NoError(t, err)
+		require.Equal(t, "prc-verifier", profile.VCSProfileID)
+		require.Equal(t, "prc-oidc4vp", profile.OIDC4VP.VCSProfileID)
+		require.Equal(t, "Background Check", profile.Labels.Name)
+
+		pd, err := profile.Definition()
+		require.NoError(t, err)
+		require.Equal(t, "prc-definition", pd.ID)
+
+		profiles, err := r.List()
+		require.NoError(t, err)
+		require.Len(t, profiles, 2)
+		require.Equal(t, DefaultProfileID, profiles[0].ID)
+		require.Equal(t, "prc", profiles[1].ID)
+
+		require.NoError(t, r.Delete("prc"))
+
+		_, err = r.Get("prc")
+		require.ErrorIs(t, err, ErrProfileNotFound)
+
+		require.ErrorIs(t, r.Delete("prc"), ErrProfileNotFound)
+		require.ErrorIs(t, r.Delete(DefaultProfileID), ErrBuiltInProfile)
+	})
+
+	t.Run("default profile", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		profile, err := r.Get("")
+		require.NoError(t, err)
+		require.Equal(t, DefaultVCSProfileID, profile.VCSProfileID)
+		require.Equal(t, DefaultOIDC4VPProfileID, profile.OIDC4VP.VCSProfileID)
+
+		pd, err := profile.Definition()
+		require.NoError(t, err)
+		require.Nil(t, pd)
+
+		require.NoError(t, r.Put(&Profile{ID: DefaultProfileID, VCSProfileID: "other-verifier"}))
+
+		profile, err = r.Get(DefaultProfileID)
+		require.NoError(t, err)
+		require.Equal(t, "other-verifier", profile.VCSProfileID)
+		require.Nil(t, profile.OIDC4VP)
+
+		require.NoError(t, r.Delete(DefaultProfileID))
+
+		profile, err = r.Get("")
+		require.NoError(t, err)
+		require.Equal(t, DefaultVCSProfileID, profile.VCSProfileID)
+	})
+
+	t.Run("invalid profile", func(t *testing.T) {
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		for errMsg, profile := range map[string]*Profile{
+			"missing id":                   {},
+			"missing vcsProfileID":         {ID: "p"},
+			"empty check":                  {ID: "p", VCSProfileID: "v", Checks: []string{""}},
+			"missing oidc4vp vcsProfileID": {ID: "p", VCSProfileID: "v", OIDC4VP: &OIDC4VP{Purpose: "demo"}},
+			"unmarshal presentation definition": {
+				ID: "p", VCSProfileID: "v", PresentationDefinition: json.RawMessage(`"definition"`),
+			},
+			"invalid presentation definition": {
+				ID: "p", VCSProfileID: "v", PresentationDefinition: json.RawMessage(`{"id": "definition"}`),
+			},
+		} {
+			err = r.Put(profile)
+			require.ErrorIs(t, err, ErrInvalidProfile)
+			require.Contains(t, err.Error(), errMsg)
+		}
+	})
+
+	t.Run("store errors", func(t *testing.T) {
+		r := &Registry{store: &mockstorage.Store{ErrPut: errors.New("put error")}}
+
+		err := r.Put(&Profile{ID: "p", VCSProfileID: "v"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "save verifier profile : put error")
+
+		r = &Registry{store: &mockstorage.Store{ErrGet: errors.New("get error")}}
+
+		_, err = r.Get("p")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get verifier profile : get error")
+
+		err = r.Delete("p")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get verifier profile : get error")
+
+		r = &Registry{store: &mockstorage.Store{GetReturn: []byte("{")}}
+
+		_, err = r.Get("p")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal verifier profile")
+
+		r = &Registry{store: &mockstorage.Store{GetReturn: []byte("{}"), ErrDelete: errors.New("delete error")}}
+
+		err = r.Delete("p")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "delete verifier profile : delete error")
+
+		r = &Registry{store: &mockstorage.Store{ErrQuery: errors.New("query error")}}
+
+		_, err = r.List()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "query verifier profiles : query error")
+
+		for iter, errMsg := range map[*mockstorage.Iterator]string{
+			{ErrNext: errors.New("next error")}:                     "iterate verifier profiles : next error",
+			{NextReturn: true, ErrValue: errors.New("value error")}: "get verifier profile : value error",
+			{NextReturn: true, ValueReturn: []byte("{")}:            "unmarshal verifier profile",
+		} {
+			r = &Registry{store: &mockstorage.Store{QueryReturn: iter}}
+
+			_, err = r.List()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), errMsg)
+		}
+	})
+}
+
+func TestRegistry_Load(t *testing.T) {
+	t.Run("directory", func(t *testing.T) {
+		dir := t.TempDir()
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "prc.json"), []byte(prcProfile), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "demos.json"),
+			[]byte(`[{"id":"degree","vcsProfileID":"degree-verifier"},{"id":"bank","vcsProfileID":"bank"}]`), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("profiles"), 0o600))
+
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		require.NoError(t, r.Load(dir))
+
+		profiles, err := r.List()
+		require.NoError(t, err)
+		require.Len(t, profiles, 4)
+
+		profile, err := r.Get("degree")
+		require.NoError(t, err)
+		require.Equal(t, "degree-verifier", profile.VCSProfileID)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		dir := t.TempDir()
+
+		r, err := New(mem.NewProvider())
+		require.NoError(t, err)
+
+		err = r.Load(filepath.Join(dir, "missing.json"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "read verifier profiles")
+
+		file := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(file, []byte("{"), 0o600))
+
+		err = r.Load(file)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal verifier profiles of "+file)
+
+		require.NoError(t, os.WriteFile(file, []byte(`[{"id":"p"}]`), 0o600))
+
+		err = r.Load(dir)
+		require.ErrorIs(t, err, ErrInvalidProfile)
+		require.Contains(t, err.Error(), "load verifier profile from "+file)
+	})
+}
+
+func parseProfile(t *testing.T, profileJSON string) *Profile {
+	t.Helper()
+
+	profile := &Profile{}
+	require.NoError(t, json.Unmarshal([]byte(profileJSON), profile))
+
+	return profile
+}